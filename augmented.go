@@ -0,0 +1,170 @@
+package math
+
+// AugmentedAdd returns the sum of x+y rounded to nearest with ties toward zero,
+// and the exact error of that rounding, such that sum + err == x + y exactly.
+//
+// Special cases are:
+//
+//	AugmentedAdd(x, NaN)     = AugmentedAdd(NaN, y) = NaN, NaN
+//	AugmentedAdd(±Inf, ∓Inf) = NaN, NaN
+//	AugmentedAdd(±Inf, y)    = AugmentedAdd(x, ±Inf) = ±Inf, ±Inf
+//
+// If the sum overflows, then both results are the same infinity.
+// If err is zero, then it has the same sign as sum.
+//
+// This is the IEEE 754-2019 augmentedAddition operation.
+func AugmentedAdd[FLOAT Float](x, y FLOAT) (sum, err FLOAT) {
+	sum = x + y
+
+	switch {
+	case IsNaN(sum):
+		return sum, sum
+
+	case IsInf(sum, 0):
+		if IsInf(x, 0) || IsInf(y, 0) {
+			return sum, sum
+		}
+
+		// The sum overflowed under ties-to-even,
+		// but ties-to-zero may yet round the sum down to the largest finite value.
+		// Halving is exact here, as both x and y must be quite large for the sum to overflow.
+		sum, err = AugmentedAdd(x/2, y/2)
+		if IsInf(sum*2, 0) {
+			return sum * 2, sum * 2
+		}
+
+		return sum * 2, err * 2
+	}
+
+	return tiesToZero(sum, twoSumErr(x, y, sum))
+}
+
+// AugmentedSub returns the difference of x-y rounded to nearest with ties toward zero,
+// and the exact error of that rounding, such that diff + err == x - y exactly.
+//
+// Special cases are the same as AugmentedAdd(x, -y).
+//
+// This is the IEEE 754-2019 augmentedSubtraction operation.
+func AugmentedSub[FLOAT Float](x, y FLOAT) (diff, err FLOAT) {
+	return AugmentedAdd(x, -y)
+}
+
+// AugmentedMul returns the product of x*y rounded to nearest with ties toward zero,
+// and the error of that rounding, such that prod + err == x * y.
+// The error is exact, unless it is too small to be represented.
+//
+// Special cases are:
+//
+//	AugmentedMul(x, NaN)  = AugmentedMul(NaN, y) = NaN, NaN
+//	AugmentedMul(±Inf, 0) = AugmentedMul(0, ±Inf) = NaN, NaN
+//	AugmentedMul(±Inf, y) = AugmentedMul(x, ±Inf) = ±Inf, ±Inf
+//	AugmentedMul(±0, y)   = AugmentedMul(x, ±0) = ±0, ±0
+//
+// If the product overflows, then both results are the same infinity.
+// If err is zero, then it has the same sign as prod.
+//
+// This is the IEEE 754-2019 augmentedMultiplication operation.
+func AugmentedMul[FLOAT Float](x, y FLOAT) (prod, err FLOAT) {
+	// The explicit conversion prevents the compiler from fusing this multiply into anything else.
+	prod = FLOAT(x * y)
+
+	switch {
+	case IsNaN(prod), prod == 0:
+		return prod, prod
+
+	case IsInf(prod, 0):
+		if IsInf(x, 0) || IsInf(y, 0) {
+			return prod, prod
+		}
+
+		// The product overflowed under ties-to-even,
+		// but ties-to-zero may yet round the product down to the largest finite value.
+		// Halving the larger operand is exact, as it must be quite large for the product to overflow.
+		if Abs(x) >= Abs(y) {
+			x /= 2
+		} else {
+			y /= 2
+		}
+
+		prod, err = AugmentedMul(x, y)
+		if IsInf(prod*2, 0) {
+			return prod * 2, prod * 2
+		}
+
+		return prod * 2, err * 2
+	}
+
+	err = FMA(x, y, -prod)
+
+	// Near the subnormals, the error may itself be rounded,
+	// and could then falsely appear to be exactly half of an ulp.
+	if limit, scale := tinyProduct[FLOAT](); Abs(prod) < limit {
+		// Scaling the smaller operand up is exact, and makes the scaled error exact as well.
+		if Abs(x) > Abs(y) {
+			x, y = y, x
+		}
+
+		var errʹ FLOAT
+		prod, errʹ = tiesToZeroScaled(prod, FMA(x*scale, y, -prod*scale), scale)
+
+		// Scaling back down rounds ties to even, so it also needs to be rounded with ties toward zero.
+		err, _ = tiesToZeroScaled(errʹ/scale, errʹ-(errʹ/scale)*scale, scale)
+		if err == 0 {
+			// An underflowed error still takes the sign of the result.
+			err = CopySign(0, prod)
+		}
+
+		return prod, err
+	}
+
+	return tiesToZero(prod, err)
+}
+
+// tinyProduct returns the magnitude below which the error of a product might not be exact,
+// and a power of two that scales such an error up to be exact again.
+func tinyProduct[FLOAT Float]() (limit, scale FLOAT) {
+	var f FLOAT
+	switch any(f).(type) {
+	case float32:
+		return 0x1p-78, 0x1p48 // 2**(emin + 2p), 2**(2p)
+	case float64:
+		return 0x1p-916, 0x1p106
+	default:
+		panic("impossible type")
+	}
+}
+
+// twoSumErr returns the exact error of the rounded sum of x+y,
+// using Knuth’s branch-free TwoSum algorithm.
+func twoSumErr[FLOAT Float](x, y, sum FLOAT) FLOAT {
+	xʹ := sum - y
+	yʹ := sum - xʹ
+
+	Δx := x - xʹ
+	Δy := y - yʹ
+
+	return Δx + Δy
+}
+
+// tiesToZero takes a result r rounded to nearest with ties to even, and its exact error e,
+// and returns the same result and error, but as if r had been rounded with ties toward zero.
+func tiesToZero[FLOAT Float](r, e FLOAT) (FLOAT, FLOAT) {
+	return tiesToZeroScaled(r, e, 1)
+}
+
+// tiesToZeroScaled is tiesToZero, where the exact error e has been scaled up by a power of two.
+func tiesToZeroScaled[FLOAT Float](r, e, scale FLOAT) (FLOAT, FLOAT) {
+	if e == 0 {
+		// A zero error always takes the sign of the result.
+		return r, CopySign(0, r)
+	}
+
+	// r was rounded away from zero on a tie,
+	// if the error is exactly half of the gap between r and its neighbor toward zero.
+	toward := NextAfter(r, 0)
+	if (r-toward)*scale == -2*e {
+		return toward, -e
+	}
+
+	return r, e
+}
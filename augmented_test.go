@@ -0,0 +1,114 @@
+package math
+
+import (
+	"testing"
+)
+
+// tieAtMax returns two operands whose exact product lies halfway between the largest finite value and the overflow threshold,
+// which is 2**(p+1) - 1 scaled up, for the precision p.
+func tieAtMax[FLOAT Float]() (x, y FLOAT) {
+	var f FLOAT
+	switch any(f).(type) {
+	case float32:
+		return LdExp[FLOAT](31, 103), 1082401 // 31 × 1082401 = 2**25 - 1
+	case float64:
+		return LdExp[FLOAT](3, 970), 6004799503160661 // 3 × 6004799503160661 = 2**54 - 1
+	default:
+		panic("impossible type")
+	}
+}
+
+func testAugmented[FLOAT Float](t *testing.T) {
+	t.Helper()
+
+	nan, inf, negZero := NaN[FLOAT](), Inf[FLOAT](1), CopySign(0, FLOAT(-1))
+
+	// eps is the gap above 1, huge is the largest finite value, and tiny is the smallest subnormal.
+	eps := NextUp(FLOAT(1)) - 1
+	huge := NextDown(inf)
+	halfUlpHuge := (huge - NextDown(huge)) / 2
+	tiny := NextUp(FLOAT(0))
+
+	// small scales a product of operands near 1 below the limit of tinyProduct,
+	// while eps×small and eps×eps×small are still normal.
+	limit, _ := tinyProduct[FLOAT]()
+	small := limit / 4
+
+	tieX, tieY := tieAtMax[FLOAT]()
+
+	add, sub, mul := AugmentedAdd[FLOAT], AugmentedSub[FLOAT], AugmentedMul[FLOAT]
+
+	tests := []struct {
+		name string
+		fn   func(x, y FLOAT) (FLOAT, FLOAT)
+		x, y FLOAT
+
+		expect, expectErr FLOAT
+	}{
+		{"AugmentedAdd", add, 1, 2, 3, 0},
+		{"AugmentedAdd", add, 1, eps / 4, 1, eps / 4},
+		{"AugmentedAdd", add, nan, 1, nan, nan},
+		{"AugmentedAdd", add, inf, -inf, nan, nan},
+		{"AugmentedAdd", add, -inf, 1, -inf, -inf},
+		{"AugmentedAdd", add, huge, huge, inf, inf},
+		{"AugmentedAdd", add, -huge, -huge, -inf, -inf},
+
+		// Ties round toward zero, where ties to even would round away from it.
+		{"AugmentedAdd", add, 1 + eps, eps / 2, 1 + eps, eps / 2},
+		{"AugmentedAdd", add, -1 - eps, -eps / 2, -1 - eps, -eps / 2},
+
+		// A tie at the overflow threshold rounds down to the largest finite value.
+		{"AugmentedAdd", add, huge, halfUlpHuge, huge, halfUlpHuge},
+		{"AugmentedAdd", add, -huge, -halfUlpHuge, -huge, -halfUlpHuge},
+
+		// A zero error takes the sign of the result.
+		{"AugmentedAdd", add, -1, -1, -2, negZero},
+		{"AugmentedAdd", add, 1, -1, 0, 0},
+		{"AugmentedAdd", add, negZero, negZero, negZero, negZero},
+
+		{"AugmentedSub", sub, 1 + eps, -eps / 2, 1 + eps, eps / 2},
+		{"AugmentedSub", sub, -huge, halfUlpHuge, -huge, -halfUlpHuge},
+		{"AugmentedSub", sub, -1, 1, -2, negZero},
+		{"AugmentedSub", sub, negZero, 0, negZero, negZero},
+		{"AugmentedSub", sub, inf, inf, nan, nan},
+
+		{"AugmentedMul", mul, 1 + eps, 1 + eps, 1 + 2*eps, eps * eps},
+		{"AugmentedMul", mul, nan, 0, nan, nan},
+		{"AugmentedMul", mul, inf, 0, nan, nan},
+		{"AugmentedMul", mul, -inf, 2, -inf, -inf},
+		{"AugmentedMul", mul, huge, 2, inf, inf},
+		{"AugmentedMul", mul, negZero, 1, negZero, negZero},
+
+		// 3 × (1+eps) is halfway between 3+2eps and 3+4eps.
+		{"AugmentedMul", mul, 3, 1 + eps, 3 + 2*eps, eps},
+		{"AugmentedMul", mul, -3, 1 + eps, -3 - 2*eps, -eps},
+
+		// A tie at the overflow threshold rounds down to the largest finite value.
+		{"AugmentedMul", mul, tieX, tieY, huge, halfUlpHuge},
+		{"AugmentedMul", mul, -tieX, tieY, -huge, -halfUlpHuge},
+
+		// Products below the limit of tinyProduct, whose errors are found by scaling.
+		{"AugmentedMul", mul, 1 + eps, (1 + eps) * small, (1 + 2*eps) * small, eps * eps * small},
+		{"AugmentedMul", mul, 3, (1 + eps) * small, (3 + 2*eps) * small, eps * small},
+		{"AugmentedMul", mul, (1 + eps) * small, -3, -(3 + 2*eps) * small, -eps * small},
+
+		// Subnormal products, where the tie rounds toward zero, and the error underflows to a zero of the sign of the product.
+		{"AugmentedMul", mul, 3 * tiny, 0.5, tiny, 0},
+		{"AugmentedMul", mul, -3 * tiny, 0.5, -tiny, negZero},
+		{"AugmentedMul", mul, 0.5, -3 * tiny, -tiny, negZero},
+		{"AugmentedMul", mul, tiny, 0.5, 0, 0},
+		{"AugmentedMul", mul, -tiny, 0.5, negZero, negZero},
+		{"AugmentedMul", mul, 5 * tiny, 0.5, 2 * tiny, 0},
+	}
+
+	for _, tt := range tests {
+		if got, gotErr := tt.fn(tt.x, tt.y); !same(got, tt.expect) || !same(gotErr, tt.expectErr) {
+			t.Errorf("%T: %s(%v, %v) = %v, %v, expected %v, %v", tt.x, tt.name, tt.x, tt.y, got, gotErr, tt.expect, tt.expectErr)
+		}
+	}
+}
+
+func TestAugmented(t *testing.T) {
+	testAugmented[float32](t)
+	testAugmented[float64](t)
+}
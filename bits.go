@@ -2,6 +2,8 @@ package math
 
 import (
 	"math"
+
+	"github.com/puellanivis/math/floats"
 )
 
 // Bits returns the IEEE 754 binary representation of f,
@@ -137,6 +139,52 @@ func IsNaN[FLOAT Float](f FLOAT) (is bool) {
 	return f != f
 }
 
+// Class returns which of the ten IEEE 754 classes f belongs to.
+func Class[FLOAT Float](f FLOAT) floats.Class {
+	switch f := any(f).(type) {
+	case float32:
+		return floats.Float32FromBits(Bits32(f)).Class()
+	case float64:
+		return floats.Float64FromBits(Bits64(f)).Class()
+	default:
+		panic("impossible type")
+	}
+}
+
+// IsSignaling reports whether f is an IEEE 754 signaling “not-a-number” value.
+func IsSignaling[FLOAT Float](f FLOAT) (is bool) {
+	return Class(f) == floats.SignalingNaN
+}
+
+// IsZero reports whether f is either positive or negative zero.
+func IsZero[FLOAT Float](f FLOAT) (is bool) {
+	return f == 0
+}
+
+// IsFinite reports whether f is neither an infinity nor a “not-a-number” value.
+func IsFinite[FLOAT Float](f FLOAT) (is bool) {
+	// Only infinities and NaNs give a NaN when subtracted from themselves.
+	return f-f == 0
+}
+
+// IsNormal reports whether f is normal, that is finite, non-zero, and not subnormal.
+func IsNormal[FLOAT Float](f FLOAT) (is bool) {
+	switch Class(f) {
+	case floats.NegativeNormal, floats.PositiveNormal:
+		return true
+	}
+	return false
+}
+
+// IsSubnormal reports whether f is subnormal, that is non-zero, and smaller in magnitude than any normal number.
+func IsSubnormal[FLOAT Float](f FLOAT) (is bool) {
+	switch Class(f) {
+	case floats.NegativeSubnormal, floats.PositiveSubnormal:
+		return true
+	}
+	return false
+}
+
 // FrExp breaks f into a normalized fraction and an integral power of two.
 // It returns frac and exp satisfying f == frac × 2**exp,
 // with the absolute value of frac in the interval [½, 1).
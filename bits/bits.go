@@ -9,6 +9,8 @@ type Uint interface {
 type Bits[U Uint] interface {
 	Int(x U) int
 	FromInt(int) U
+	Uint128(x U) Uint128
+	FromUint128(Uint128) U
 
 	Add(x, y, carry U) (sum, carryOut U)
 	Sub(x, y, borrow U) (diff, borrowOut U)
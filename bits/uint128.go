@@ -8,28 +8,11 @@ import (
 	"strconv"
 )
 
-const wordsPerUint128 = 128 / strconv.IntSize
-
 // Uint128 defines a 128 bit unsigned integer.
 type Uint128 struct {
 	Hi, Lo uint64
 }
 
-func (u *Uint128) set(words []big.Word) {
-	if strconv.IntSize == 32 {
-		var w [4]big.Word
-		copy(w[:], words)
-
-		u.Hi = (uint64(w[3]) << 32) | uint64(w[2])
-		u.Lo = (uint64(w[1]) << 32) | uint64(w[0])
-	}
-
-	var w [2]big.Word
-	copy(w[:], words)
-
-	u.Hi, u.Lo = uint64(w[1]), uint64(w[0])
-}
-
 func (u Uint128) words() []big.Word {
 	if strconv.IntSize == 32 {
 		const mask = (1 << 32) - 1
@@ -67,6 +50,16 @@ func (Bits128) FromInt(i int) (u Uint128) {
 	return Uint128{Lo: uint64(i)}
 }
 
+// Uint128 converts to a Uint128, which is the identity.
+func (Bits128) Uint128(x Uint128) Uint128 {
+	return x
+}
+
+// FromUint128 converts from a Uint128, which is the identity.
+func (Bits128) FromUint128(x Uint128) Uint128 {
+	return x
+}
+
 // Add is bits.Add128.
 func (Bits128) Add(x, y, carry Uint128) (sum, carryOut Uint128) {
 	sum.Lo, carryOut.Lo = bits.Add64(x.Lo, y.Lo, carry.Lo)
@@ -96,43 +89,91 @@ func (b Bits128) Dec(x Uint128) Uint128 {
 }
 
 // Mul is bits.Mul128.
-func (b Bits128) Mul(x, y Uint128) (hi, lo Uint128) {
-	// TODO: implement without using math/big.
+func (Bits128) Mul(x, y Uint128) (hi, lo Uint128) {
+	h00, l00 := bits.Mul64(x.Lo, y.Lo)
+	h01, l01 := bits.Mul64(x.Lo, y.Hi)
+	h10, l10 := bits.Mul64(x.Hi, y.Lo)
+	h11, l11 := bits.Mul64(x.Hi, y.Hi)
+
+	// Sum the partial products by columns of 64 bits, carrying into the next.
+	w1, c1 := bits.Add64(h00, l01, 0)
+	w1, c2 := bits.Add64(w1, l10, 0)
+	w2, c3 := bits.Add64(h01, h10, c1)
+	w2, c4 := bits.Add64(w2, l11, c2)
 
-	bx := new(big.Int).SetBits(x.words())
-	by := new(big.Int).SetBits(y.words())
+	// The product fits in 256 bits, so this cannot carry out.
+	w3 := h11 + c3 + c4
 
-	bprod := new(big.Int).Mul(bx, by)
+	return Uint128{Hi: w3, Lo: w2}, Uint128{Hi: w1, Lo: l00}
+}
 
-	prod := bprod.Bits()
+// Div is bits.Div128.
+//
+// As with bits.Div64, it panics if y is zero (division by zero) or y <= hi (quotient overflow).
+func (b Bits128) Div(hi, lo, y Uint128) (quo, rem Uint128) {
+	if !b.IsZero(y) && b.Lte(y, hi) {
+		panic("integer overflow")
+	}
 
-	sep := wordsPerUint128
-	if sep > len(prod) {
-		sep = len(prod)
+	if y.Hi == 0 {
+		// Since hi < y, hi.Hi is zero, and this is a long division by a single word.
+		// bits.Div64 also panics for us, if y is zero.
+		var r uint64
+		quo.Hi, r = bits.Div64(hi.Lo, lo.Hi, y.Lo)
+		quo.Lo, r = bits.Div64(r, lo.Lo, y.Lo)
+		return quo, Uint128{Lo: r}
 	}
 
-	lo.set(prod[:sep])
-	hi.set(prod[sep:])
+	// Normalize so that the top bit of y is set, which bounds the error of each estimated quotient word.
+	// Since hi < y, no bits are shifted out of the top of hi.
+	s := bits.LeadingZeros64(y.Hi)
+	y = b.Shl(y, s)
+	hi = Uint128{Hi: hi.Hi<<s | hi.Lo>>(64-s), Lo: hi.Lo<<s | lo.Hi>>(64-s)}
+	lo = b.Shl(lo, s)
 
-	return
+	var r Uint128
+	quo.Hi, r = div192by128(hi.Hi, hi.Lo, lo.Hi, y)
+	quo.Lo, r = div192by128(r.Hi, r.Lo, lo.Lo, y)
+
+	return quo, b.Shr(r, s)
 }
 
-// Div is bits.Div128.
-func (Bits128) Div(hi, lo, y Uint128) (quo, rem Uint128) {
-	// TODO: implement without using math/big.
+// div192by128 divides the 192 bits u2:u1:u0 by y, whose top bit must be set, where u2:u1 < y.
+//
+// This is step D3 through D6 of Knuth’s Algorithm D, in The Art of Computer Programming, Vol. 2, §4.3.1.
+func div192by128(u2, u1, u0 uint64, y Uint128) (q uint64, r Uint128) {
+	// Estimate the quotient from the top words alone.
+	// With y normalized, this is at most two too large.
+	if u2 < y.Hi {
+		q, _ = bits.Div64(u2, u1, y.Hi)
+	} else {
+		q = 1<<64 - 1
+	}
 
-	bz := new(big.Int).SetBits(hi.words())
-	bz.Lsh(bz, 128)
-	bz.Or(bz, new(big.Int).SetBits(lo.words()))
+	// Subtract q·y from u.
+	p1, p0 := bits.Mul64(q, y.Lo)
+	p2, t := bits.Mul64(q, y.Hi)
 
-	by := new(big.Int).SetBits(y.words())
+	p1, c := bits.Add64(p1, t, 0)
+	p2 += c
 
-	bquo, brem := new(big.Int).QuoRem(bz, by, new(big.Int))
+	r0, borrow := bits.Sub64(u0, p0, 0)
+	r1, borrow := bits.Sub64(u1, p1, borrow)
+	r2, borrow := bits.Sub64(u2, p2, borrow)
 
-	quo.set(bquo.Bits())
-	rem.set(brem.Bits())
+	// While the remainder is negative, the estimate was too large, so add y back.
+	for negative := borrow != 0; negative; {
+		q--
 
-	return
+		var carry uint64
+		r0, carry = bits.Add64(r0, y.Lo, 0)
+		r1, carry = bits.Add64(r1, y.Hi, carry)
+		r2, carry = bits.Add64(r2, 0, carry)
+
+		negative = carry == 0
+	}
+
+	return q, Uint128{Hi: r1, Lo: r0}
 }
 
 // Not returns the bitwise inverse of all bits in the argument.
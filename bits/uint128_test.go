@@ -0,0 +1,114 @@
+package bits
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+func (u Uint128) bigInt() *big.Int {
+	return new(big.Int).SetBits(u.words())
+}
+
+func fromBigInt(x *big.Int) Uint128 {
+	lo := new(big.Int).And(x, new(big.Int).SetUint64(1<<64-1))
+	hi := new(big.Int).Rsh(x, 64)
+	return Uint128{Hi: hi.Uint64(), Lo: lo.Uint64()}
+}
+
+// edgeWords are the words most likely to expose a lost carry or a misestimated quotient word.
+var edgeWords = []uint64{
+	0, 1, 2,
+	1<<32 - 1, 1 << 32, 1<<32 + 1,
+	1<<63 - 1, 1 << 63, 1<<63 + 1,
+	1<<64 - 2, 1<<64 - 1,
+}
+
+// randUint128 returns a random Uint128, with each word taken from edgeWords a quarter of the time.
+func randUint128(rng *rand.Rand) Uint128 {
+	word := func() uint64 {
+		if rng.IntN(4) == 0 {
+			return edgeWords[rng.IntN(len(edgeWords))]
+		}
+		return rng.Uint64() >> rng.IntN(64)
+	}
+
+	return Uint128{Hi: word(), Lo: word()}
+}
+
+const testOperands = 1 << 20
+
+func TestUint128Mul(t *testing.T) {
+	var b Bits128
+	rng := rand.New(rand.NewPCG(128, 1))
+
+	for range testOperands {
+		x, y := randUint128(rng), randUint128(rng)
+
+		hi, lo := b.Mul(x, y)
+
+		p := new(big.Int).Mul(x.bigInt(), y.bigInt())
+		expectLo := fromBigInt(p)
+		expectHi := fromBigInt(p.Rsh(p, 128))
+
+		if hi != expectHi || lo != expectLo {
+			t.Fatalf("Mul(%#x, %#x) = %#x:%#x, expected %#x:%#x", x, y, hi, lo, expectHi, expectLo)
+		}
+	}
+}
+
+func TestUint128Div(t *testing.T) {
+	var b Bits128
+	rng := rand.New(rand.NewPCG(128, 2))
+
+	for range testOperands {
+		y := randUint128(rng)
+		if b.IsZero(y) {
+			continue
+		}
+
+		// The quotient fits in 128 bits only if hi < y.
+		hi := fromBigInt(new(big.Int).Mod(randUint128(rng).bigInt(), y.bigInt()))
+		if rng.IntN(4) == 0 {
+			hi = b.Dec(y)
+		}
+		lo := randUint128(rng)
+
+		quo, rem := b.Div(hi, lo, y)
+
+		u := new(big.Int).Lsh(hi.bigInt(), 128)
+		u.Or(u, lo.bigInt())
+		q, r := new(big.Int).QuoRem(u, y.bigInt(), new(big.Int))
+
+		if expectQuo, expectRem := fromBigInt(q), fromBigInt(r); quo != expectQuo || rem != expectRem {
+			t.Fatalf("Div(%#x, %#x, %#x) = %#x, %#x, expected %#x, %#x", hi, lo, y, quo, rem, expectQuo, expectRem)
+		}
+	}
+}
+
+func TestUint128DivPanics(t *testing.T) {
+	var b Bits128
+
+	tests := []struct {
+		name      string
+		hi, lo, y Uint128
+	}{
+		{"zero", Uint128{}, Uint128{Lo: 1}, Uint128{}},
+		{"zero_hi", Uint128{Hi: 1}, Uint128{}, Uint128{}},
+		{"hi_equal", Uint128{Lo: 5}, Uint128{}, Uint128{Lo: 5}},
+		{"hi_above", Uint128{Hi: 1}, Uint128{}, Uint128{Lo: 1<<64 - 1}},
+		{"hi_equal_wide", Uint128{Hi: 1, Lo: 2}, Uint128{}, Uint128{Hi: 1, Lo: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Div(%#x, %#x, %#x) did not panic", tt.hi, tt.lo, tt.y)
+				}
+			}()
+
+			b.Div(tt.hi, tt.lo, tt.y)
+		})
+	}
+}
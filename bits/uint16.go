@@ -21,6 +21,18 @@ func (Bits16) FromInt(i int) uint16 {
 	return uint16(i)
 }
 
+// Uint128 converts to a Uint128.
+func (Bits16) Uint128(x uint16) Uint128 {
+	return Uint128{Lo: uint64(x)}
+}
+
+// FromUint128 converts from a Uint128.
+//
+// This is a simple cast, and will discard all but the lowest 16 bits.
+func (Bits16) FromUint128(x Uint128) uint16 {
+	return uint16(x.Lo)
+}
+
 // Add is bits.Add16.
 func (Bits16) Add(x, y, carry uint16) (sum, carryOut uint16) {
 	sum32 := uint32(x) + uint32(y) + uint32(carry)
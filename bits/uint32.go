@@ -21,6 +21,18 @@ func (Bits32) FromInt(i int) uint32 {
 	return uint32(i)
 }
 
+// Uint128 converts to a Uint128.
+func (Bits32) Uint128(x uint32) Uint128 {
+	return Uint128{Lo: uint64(x)}
+}
+
+// FromUint128 converts from a Uint128.
+//
+// This is a simple cast, and will discard all but the lowest 32 bits.
+func (Bits32) FromUint128(x Uint128) uint32 {
+	return uint32(x.Lo)
+}
+
 // Add is [bits.Add32].
 func (Bits32) Add(x, y, carry uint32) (sum, carryOut uint32) {
 	return bits.Add32(x, y, carry)
@@ -21,6 +21,18 @@ func (Bits64) FromInt(i int) uint64 {
 	return uint64(i)
 }
 
+// Uint128 converts to a Uint128.
+func (Bits64) Uint128(x uint64) Uint128 {
+	return Uint128{Lo: x}
+}
+
+// FromUint128 converts from a Uint128.
+//
+// This is a simple cast, and will discard all but the lowest 64 bits.
+func (Bits64) FromUint128(x Uint128) uint64 {
+	return x.Lo
+}
+
 // Add is [bits.Add64].
 func (Bits64) Add(x, y, carry uint64) (sum, carryOut uint64) {
 	return bits.Add64(x, y, carry)
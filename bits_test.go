@@ -0,0 +1,68 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/puellanivis/math/floats"
+)
+
+func testClass[FLOAT Float](t *testing.T) {
+	t.Helper()
+
+	inf, negZero := Inf[FLOAT](1), CopySign(0, FLOAT(-1))
+	tiny := NextUp(FLOAT(0))
+
+	// A NaN with only the lowest bit of its significand set is signaling in every binary format.
+	// A float32 one is quieted if it is widened to a float64, so it must be classified from its own bits.
+	snan := FromBits[FLOAT](Bits[uint64](inf) | 1)
+	negSNaN := CopySign(snan, -1)
+
+	tests := []struct {
+		x FLOAT
+
+		expect floats.Class
+	}{
+		{0, floats.PositiveZero},
+		{negZero, floats.NegativeZero},
+		{tiny, floats.PositiveSubnormal},
+		{-tiny, floats.NegativeSubnormal},
+		{1, floats.PositiveNormal},
+		{-1, floats.NegativeNormal},
+		{NextDown(inf), floats.PositiveNormal},
+		{inf, floats.PositiveInfinity},
+		{-inf, floats.NegativeInfinity},
+		{NaN[FLOAT](), floats.QuietNaN},
+		{CopySign(NaN[FLOAT](), -1), floats.QuietNaN},
+		{snan, floats.SignalingNaN},
+		{negSNaN, floats.SignalingNaN},
+	}
+
+	for _, tt := range tests {
+		if got := Class(tt.x); got != tt.expect {
+			t.Errorf("%T: Class(%#x) = %v, expected %v", tt.x, Bits[uint64](tt.x), got, tt.expect)
+		}
+
+		checks := []struct {
+			name   string
+			fn     func(FLOAT) bool
+			expect bool
+		}{
+			{"IsSignaling", IsSignaling[FLOAT], tt.expect == floats.SignalingNaN},
+			{"IsZero", IsZero[FLOAT], tt.expect == floats.PositiveZero || tt.expect == floats.NegativeZero},
+			{"IsFinite", IsFinite[FLOAT], tt.expect >= floats.NegativeNormal && tt.expect <= floats.PositiveNormal},
+			{"IsNormal", IsNormal[FLOAT], tt.expect == floats.PositiveNormal || tt.expect == floats.NegativeNormal},
+			{"IsSubnormal", IsSubnormal[FLOAT], tt.expect == floats.PositiveSubnormal || tt.expect == floats.NegativeSubnormal},
+		}
+
+		for _, c := range checks {
+			if got := c.fn(tt.x); got != c.expect {
+				t.Errorf("%T: %s(%#x) = %t, expected %t", tt.x, c.name, Bits[uint64](tt.x), got, c.expect)
+			}
+		}
+	}
+}
+
+func TestClass(t *testing.T) {
+	testClass[float32](t)
+	testClass[float64](t)
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/puellanivis/math/bits"
+	"github.com/puellanivis/math/floats"
+)
+
+// format is one of the floats formats, with its encoding.
+type format struct {
+	name      string
+	short     string
+	mantWidth int
+	expWidth  int
+
+	// fromBits returns the value encoded by u, and convert returns the encoding of the value c converted to the format.
+	fromBits func(u *big.Int) converter
+	convert  func(c converter) *big.Int
+}
+
+// converter is a floats value, converted to each format by the floats package.
+type converter interface {
+	Float16() floats.Float16
+	BFloat16() floats.BFloat16
+	Float32() floats.Float32
+	Float64() floats.Float64
+	Float128() floats.Float128
+}
+
+var formats = []*format{
+	{
+		name: "Float16", short: "f16", mantWidth: 10, expWidth: 5,
+		fromBits: func(u *big.Int) converter { return floats.Float16FromBits(uint16(u.Uint64())) },
+		convert:  func(c converter) *big.Int { return encoding(c.Float16().Bits()) },
+	},
+	{
+		name: "BFloat16", short: "bf16", mantWidth: 7, expWidth: 8,
+		fromBits: func(u *big.Int) converter { return floats.BFloat16FromBits(uint16(u.Uint64())) },
+		convert:  func(c converter) *big.Int { return encoding(c.BFloat16().Bits()) },
+	},
+	{
+		name: "Float32", short: "f32", mantWidth: 23, expWidth: 8,
+		fromBits: func(u *big.Int) converter { return floats.Float32FromBits(uint32(u.Uint64())) },
+		convert:  func(c converter) *big.Int { return encoding(c.Float32().Bits()) },
+	},
+	{
+		name: "Float64", short: "f64", mantWidth: 52, expWidth: 11,
+		fromBits: func(u *big.Int) converter { return floats.Float64FromBits(u.Uint64()) },
+		convert:  func(c converter) *big.Int { return encoding(c.Float64().Bits()) },
+	},
+	{
+		name: "Float128", short: "f128", mantWidth: 112, expWidth: 15,
+		fromBits: func(u *big.Int) converter {
+			return floats.Float128WithRoundFromBits[floats.RoundTiesToEven](uint128(u))
+		},
+		convert: func(c converter) *big.Int { return encoding(c.Float128().Bits()) },
+	},
+}
+
+func lookup(name string) (*format, error) {
+	for _, f := range formats {
+		if name == f.short || name == f.name {
+			return f, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown format %q", name)
+}
+
+func (f *format) width() int {
+	return 1 + f.expWidth + f.mantWidth
+}
+
+func (f *format) bias() int {
+	return 1<<(f.expWidth-1) - 1
+}
+
+func (f *format) nanBits() *big.Int {
+	u := big.NewInt(1<<(f.expWidth+1) - 1)
+	return u.Lsh(u, uint(f.mantWidth-1))
+}
+
+// decode returns the exact value encoded by u, or false if u encodes a NaN.
+func (f *format) decode(u *big.Int) (*big.Float, bool) {
+	neg := u.Bit(f.width()-1) == 1
+
+	exp := int(new(big.Int).Rsh(u, uint(f.mantWidth)).Int64()) & (1<<f.expWidth - 1)
+	mant := new(big.Int).And(u, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(f.mantWidth)), big.NewInt(1)))
+
+	v := new(big.Float).SetPrec(uint(f.mantWidth + 1))
+
+	switch exp {
+	case 1<<f.expWidth - 1:
+		if mant.Sign() != 0 {
+			return nil, false
+		}
+		v.SetInf(neg)
+		return v, true
+
+	case 0:
+		// Subnormals have the same exponent as the smallest normal numbers.
+		exp = 1
+
+	default:
+		mant.SetBit(mant, f.mantWidth, 1)
+	}
+
+	v.SetInt(mant)
+	v.SetMantExp(v, exp-f.bias()-f.mantWidth)
+
+	if neg {
+		v.Neg(v)
+	}
+
+	return v, true
+}
+
+// hex returns the bits u in hexadecimal.
+func (f *format) hex(u *big.Int) string {
+	return fmt.Sprintf("0x%0*x", f.width()/4, u)
+}
+
+// text returns the value encoded by u in decimal, with the fewest digits that identify it,
+// then in hexadecimal.
+func (f *format) text(u *big.Int) string {
+	v, ok := f.decode(u)
+	if !ok {
+		return "NaN"
+	}
+
+	if v.IsInf() {
+		return v.String()
+	}
+
+	return fmt.Sprintf("%s (%s)", v.Text('g', -1), v.Text('x', -1))
+}
+
+// facts are the properties of a value, as given by the floats package.
+type facts struct {
+	fields        string
+	class         floats.Class
+	up, down, ulp *big.Int
+}
+
+// float is the set of methods of the floats types that facts are taken from.
+type float[T any, B uint16 | uint32 | uint64 | bits.Uint128] interface {
+	fmt.Formatter
+
+	Bits() B
+	Class() floats.Class
+	NextUp() T
+	NextDown() T
+	ULP() T
+}
+
+func factsOf[T float[T, B], B uint16 | uint32 | uint64 | bits.Uint128](x T) facts {
+	return facts{
+		fields: fmt.Sprintf("%b", x),
+		class:  x.Class(),
+		up:     encoding(x.NextUp().Bits()),
+		down:   encoding(x.NextDown().Bits()),
+		ulp:    encoding(x.ULP().Bits()),
+	}
+}
+
+func (f *format) facts(u *big.Int) facts {
+	switch c := f.fromBits(u).(type) {
+	case floats.Float16:
+		return factsOf(c)
+	case floats.BFloat16:
+		return factsOf(c)
+	case floats.Float32:
+		return factsOf(c)
+	case floats.Float64:
+		return factsOf(c)
+	case floats.Float128:
+		return factsOf(c)
+	}
+
+	panic("unknown format " + f.name)
+}
+
+func encoding[B uint16 | uint32 | uint64 | bits.Uint128](b B) *big.Int {
+	switch b := any(b).(type) {
+	case uint16:
+		return new(big.Int).SetUint64(uint64(b))
+	case uint32:
+		return new(big.Int).SetUint64(uint64(b))
+	case uint64:
+		return new(big.Int).SetUint64(b)
+	case bits.Uint128:
+		u := new(big.Int).SetUint64(b.Hi)
+		u.Lsh(u, 64)
+		return u.Or(u, new(big.Int).SetUint64(b.Lo))
+	}
+
+	panic("impossible encoding type")
+}
+
+func uint128(u *big.Int) bits.Uint128 {
+	return bits.Uint128{
+		Hi: new(big.Int).Rsh(u, 64).Uint64(),
+		Lo: new(big.Int).And(u, new(big.Int).SetUint64(^uint64(0))).Uint64(),
+	}
+}
+
+// rounding is a rounding mode, with the conversion of a value to each format rounded per it.
+type rounding struct {
+	name  string
+	round func(f *format, v *big.Float) *big.Int
+}
+
+// roundings are the rounding modes, with RoundTiesToEven, the default, first.
+var roundings = []rounding{
+	{"RoundTiesToEven", round[floats.RoundTiesToEven]},
+	{"RoundTiesToAway", round[floats.RoundTiesToAway]},
+	{"RoundTiesToZero", round[floats.RoundTiesToZero]},
+	{"RoundTiesToOdd", round[floats.RoundTiesToOdd]},
+	{"RoundTowardZero", round[floats.RoundTowardZero]},
+	{"RoundTowardPositive", round[floats.RoundTowardPositive]},
+	{"RoundTowardNegative", round[floats.RoundTowardNegative]},
+}
+
+// round returns the encoding in the format f of v, rounded per RND.
+func round[RND floats.RoundingMode](f *format, v *big.Float) *big.Int {
+	switch f.short {
+	case "f16":
+		return encoding(floats.Float16WithRoundFromFloat[RND](v).Bits())
+	case "bf16":
+		return encoding(floats.BFloat16WithRoundFromFloat[RND](v).Bits())
+	case "f32":
+		return encoding(floats.Float32WithRoundFromFloat[RND](v).Bits())
+	case "f64":
+		return encoding(floats.Float64WithRoundFromFloat[RND](v).Bits())
+	case "f128":
+		return encoding(floats.Float128WithRoundFromFloat[RND](v).Bits())
+	}
+
+	panic("unknown format " + f.name)
+}
@@ -0,0 +1,191 @@
+// Command floatinfo describes a value in each of the floats formats:
+// Float16, BFloat16, Float32, Float64, and Float128.
+//
+// Usage:
+//
+//	floatinfo [flags] value...
+//
+// A value is a decimal or hexadecimal floating-point number, such as 0.1, -2.5e-8, or 0x1.8p3,
+// or one of inf, -inf, and nan.
+// With -bits, each value is instead the hexadecimal bit pattern of a value in the format given,
+// such as -bits f16 3c00.
+//
+// For each format, floatinfo shows the value rounded to nearest, ties to even,
+// its bits, its sign, exponent, and mantissa fields, its class, the values next up and down from it, and its ULP,
+// then the result and rounding error under each rounding mode, in absolute terms and in ULPs.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+var (
+	bitsFormat = flag.String("bits", "", "read values as the hexadecimal bits of `format`: f16, bf16, f32, f64, or f128")
+	formatList = flag.String("formats", "all", "comma-separated `list` of formats to describe: f16, bf16, f32, f64, f128, or all")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] value...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(os.Stdout, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "floatinfo:", err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, args []string) error {
+	selected := formats
+	if *formatList != "all" {
+		selected = nil
+
+		for _, name := range strings.Split(*formatList, ",") {
+			f, err := lookup(name)
+			if err != nil {
+				return fmt.Errorf("-formats: %w", err)
+			}
+			selected = append(selected, f)
+		}
+	}
+
+	var from *format
+	if *bitsFormat != "" {
+		f, err := lookup(*bitsFormat)
+		if err != nil {
+			return fmt.Errorf("-bits: %w", err)
+		}
+		from = f
+	}
+
+	for i, arg := range args {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		in, err := parse(arg, from)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "%s\n", arg)
+
+		for _, f := range selected {
+			describe(w, f, in)
+		}
+	}
+
+	return nil
+}
+
+// input is a parsed value: either exact, or a NaN carried in a floats value.
+type input struct {
+	exact *big.Float
+	nan   converter
+}
+
+// inputPrec is the precision of decimal values as parsed,
+// so far beyond that of any format that the rounding error is given to many digits.
+const inputPrec = 4096
+
+func parse(s string, from *format) (input, error) {
+	if from != nil {
+		u, ok := new(big.Int).SetString(strings.TrimPrefix(strings.ToLower(s), "0x"), 16)
+		if !ok || u.Sign() < 0 || u.BitLen() > from.width() {
+			return input{}, fmt.Errorf("%q is not the bits of a %s", s, from.name)
+		}
+
+		if v, ok := from.decode(u); ok {
+			return input{exact: v}, nil
+		}
+
+		return input{nan: from.fromBits(u)}, nil
+	}
+
+	switch strings.ToLower(strings.TrimPrefix(s, "+")) {
+	case "nan", "-nan":
+		return input{nan: formats[len(formats)-1].fromBits(formats[len(formats)-1].nanBits())}, nil
+	}
+
+	v, _, err := big.ParseFloat(s, 0, inputPrec, big.ToNearestEven)
+	if err != nil {
+		return input{}, fmt.Errorf("%q is not a number: %w", s, err)
+	}
+
+	return input{exact: v}, nil
+}
+
+// describe writes the description of the input in the format f.
+func describe(w io.Writer, f *format, in input) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	defer tw.Flush()
+
+	var u *big.Int
+	if in.nan != nil {
+		u = f.convert(in.nan)
+	} else {
+		u = roundings[0].round(f, in.exact)
+	}
+
+	x := f.facts(u)
+
+	fmt.Fprintf(tw, "%s\n", f.name)
+	fmt.Fprintf(tw, "  bits\t%s\n", f.hex(u))
+	fmt.Fprintf(tw, "  fields\t%s\n", x.fields)
+	fmt.Fprintf(tw, "  class\t%v\n", x.class)
+	fmt.Fprintf(tw, "  value\t%s\n", f.text(u))
+	fmt.Fprintf(tw, "  next up\t%s\t%s\n", f.hex(x.up), f.text(x.up))
+	fmt.Fprintf(tw, "  next down\t%s\t%s\n", f.hex(x.down), f.text(x.down))
+	fmt.Fprintf(tw, "  ulp\t%s\t%s\n", f.hex(x.ulp), f.text(x.ulp))
+
+	if in.exact == nil {
+		return
+	}
+
+	ulp, _ := f.decode(x.ulp)
+
+	for _, r := range roundings {
+		ru := r.round(f, in.exact)
+
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", r.name, f.hex(ru), f.text(ru), roundingError(f, ru, in.exact, ulp))
+	}
+}
+
+// roundingError describes the error of the result u in the format f, rounded from v,
+// both absolutely and relative to the ULP of the value rounded to nearest.
+func roundingError(f *format, u *big.Int, v, ulp *big.Float) string {
+	r, _ := f.decode(u)
+
+	switch {
+	case r.IsInf() && v.IsInf():
+		return "exact"
+	case r.IsInf():
+		return "overflow"
+	}
+
+	e := new(big.Float).SetPrec(inputPrec).Sub(r, v)
+	if e.Sign() == 0 {
+		return "exact"
+	}
+
+	if ulp == nil || ulp.IsInf() {
+		return fmt.Sprintf("%+.7g", e)
+	}
+
+	ulps, _ := new(big.Float).Quo(e, ulp).Float64()
+
+	return fmt.Sprintf("%+.7g (%+.4f ulp)", e, ulps)
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/puellanivis/math/floats"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		format string
+		bits   string
+		expect string
+	}{
+		{"f16", "3c00", "1"},
+		{"f16", "7bff", "65504"},
+		{"f16", "0001", "5.9604644775390625e-08"},
+		{"f16", "8000", "-0"},
+		{"f16", "fc00", "-Inf"},
+		{"bf16", "3f80", "1"},
+		{"f32", "3f800000", "1"},
+		{"f64", "4000000000000000", "2"},
+		{"f128", "3fff0000000000000000000000000000", "1"},
+		{"f128", "c0008000000000000000000000000000", "-3"},
+	}
+
+	for _, tt := range tests {
+		f, err := lookup(tt.format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		u, _ := new(big.Int).SetString(tt.bits, 16)
+
+		v, ok := f.decode(u)
+		if !ok {
+			t.Errorf("%s %s: decoded as NaN", tt.format, tt.bits)
+			continue
+		}
+
+		if got := v.Text('g', 20); got != tt.expect {
+			t.Errorf("%s %s: decoded as %s, expected %s", tt.format, tt.bits, got, tt.expect)
+		}
+	}
+
+	f, _ := lookup("f128")
+	if _, ok := f.decode(f.nanBits()); ok {
+		t.Errorf("%s: did not decode as NaN", f.hex(f.nanBits()))
+	}
+}
+
+func TestRoundings(t *testing.T) {
+	f, _ := lookup("f16")
+
+	v, _, err := big.ParseFloat("0.1", 0, inputPrec, big.ToNearestEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 0.1 lies 0.4 ULP above 0x2e66.
+	expect := map[string]uint64{
+		"RoundTiesToEven":     0x2e66,
+		"RoundTiesToAway":     0x2e66,
+		"RoundTiesToZero":     0x2e66,
+		"RoundTiesToOdd":      0x2e66,
+		"RoundTowardZero":     0x2e66,
+		"RoundTowardPositive": 0x2e67,
+		"RoundTowardNegative": 0x2e66,
+	}
+
+	for _, r := range roundings {
+		if got := r.round(f, v).Uint64(); got != expect[r.name] {
+			t.Errorf("%s: 0.1 rounded to %04x, expected %04x", r.name, got, expect[r.name])
+		}
+	}
+}
+
+func TestFacts(t *testing.T) {
+	f, _ := lookup("f16")
+
+	x := f.facts(big.NewInt(0x3c00))
+
+	if x.fields != "0_01111_0000000000" {
+		t.Errorf("fields = %s, expected 0_01111_0000000000", x.fields)
+	}
+
+	if x.class != floats.PositiveNormal {
+		t.Errorf("class = %v, expected %v", x.class, floats.PositiveNormal)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		got    *big.Int
+		expect int64
+	}{
+		{"next up", x.up, 0x3c01},
+		{"next down", x.down, 0x3bff},
+		{"ulp", x.ulp, 0x1400},
+	} {
+		if tt.got.Int64() != tt.expect {
+			t.Errorf("%s = %04x, expected %04x", tt.name, tt.got, tt.expect)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	defer func(bits, list string) {
+		*bitsFormat, *formatList = bits, list
+	}(*bitsFormat, *formatList)
+
+	tests := []struct {
+		bits, formats string
+		args          []string
+		expect        []string
+	}{
+		{"", "f16", []string{"1"}, []string{"Float16\n", "0x3c00", "0_01111_0000000000", "positiveNormal", "exact"}},
+		{"", "f32,f64", []string{"-0.1"}, []string{"Float32\n", "0xbdcccccd", "Float64\n", "0xbfb999999999999a", "+0.6000 ulp"}},
+		{"", "f16", []string{"70000"}, []string{"+Inf", "overflow", "0x7bff"}},
+		{"", "f128", []string{"nan"}, []string{"quietNaN"}},
+		{"bf16", "f32", []string{"3f81"}, []string{"0x3f810000", "1.0078125 (0x1.02p+00)"}},
+	}
+
+	for _, tt := range tests {
+		*bitsFormat, *formatList = tt.bits, tt.formats
+
+		var b bytes.Buffer
+		if err := run(&b, tt.args); err != nil {
+			t.Errorf("%v: %v", tt.args, err)
+			continue
+		}
+
+		for _, s := range tt.expect {
+			if !strings.Contains(b.String(), s) {
+				t.Errorf("%v: output does not contain %q:\n%s", tt.args, s, &b)
+			}
+		}
+	}
+
+	*bitsFormat, *formatList = "f16", "all"
+	if err := run(new(bytes.Buffer), []string{"10000"}); err == nil {
+		t.Error("17 bits read as the bits of a Float16")
+	}
+}
@@ -0,0 +1,676 @@
+package math
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/puellanivis/math/floats"
+)
+
+// DoubleDouble is an unevaluated sum of two float64 values, hi + lo, where |lo| ≤ ½ulp(hi).
+// It provides about 106 bits of precision, with the same exponent range as float64.
+//
+// The zero value is 0.
+type DoubleDouble struct {
+	hi, lo float64
+}
+
+// Decimal expansions to over 300 bits, used to build the multi-part constants.
+const (
+	pi90  = "3.141592653589793238462643383279502884197169399375105820974944592307816406286208998628034825342117"
+	ln290 = "0.693147180559945309417232121458176568075500134360255254120680009493393621969694715605863326996418"
+)
+
+// Multi-part constants with more precision than any of the types, which are used for argument reduction.
+var (
+	piParts  = splitDecimal(pi90, 5)
+	ln2Parts = splitDecimal(ln290, 5)
+)
+
+// Constants for DoubleDouble.
+var (
+	DoubleDoublePi  = DoubleDouble{piParts[0], piParts[1]}
+	DoubleDoubleLn2 = DoubleDouble{ln2Parts[0], ln2Parts[1]}
+)
+
+// ddEps is the relative precision of a DoubleDouble, beyond which series terms are negligible.
+const ddEps = 0x1p-106
+
+// DoubleDoubleFromFloat64 returns x as a DoubleDouble.
+func DoubleDoubleFromFloat64(x float64) DoubleDouble {
+	return DoubleDouble{hi: x}
+}
+
+// DoubleDoubleFromFloat128 returns x rounded to the nearest DoubleDouble.
+func DoubleDoubleFromFloat128(x floats.Float128) DoubleDouble {
+	var parts [2]float64
+	splitFloat128(parts[:], x)
+
+	return DoubleDouble{parts[0], parts[1]}
+}
+
+// ParseDoubleDouble parses s as a decimal or hexadecimal floating-point number,
+// and returns the nearest DoubleDouble.
+// It accepts the same syntax as big.ParseFloat, as well as "NaN".
+func ParseDoubleDouble(s string) (DoubleDouble, error) {
+	var parts [2]float64
+	if err := parseParts(parts[:], s); err != nil {
+		return DoubleDouble{}, err
+	}
+
+	return DoubleDouble{parts[0], parts[1]}, nil
+}
+
+// Parts returns the hi and lo parts of x, such that x == hi + lo, and |lo| ≤ ½ulp(hi).
+func (x DoubleDouble) Parts() (hi, lo float64) {
+	return x.hi, x.lo
+}
+
+// Float64 returns x rounded to the nearest float64.
+func (x DoubleDouble) Float64() float64 {
+	return x.hi
+}
+
+// Float128 returns x rounded to the nearest floats.Float128.
+func (x DoubleDouble) Float128() floats.Float128 {
+	// Float128 can hold hi exactly, so the addition has only one rounding.
+	return floats.Float128FromFloat(x.hi).Add(floats.Float128FromFloat(x.lo))
+}
+
+// QuadDouble returns x as a QuadDouble.
+func (x DoubleDouble) QuadDouble() QuadDouble {
+	return QuadDouble{[4]float64{x.hi, x.lo}}
+}
+
+// BigFloat returns x as a *big.Float, with enough precision to hold x exactly.
+func (x DoubleDouble) BigFloat() *big.Float {
+	return sumParts(x.hi, x.lo)
+}
+
+// String returns x formatted as the shortest decimal that rounds to x at 106 bits of precision.
+// A lo part far below the precision of hi is rounded away, so the result need not parse back into x.
+func (x DoubleDouble) String() string {
+	return fmt.Sprint(x)
+}
+
+// Format implements fmt.Formatter, accepting the same verbs as *big.Float.
+func (x DoubleDouble) Format(f fmt.State, verb rune) {
+	formatParts(f, verb, 106, x.hi, x.lo)
+}
+
+// IsNaN reports whether x is a “not-a-number” value.
+func (x DoubleDouble) IsNaN() bool {
+	return IsNaN(x.hi)
+}
+
+// IsInf reports whether x is an infinity, according to sign.
+// If sign > 0, IsInf reports whether x is positive infinity.
+// If sign < 0, IsInf reports whether x is negative infinity.
+// If sign == 0, IsInf reports whether x is either infinity.
+func (x DoubleDouble) IsInf(sign int) bool {
+	return IsInf(x.hi, sign)
+}
+
+// Sign returns -1 if x < 0, 0 if x is ±0 or NaN, and +1 if x > 0.
+func (x DoubleDouble) Sign() int {
+	switch {
+	case x.hi < 0:
+		return -1
+	case x.hi > 0:
+		return +1
+	}
+
+	return 0
+}
+
+// Cmp compares x and y and returns:
+//
+//	-1 if x < y
+//	 0 if x == y (incl. -0 == 0)
+//	+1 if x > y
+//
+// The result is 0, if either x or y is NaN.
+func (x DoubleDouble) Cmp(y DoubleDouble) int {
+	return cmpParts(x.hi, y.hi, x.lo, y.lo)
+}
+
+// Neg returns -x.
+func (x DoubleDouble) Neg() DoubleDouble {
+	return DoubleDouble{-x.hi, -x.lo}
+}
+
+// Abs returns the absolute value of x.
+func (x DoubleDouble) Abs() DoubleDouble {
+	if SignBit(x.hi) {
+		return x.Neg()
+	}
+
+	return x
+}
+
+// Add returns the sum of x+y.
+//
+// Special cases are the same as for float64 addition.
+func (x DoubleDouble) Add(y DoubleDouble) DoubleDouble {
+	s, e := twoSum(x.hi, y.hi)
+	if !isFinite(s) {
+		return DoubleDouble{hi: s}
+	}
+
+	t, f := twoSum(x.lo, y.lo)
+
+	e += t
+	s, e = quickTwoSum(s, e)
+	e += f
+
+	return ddRenorm(s, e)
+}
+
+// Sub returns the difference of x-y.
+//
+// Special cases are the same as for float64 subtraction.
+func (x DoubleDouble) Sub(y DoubleDouble) DoubleDouble {
+	return x.Add(y.Neg())
+}
+
+// Mul returns the product of x*y.
+//
+// Special cases are the same as for float64 multiplication.
+func (x DoubleDouble) Mul(y DoubleDouble) DoubleDouble {
+	p, e := twoProd(x.hi, y.hi)
+	if !isFinite(p) || p == 0 {
+		return DoubleDouble{hi: p}
+	}
+
+	e += x.hi*y.lo + x.lo*y.hi
+
+	return ddRenorm(p, e)
+}
+
+// mulFloat64 returns the product of x*y, where y is a float64.
+func (x DoubleDouble) mulFloat64(y float64) DoubleDouble {
+	p, e := twoProd(x.hi, y)
+	if !isFinite(p) || p == 0 {
+		return DoubleDouble{hi: p}
+	}
+
+	e += x.lo * y
+
+	return ddRenorm(p, e)
+}
+
+// Div returns the quotient of x/y.
+//
+// Special cases are the same as for float64 division.
+func (x DoubleDouble) Div(y DoubleDouble) DoubleDouble {
+	q0 := x.hi / y.hi
+	if !isFinite(q0) || q0 == 0 || IsInf(y.hi, 0) {
+		return DoubleDouble{hi: q0}
+	}
+
+	r := x.Sub(y.mulFloat64(q0))
+	q1 := r.hi / y.hi
+
+	r = r.Sub(y.mulFloat64(q1))
+	q2 := r.hi / y.hi
+
+	q0, q1 = quickTwoSum(q0, q1)
+
+	return DoubleDouble{q0, q1}.Add(DoubleDouble{hi: q2})
+}
+
+// Sqrt returns the square root of x.
+//
+// Special cases are:
+//
+//	+Inf.Sqrt() = +Inf
+//	±0.Sqrt() = ±0
+//	x.Sqrt() = NaN, if x < 0
+//	NaN.Sqrt() = NaN
+func (x DoubleDouble) Sqrt() DoubleDouble {
+	switch {
+	case x.hi == 0, IsInf(x.hi, 1), IsNaN(x.hi):
+		return x
+	case x.hi < 0:
+		return DoubleDouble{hi: NaN64()}
+	}
+
+	// Scale x by an even power of two near 1, so that ax² can neither overflow nor underflow.
+	k := math.Ilogb(x.hi) / 2
+	x = x.ldexp(-2 * k)
+
+	// Karp’s trick: one Newton step on the float64 reciprocal square root.
+	r := 1 / Sqrt(x.hi)
+	ax := x.hi * r
+
+	diff := x.Sub(DoubleDouble{hi: ax}.Mul(DoubleDouble{hi: ax}))
+
+	return DoubleDouble{hi: ax}.Add(DoubleDouble{hi: diff.hi * (r * 0.5)}).ldexp(k)
+}
+
+// Exp returns e**x, the base-e exponential of x.
+//
+// Special cases are:
+//
+//	+Inf.Exp() = +Inf
+//	-Inf.Exp() = 0
+//	NaN.Exp() = NaN
+//
+// Very large values overflow to +Inf, and very small values underflow to 0.
+func (x DoubleDouble) Exp() DoubleDouble {
+	switch {
+	case IsNaN(x.hi), IsInf(x.hi, 1):
+		return x
+	case x.hi > expOverflow:
+		return DoubleDouble{hi: Inf64(1)}
+	case x.hi < expUnderflow:
+		return DoubleDouble{}
+	case x.hi == 0:
+		return DoubleDouble{hi: 1}
+	}
+
+	// Reduce x = k×ln2 + r, with |r| ≤ ½ln2, then r by a further 2**expScale.
+	k := RoundToEven(x.hi / Ln2)
+
+	r := x
+	for _, p := range ln2Parts[:3] {
+		r = r.Sub(DoubleDouble{hi: k}.mulFloat64(p))
+	}
+	r = r.ldexp(-expScale)
+
+	// Taylor series of e**r - 1.
+	s, t := r, r
+	for i := 2; ; i++ {
+		t = t.Mul(r).Div(DoubleDouble{hi: float64(i)})
+		s = s.Add(t)
+
+		if Abs(t.hi) <= Abs(s.hi)*ddEps {
+			break
+		}
+	}
+
+	// e**2r - 1 = 2(e**r - 1) + (e**r - 1)²
+	for i := 0; i < expScale; i++ {
+		s = s.mulFloat64(2).Add(s.Mul(s))
+	}
+
+	return s.Add(DoubleDouble{hi: 1}).ldexp(int(k))
+}
+
+// Log returns the natural logarithm of x.
+//
+// Special cases are:
+//
+//	+Inf.Log() = +Inf
+//	0.Log() = -Inf
+//	x.Log() = NaN, if x < 0
+//	NaN.Log() = NaN
+func (x DoubleDouble) Log() DoubleDouble {
+	switch {
+	case IsNaN(x.hi), IsInf(x.hi, 1):
+		return x
+	case x.hi == 0:
+		return DoubleDouble{hi: Inf64(-1)}
+	case x.hi < 0:
+		return DoubleDouble{hi: NaN64()}
+	case x.hi == 1 && x.lo == 0:
+		return DoubleDouble{}
+	}
+
+	// Reduce x = m×2**k, with √½ ≤ m < √2, so that log(m) is small.
+	k := math.Ilogb(x.hi)
+	m := x.ldexp(-k)
+	if m.hi > Sqrt2 {
+		m = m.ldexp(-1)
+		k++
+	}
+
+	one := DoubleDouble{hi: 1}
+
+	var y DoubleDouble
+	if Abs(m.hi-1) < logNearOne {
+		// Near 1, the Newton step would cancel catastrophically, so use log(m) = 2atanh(z),
+		// where z = (m-1)/(m+1), and atanh(z) = z + z³/3 + z⁵/5 + …
+		z := m.Sub(one).Div(m.Add(one))
+		zz := z.Mul(z)
+
+		s, t := z, z
+		for i := 3; ; i += 2 {
+			t = t.Mul(zz)
+			u := t.Div(DoubleDouble{hi: float64(i)})
+			s = s.Add(u)
+
+			if Abs(u.hi) <= Abs(s.hi)*ddEps {
+				break
+			}
+		}
+
+		y = s.mulFloat64(2)
+	} else {
+		// One Newton step, y = y + m×e**-y - 1, doubles the precision of the float64 logarithm.
+		y = DoubleDouble{hi: Log(m.hi)}
+		y = y.Add(m.Mul(y.Neg().Exp())).Sub(one)
+	}
+
+	// log(x) = k×ln2 + log(m)
+	var kln2 DoubleDouble
+	for _, p := range ln2Parts[:3] {
+		kln2 = kln2.Add(DoubleDouble{hi: float64(k)}.mulFloat64(p))
+	}
+
+	return kln2.Add(y)
+}
+
+// Sin returns the sine of the radian argument x.
+//
+// Special cases are:
+//
+//	±0.Sin() = ±0
+//	±Inf.Sin() = NaN
+//	NaN.Sin() = NaN
+//
+// The argument is reduced against as many bits of π as it needs, so large arguments keep their full precision.
+func (x DoubleDouble) Sin() DoubleDouble {
+	sin, _ := x.SinCos()
+	return sin
+}
+
+// Cos returns the cosine of the radian argument x.
+//
+// Special cases are:
+//
+//	±Inf.Cos() = NaN
+//	NaN.Cos() = NaN
+//
+// The argument is reduced against as many bits of π as it needs, so large arguments keep their full precision.
+func (x DoubleDouble) Cos() DoubleDouble {
+	_, cos := x.SinCos()
+	return cos
+}
+
+// SinCos returns x.Sin(), x.Cos().
+func (x DoubleDouble) SinCos() (sin, cos DoubleDouble) {
+	switch {
+	case x.hi == 0:
+		return x, DoubleDouble{hi: 1}
+	case !isFinite(x.hi):
+		nan := DoubleDouble{hi: NaN64()}
+		return nan, nan
+	}
+
+	// Reduce x = j×π/2 + r, with |r| ≤ π/4.
+	r, j := x, 0
+	if Abs(x.hi) > Pi/4 {
+		var rem *big.Float
+		rem, j = reduceHalfPi(x.hi, x.lo)
+
+		var parts [2]float64
+		splitBig(parts[:], rem)
+		r = DoubleDouble{parts[0], parts[1]}
+	}
+
+	// Taylor series of sin(r) and cos(r).
+	rr := r.Mul(r)
+
+	sin, t := r, r
+	for i := 3; i < trigTerms; i += 2 {
+		t = t.Mul(rr).Div(DoubleDouble{hi: float64(-(i - 1) * i)})
+		sin = sin.Add(t)
+
+		if Abs(t.hi) <= Abs(sin.hi)*ddEps {
+			break
+		}
+	}
+
+	cos, t = DoubleDouble{hi: 1}, DoubleDouble{hi: 1}
+	for i := 2; i < trigTerms; i += 2 {
+		t = t.Mul(rr).Div(DoubleDouble{hi: float64(-(i - 1) * i)})
+		cos = cos.Add(t)
+
+		if Abs(t.hi) <= ddEps {
+			break
+		}
+	}
+
+	switch j {
+	case 1:
+		return cos, sin.Neg()
+	case 2:
+		return sin.Neg(), cos.Neg()
+	case 3:
+		return cos.Neg(), sin
+	}
+
+	return sin, cos
+}
+
+// ldexp returns x×2**k, scaling each part.
+func (x DoubleDouble) ldexp(k int) DoubleDouble {
+	return DoubleDouble{math.Ldexp(x.hi, k), math.Ldexp(x.lo, k)}
+}
+
+// ddRenorm returns hi + lo as a DoubleDouble, with lo no larger than half an ulp of hi.
+func ddRenorm(hi, lo float64) DoubleDouble {
+	hi, lo = quickTwoSum(hi, lo)
+	if !isFinite(hi) {
+		return DoubleDouble{hi: hi}
+	}
+
+	return DoubleDouble{hi, lo}
+}
+
+// Limits beyond which the exponential certainly overflows or underflows.
+const (
+	expOverflow  = 709.79
+	expUnderflow = -745.2
+
+	// expScale is the power of two by which the reduced argument is further reduced.
+	expScale = 10
+
+	// logNearOne is how close to 1 an argument must be for the logarithm to use a series instead.
+	logNearOne = 1.0 / 16
+)
+
+// Limits of the argument reduction and series used for the sine and cosine.
+const (
+	// reducePrec is the precision of the remainder of the argument reduction,
+	// beyond the integral bits of the quotient of x and π/2.
+	// It leaves the full precision of a QuadDouble, even after a cancellation of hundreds of bits.
+	reducePrec = 640
+
+	// halfPiPrec is the precision of π/2, which is enough to reduce the largest finite argument.
+	halfPiPrec = 1024 + reducePrec + 64
+
+	// trigTerms bounds the series, which converge well within it for any reduced argument.
+	trigTerms = 200
+)
+
+// twoSum returns the rounded sum of x+y, and the exact error of that rounding.
+func twoSum(x, y float64) (sum, err float64) {
+	sum = x + y
+	return sum, twoSumErr(x, y, sum)
+}
+
+// quickTwoSum returns the rounded sum of x+y, and the exact error of that rounding,
+// assuming that |x| ≥ |y|, or x is zero.
+func quickTwoSum(x, y float64) (sum, err float64) {
+	sum = x + y
+	return sum, y - (sum - x)
+}
+
+// twoProd returns the rounded product of x*y, and the exact error of that rounding.
+func twoProd(x, y float64) (prod, err float64) {
+	// The explicit conversion prevents the compiler from fusing this multiply into anything else.
+	prod = float64(x * y)
+	return prod, FMA(x, y, -prod)
+}
+
+func isFinite(x float64) bool {
+	return !IsNaN(x) && !IsInf(x, 0)
+}
+
+// cmpParts compares two multi-part values, given as pairs of their parts in order of significance.
+func cmpParts(pairs ...float64) int {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		x, y := pairs[i], pairs[i+1]
+
+		switch {
+		case IsNaN(x), IsNaN(y):
+			return 0
+		case x < y:
+			return -1
+		case x > y:
+			return +1
+		}
+	}
+
+	return 0
+}
+
+// bigPrec is enough precision to hold the exact sum of any float64 values that do not overflow.
+const bigPrec = 2200
+
+// sumParts returns the exact sum of the parts as a *big.Float.
+// Infinities and NaNs are not handled.
+func sumParts(parts ...float64) *big.Float {
+	sum := new(big.Float).SetPrec(bigPrec)
+
+	for _, p := range parts {
+		sum.Add(sum, new(big.Float).SetFloat64(p))
+	}
+
+	return sum
+}
+
+// bigHalfPi returns π/2 to halfPiPrec bits, which is computed only once.
+var bigHalfPi = sync.OnceValue(func() *big.Float {
+	// π = 16 atan(⅕) - 4 atan(1/239)
+	pi := new(big.Float).SetPrec(halfPiPrec+32).Mul(bigAtanInv(5), big.NewFloat(16))
+	pi.Sub(pi, new(big.Float).Mul(bigAtanInv(239), big.NewFloat(4)))
+
+	return pi.SetMantExp(pi, -1).SetPrec(halfPiPrec)
+})
+
+// bigAtanInv returns atan(1/n) to more than halfPiPrec bits.
+func bigAtanInv(n int64) *big.Float {
+	// atan(1/n) = Σ (-1)**k / ((2k+1) n**(2k+1))
+	wp := uint(halfPiPrec + 32)
+
+	sum := new(big.Float).SetPrec(wp)
+	pow := new(big.Float).SetPrec(wp).Quo(big.NewFloat(1), new(big.Float).SetInt64(n))
+	nn := new(big.Float).SetPrec(wp).SetInt64(n * n)
+	term := new(big.Float).SetPrec(wp)
+
+	for k := int64(0); pow.Sign() != 0 && pow.MantExp(nil) > -int(wp); k++ {
+		term.Quo(pow, new(big.Float).SetInt64(2*k+1))
+		if k&1 == 1 {
+			term.Neg(term)
+		}
+		sum.Add(sum, term)
+
+		pow.Quo(pow, nn)
+	}
+
+	return sum
+}
+
+// reduceHalfPi returns the remainder x - j×π/2, where x is the exact sum of the finite parts,
+// and j is the integer nearest to x/(π/2), along with j mod 4.
+func reduceHalfPi(parts ...float64) (r *big.Float, j int) {
+	x := sumParts(parts...)
+
+	// The product j×π/2 must be exact to reducePrec bits below the units place.
+	prec := uint(max(x.MantExp(nil), 0) + reducePrec)
+	halfPi := new(big.Float).SetPrec(prec).Set(bigHalfPi())
+
+	q := new(big.Float).SetPrec(prec).Quo(x, halfPi)
+
+	// Round the quotient to the nearest integer, with ties away from zero.
+	half := big.NewFloat(0.5)
+	if q.Sign() < 0 {
+		half.Neg(half)
+	}
+
+	n, _ := q.Add(q, half).Int(nil)
+
+	jpi := new(big.Float).SetPrec(prec + uint(n.BitLen())).SetInt(n)
+	jpi.Mul(jpi, halfPi)
+
+	r = new(big.Float).SetPrec(bigPrec).Sub(x, jpi)
+
+	return r, int(new(big.Int).Mod(n, big.NewInt(4)).Int64())
+}
+
+// splitBig sets the parts, from most to least significant, to the nearest float64 values summing to v.
+// v is consumed in the process.
+func splitBig(parts []float64, v *big.Float) {
+	for i := range parts {
+		parts[i], _ = v.Float64()
+
+		if IsInf(parts[i], 0) || parts[i] == 0 {
+			return
+		}
+
+		v.Sub(v, new(big.Float).SetFloat64(parts[i]))
+	}
+}
+
+// splitDecimal parses a constant, and splits it into n parts.
+func splitDecimal(s string, n int) []float64 {
+	parts := make([]float64, n)
+	if err := parseParts(parts, s); err != nil {
+		panic(err)
+	}
+
+	return parts
+}
+
+// splitFloat128 sets the parts to the nearest float64 values summing to x.
+func splitFloat128(parts []float64, x floats.Float128) {
+	for i := range parts {
+		parts[i] = math.Float64frombits(x.Float64().Bits())
+
+		if !isFinite(parts[i]) || parts[i] == 0 {
+			return
+		}
+
+		// This subtraction is exact, as parts[i] is the nearest float64 to x.
+		x = x.Sub(floats.Float128FromFloat(parts[i]))
+	}
+}
+
+func parseParts(parts []float64, s string) error {
+	if strings.EqualFold(strings.TrimLeft(s, "+-"), "nan") {
+		parts[0] = NaN64()
+		return nil
+	}
+
+	v, _, err := big.ParseFloat(s, 0, bigPrec, big.ToNearestEven)
+	if err != nil {
+		return err
+	}
+
+	if v.IsInf() {
+		parts[0] = Inf64(v.Sign())
+		return nil
+	}
+
+	splitBig(parts, v)
+	return nil
+}
+
+func formatParts(f fmt.State, verb rune, prec uint, parts ...float64) {
+	switch hi := parts[0]; {
+	case IsNaN(hi):
+		fmt.Fprintf(f, fmt.FormatString(f, verb), hi)
+		return
+	case IsInf(hi, 0):
+		new(big.Float).SetInf(hi < 0).Format(f, verb)
+		return
+	}
+
+	sumParts(parts...).SetPrec(prec).Format(f, verb)
+}
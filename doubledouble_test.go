@@ -0,0 +1,101 @@
+package math
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// relErr returns |got - expect| / |expect|, with got and expect as exact *big.Float values.
+func relErr(got, expect *big.Float) float64 {
+	diff := new(big.Float).SetPrec(bigPrec).Sub(got, expect)
+	diff.Quo(diff, expect)
+
+	f, _ := diff.Abs(diff).Float64()
+	return f
+}
+
+func TestDoubleDoubleArith(t *testing.T) {
+	x := DoubleDouble{1, 0x1p-60}
+	y := DoubleDouble{3, -0x1p-55}
+
+	tests := []struct {
+		name   string
+		got    DoubleDouble
+		expect *big.Float
+	}{
+		{"Add", x.Add(y), new(big.Float).SetPrec(bigPrec).Add(x.BigFloat(), y.BigFloat())},
+		{"Sub", x.Sub(y), new(big.Float).SetPrec(bigPrec).Sub(x.BigFloat(), y.BigFloat())},
+		{"Mul", x.Mul(y), new(big.Float).SetPrec(bigPrec).Mul(x.BigFloat(), y.BigFloat())},
+		{"Div", x.Div(y), new(big.Float).SetPrec(bigPrec).Quo(x.BigFloat(), y.BigFloat())},
+		{"Sqrt", y.Sqrt(), new(big.Float).SetPrec(bigPrec).Sqrt(y.BigFloat())},
+	}
+
+	for _, tt := range tests {
+		if err := relErr(tt.got.BigFloat(), tt.expect); err > 0x1p-100 {
+			t.Errorf("%s = %v, relative error %g", tt.name, tt.got, err)
+		}
+	}
+}
+
+func TestDoubleDoubleSinCos(t *testing.T) {
+	for _, x := range []float64{1e-300, 0.5, 1, Pi / 4, 3, 100, 1e6, 1e15, 0x1p52 - 1, 0x1p52, 1e22, -1e300, MaxFloat64} {
+		sin, cos := DoubleDoubleFromFloat64(x).SinCos()
+
+		// sin² + cos² = 1
+		one := sin.Mul(sin).Add(cos.Mul(cos))
+		if err := math.Abs(one.Sub(DoubleDouble{hi: 1}).Float64()); err > 0x1p-100 {
+			t.Errorf("SinCos(%g): sin² + cos² = %v", x, one)
+		}
+
+		if got, expect := sin.Float64(), math.Sin(x); math.Abs(got-expect) > 0x1p-50 {
+			t.Errorf("Sin(%g) = %v, expected about %v", x, got, expect)
+		}
+
+		if got, expect := cos.Float64(), math.Cos(x); math.Abs(got-expect) > 0x1p-50 {
+			t.Errorf("Cos(%g) = %v, expected about %v", x, got, expect)
+		}
+	}
+
+	// sin(10²²), from a reduction against a long π.
+	expect, _, _ := big.ParseFloat("-0.85220084976718880177270589375302936826176215041004365625650932602591", 10, 256, big.ToNearestEven)
+	if got := DoubleDoubleFromFloat64(1e22).Sin(); relErr(got.BigFloat(), expect) > 0x1p-100 {
+		t.Errorf("Sin(1e22) = %v, expected %v", got, expect)
+	}
+
+	for _, x := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		sin, cos := DoubleDoubleFromFloat64(x).SinCos()
+		if !sin.IsNaN() || !cos.IsNaN() {
+			t.Errorf("SinCos(%g) = %v, %v, expected NaN, NaN", x, sin, cos)
+		}
+	}
+
+	if sin := DoubleDoubleFromFloat64(math.Copysign(0, -1)).Sin(); !SignBit(sin.Float64()) {
+		t.Errorf("Sin(-0) = %v, expected -0", sin)
+	}
+}
+
+func TestDoubleDoubleString(t *testing.T) {
+	tests := []DoubleDouble{
+		{1, 0},
+		{0.1, 0},
+		{1, 0x1p-60},
+		{1, 0x1p-200},
+		{-3, 0x1p-1000},
+		{0x1p-1000, -0x1p-1074},
+	}
+
+	// The result parses back to a value that rounds to the same number of bits as x.
+	for _, x := range tests {
+		s := x.String()
+
+		got, err := ParseDoubleDouble(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := got.BigFloat().SetPrec(106), x.BigFloat().SetPrec(106); g.Cmp(e) != 0 {
+			t.Errorf("ParseDoubleDouble(%q) = %v, expected %v", s, g, e)
+		}
+	}
+}
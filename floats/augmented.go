@@ -0,0 +1,111 @@
+package floats
+
+// augmentedAdd returns x+y rounded to nearest with ties toward zero, and the exact error of that rounding.
+//
+// The error is found using Knuth’s branch-free TwoSum algorithm,
+// which holds for any round-to-nearest rounding mode.
+func augmentedAdd[SPEC spec[D], D datum](x, y D) (sum, err D) {
+	var rounding RoundTiesToZero
+
+	sum = add[SPEC](x, y, rounding)
+	if isNaN[SPEC](sum) || isInf[SPEC](sum) {
+		return sum, sum
+	}
+
+	xʹ := sub[SPEC](sum, y, rounding)
+	yʹ := sub[SPEC](sum, xʹ, rounding)
+
+	Δx := sub[SPEC](x, xʹ, rounding)
+	Δy := sub[SPEC](y, yʹ, rounding)
+
+	err = add[SPEC](Δx, Δy, rounding)
+
+	return sum, signedErr[SPEC](sum, err)
+}
+
+// augmentedMul returns x*y rounded to nearest with ties toward zero, and the error of that rounding.
+// The error is exact, unless it is too small to be represented.
+func augmentedMul[SPEC spec[D], D datum](x, y D) (prod, err D) {
+	var rounding RoundTiesToZero
+	var spec SPEC
+
+	prod = mul[SPEC](x, y, rounding)
+
+	_, m := mag[SPEC](prod)
+	if spec.IsZero(m) || spec.Gte(m, magInf[SPEC]()) {
+		return prod, prod
+	}
+
+	return prod, signedErr[SPEC](prod, prodErr[SPEC](x, y, prod, rounding))
+}
+
+// prodErr returns the error of prod, the finite non-zero product x*y rounded.
+// The error is exact, unless it is too small to be represented.
+func prodErr[SPEC spec[D], D datum](x, y, prod D, rounding RoundingMode) D {
+	// Scale x and y into [½, 1), so that the error terms can neither overflow nor underflow.
+	xfr, xexp := frexp[SPEC](x)
+	yfr, yexp := frexp[SPEC](y)
+	k := xexp + yexp
+
+	p, e := twoProd[SPEC](xfr, yfr, rounding)
+
+	// p×2**k can differ from prod, if prod was rounded into the sub-normals.
+	// This difference is exact, as p and prod×2**-k are of about the same magnitude.
+	d := sub[SPEC](p, ldexp[SPEC](prod, -k, rounding), rounding)
+
+	return ldexp[SPEC](add[SPEC](d, e, rounding), k, rounding)
+}
+
+// signedErr returns err, unless it is a zero, in which case it returns a zero with the same sign as r.
+func signedErr[SPEC spec[D], D datum](r, err D) D {
+	var spec SPEC
+
+	_, m := mag[SPEC](err)
+	if spec.IsZero(m) {
+		return copySign[SPEC](err, r)
+	}
+
+	return err
+}
+
+// twoProd returns x*y rounded, and the exact error of that rounding,
+// using Dekker’s product with Veltkamp’s splitting.
+//
+// It assumes that x*y neither overflows nor underflows.
+func twoProd[SPEC spec[D], D datum](x, y D, rounding RoundingMode) (prod, err D) {
+	prod = mul[SPEC](x, y, rounding)
+
+	xhi, xlo := split[SPEC](x, rounding)
+	yhi, ylo := split[SPEC](y, rounding)
+
+	// err = ((xhi×yhi - prod) + xhi×ylo + xlo×yhi) + xlo×ylo
+	err = msub[SPEC](xhi, yhi, prod, rounding)
+	err = add[SPEC](err, mul[SPEC](xhi, ylo, rounding), rounding)
+	err = add[SPEC](err, mul[SPEC](xlo, yhi, rounding), rounding)
+	err = add[SPEC](err, mul[SPEC](xlo, ylo, rounding), rounding)
+
+	return prod, err
+}
+
+// split returns hi and lo, such that x == hi + lo exactly,
+// and each of hi and lo fit into half of the precision of x.
+//
+// It assumes that x×(2**⌈p/2⌉+1) does not overflow.
+func split[SPEC spec[D], D datum](x D, rounding RoundingMode) (hi, lo D) {
+	var spec SPEC
+
+	// c = 2**⌈p/2⌉ + 1, where p = mantWidth + 1
+	s := (spec.mantWidth() + 2) / 2
+	c := spec.Or(spec.Shl(spec.FromInt(expBias[SPEC]()+s), spec.mantWidth()), spec.Pow2(spec.mantWidth()-s))
+
+	t := mul[SPEC](c, x, rounding)
+	hi = sub[SPEC](t, sub[SPEC](t, x, rounding), rounding)
+	lo = sub[SPEC](x, hi, rounding)
+
+	return hi, lo
+}
+
+// augmentedSub returns x-y rounded to nearest with ties toward zero, and the exact error of that rounding.
+func augmentedSub[SPEC spec[D], D datum](x, y D) (diff, err D) {
+	return augmentedAdd[SPEC](x, neg[SPEC](y))
+}
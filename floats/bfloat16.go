@@ -133,6 +133,30 @@ func (x BFloat16WithRound[RND]) IsNaN() bool {
 	return isNaN[bfloat16](x.bits)
 }
 
+func (x BFloat16WithRound[RND]) Class() Class {
+	return class[bfloat16](x.bits)
+}
+
+func (x BFloat16WithRound[RND]) IsSignaling() bool {
+	return isSignaling[bfloat16](x.bits)
+}
+
+func (x BFloat16WithRound[RND]) IsZero() bool {
+	return isZero[bfloat16](x.bits)
+}
+
+func (x BFloat16WithRound[RND]) IsFinite() bool {
+	return isFinite[bfloat16](x.bits)
+}
+
+func (x BFloat16WithRound[RND]) IsNormal() bool {
+	return isNormal[bfloat16](x.bits)
+}
+
+func (x BFloat16WithRound[RND]) IsSubnormal() bool {
+	return isSubnormal[bfloat16](x.bits)
+}
+
 func (x BFloat16WithRound[RND]) Sign() int {
 	return getSign[bfloat16](x.bits)
 }
@@ -161,6 +185,14 @@ func (x BFloat16WithRound[RND]) NextDown() BFloat16WithRound[RND] {
 	return BFloat16WithRound[RND]{nextDown[bfloat16](x.bits)}
 }
 
+func (x BFloat16WithRound[RND]) NextAfter(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	return BFloat16WithRound[RND]{nextAfter[bfloat16](x.bits, y.bits)}
+}
+
+func (x BFloat16WithRound[RND]) ULP() BFloat16WithRound[RND] {
+	return BFloat16WithRound[RND]{ulp[bfloat16](x.bits)}
+}
+
 func (x BFloat16WithRound[RND]) Add(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
 	var rnd RND
 
@@ -185,6 +217,34 @@ func (x BFloat16WithRound[RND]) Mul(y BFloat16WithRound[RND]) BFloat16WithRound[
 	return BFloat16WithRound[RND]{mul[bfloat16](x.bits, y.bits, rnd)}
 }
 
+func (x BFloat16WithRound[RND]) FMA(y, z BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	var rnd RND
+
+	var f [3]float64
+	decodeBFloat16s64(f[:], []uint16{x.bits, y.bits, z.bits})
+
+	if r := fmaOdd(f[0], f[1], f[2], rnd); r == r {
+		return BFloat16WithRoundFromFloat[RND](r)
+	}
+
+	return BFloat16WithRound[RND]{madd[bfloat16](x.bits, y.bits, z.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) AugmentedAdd(y BFloat16WithRound[RND]) (sum, err BFloat16WithRound[RND]) {
+	s, e := augmentedAdd[bfloat16](x.bits, y.bits)
+	return BFloat16WithRound[RND]{s}, BFloat16WithRound[RND]{e}
+}
+
+func (x BFloat16WithRound[RND]) AugmentedSub(y BFloat16WithRound[RND]) (diff, err BFloat16WithRound[RND]) {
+	d, e := augmentedSub[bfloat16](x.bits, y.bits)
+	return BFloat16WithRound[RND]{d}, BFloat16WithRound[RND]{e}
+}
+
+func (x BFloat16WithRound[RND]) AugmentedMul(y BFloat16WithRound[RND]) (prod, err BFloat16WithRound[RND]) {
+	p, e := augmentedMul[bfloat16](x.bits, y.bits)
+	return BFloat16WithRound[RND]{p}, BFloat16WithRound[RND]{e}
+}
+
 func (x BFloat16WithRound[RND]) Div(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
 	var rnd RND
 
@@ -197,6 +257,10 @@ func (x BFloat16WithRound[RND]) Mod(y BFloat16WithRound[RND]) BFloat16WithRound[
 	return BFloat16WithRound[RND]{mod[bfloat16](x.bits, y.bits, rnd)}
 }
 
+func (x BFloat16WithRound[RND]) Remainder(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	return BFloat16WithRound[RND]{remainder[bfloat16](x.bits, y.bits)}
+}
+
 func (x BFloat16WithRound[RND]) ModF() (i, f BFloat16WithRound[RND]) {
 	q, r := modf[bfloat16](x.bits)
 	return BFloat16WithRound[RND]{q}, BFloat16WithRound[RND]{r}
@@ -211,8 +275,8 @@ func (x BFloat16WithRound[RND]) Compare(y BFloat16WithRound[RND]) int {
 }
 
 func (x BFloat16WithRound[RND]) Equal(y BFloat16WithRound[RND]) bool {
-	order, _ := fcmp[bfloat16](x.bits, y.bits)
-	return order == 0
+	order, ordered := fcmp[bfloat16](x.bits, y.bits)
+	return order == 0 && ordered
 }
 
 func (x BFloat16WithRound[RND]) Cmp(y BFloat16WithRound[RND]) (order int, ordered bool) {
@@ -239,6 +303,22 @@ func (x BFloat16WithRound[RND]) MaxMag(y BFloat16WithRound[RND]) BFloat16WithRou
 	return BFloat16WithRound[RND]{fmaxMag[bfloat16](x.bits, y.bits)}
 }
 
+func (x BFloat16WithRound[RND]) Minimum(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	return BFloat16WithRound[RND]{fminimum[bfloat16](x.bits, y.bits)}
+}
+
+func (x BFloat16WithRound[RND]) Maximum(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	return BFloat16WithRound[RND]{fmaximum[bfloat16](x.bits, y.bits)}
+}
+
+func (x BFloat16WithRound[RND]) MinimumMag(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	return BFloat16WithRound[RND]{fminimumMag[bfloat16](x.bits, y.bits)}
+}
+
+func (x BFloat16WithRound[RND]) MaximumMag(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	return BFloat16WithRound[RND]{fmaximumMag[bfloat16](x.bits, y.bits)}
+}
+
 func (x BFloat16WithRound[RND]) Round() BFloat16WithRound[RND] {
 	return BFloat16WithRound[RND]{round[bfloat16](x.bits)}
 }
@@ -289,6 +369,133 @@ func (x BFloat16WithRound[RND]) Exp2() BFloat16WithRound[RND] {
 	return BFloat16WithRound[RND]{exp2[bfloat16](x.bits, rnd)}
 }
 
+func (x BFloat16WithRound[RND]) Exp10() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{exp10[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) ExpM1() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{expM1[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Exp2M1() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{exp2M1[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Exp10M1() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{exp10M1[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Log1p() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{log1p[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Log2p1() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{log2P1[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Log10p1() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{log10P1[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Pow(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{pow[bfloat16](x.bits, y.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Pown(n int) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{pown[bfloat16](x.bits, n, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Powr(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{powr[bfloat16](x.bits, y.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Rootn(n int) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{rootn[bfloat16](x.bits, n, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Cbrt() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{rootn[bfloat16](x.bits, 3, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Compound(n int) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{compound[bfloat16](x.bits, n, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Erf() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{erf[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Erfc() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{erfc[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Gamma() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{gamma[bfloat16](x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) LnGamma() (lngamma BFloat16WithRound[RND], sign int) {
+	var rnd RND
+
+	l, sign := lnGamma[bfloat16](x.bits, rnd)
+	return BFloat16WithRound[RND]{l}, sign
+}
+
+func (x BFloat16WithRound[RND]) J0() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{besselJ[bfloat16](0, x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) J1() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{besselJ[bfloat16](1, x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Y0() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{besselY[bfloat16](0, x.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) Y1() BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{besselY[bfloat16](1, x.bits, rnd)}
+}
+
 func (x BFloat16WithRound[RND]) LogB() BFloat16WithRound[RND] {
 	return BFloat16WithRound[RND]{logb[bfloat16](x.bits)}
 }
@@ -296,3 +503,59 @@ func (x BFloat16WithRound[RND]) LogB() BFloat16WithRound[RND] {
 func (x BFloat16WithRound[RND]) ILogB() (int, bool) {
 	return ilogb[bfloat16](x.bits)
 }
+
+func (x BFloat16WithRound[RND]) ScaleB(n int) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{ldexp[bfloat16](x.bits, n, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) FrExp() (frac BFloat16WithRound[RND], exp int) {
+	f, exp := frexp[bfloat16](x.bits)
+	return BFloat16WithRound[RND]{f}, exp
+}
+
+func (x BFloat16WithRound[RND]) LdExp(exp int) BFloat16WithRound[RND] {
+	return x.ScaleB(exp)
+}
+
+// The following unexported methods provide the reductions needed by Sum and Dot.
+
+func (x BFloat16WithRound[RND]) sum(xs []BFloat16WithRound[RND], alg SumAlgorithm) BFloat16WithRound[RND] {
+	return BFloat16Sum(xs, alg)
+}
+
+func (x BFloat16WithRound[RND]) dot(xs, ys []BFloat16WithRound[RND], alg SumAlgorithm) BFloat16WithRound[RND] {
+	return BFloat16Dot(xs, ys, alg)
+}
+
+// The following unexported methods provide the elementary functions needed by Complex.
+
+func (x BFloat16WithRound[RND]) fromFloat64(v float64) BFloat16WithRound[RND] {
+	return BFloat16WithRoundFromFloat[RND](v)
+}
+
+func (x BFloat16WithRound[RND]) ldexp(exp int) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{ldexp[bfloat16](x.bits, exp, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) logHypot(y BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{logHypot[bfloat16](x.bits, y.bits, rnd)}
+}
+
+func (x BFloat16WithRound[RND]) sinCos() (sin, cos BFloat16WithRound[RND]) {
+	var rnd RND
+
+	s, c := sinCos[bfloat16](x.bits, rnd)
+	return BFloat16WithRound[RND]{s}, BFloat16WithRound[RND]{c}
+}
+
+func (y BFloat16WithRound[RND]) atan2(x BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{atan2[bfloat16](y.bits, x.bits, rnd)}
+}
@@ -3,6 +3,7 @@ package floats
 import (
 	"math"
 	"math/big"
+	"math/rand/v2"
 	"testing"
 )
 
@@ -124,6 +125,47 @@ func TestBFloat16Numbers(t *testing.T) {
 	}
 }
 
+func TestBFloat16ConvertFloat16(t *testing.T) {
+	type test struct {
+		name string
+		f16  uint16
+		bf16 uint16
+	}
+
+	// Float16 and BFloat16 share a width, but not an encoding.
+	tests := []test{
+		{"one", 0x3c00, 0x3f80},
+		{"-two", 0xc000, 0xc000},
+		{"max", 0x7bff, 0x4780}, // rounds up to 65536
+		{"third", 0x3555, 0x3eab},
+		{"tiny", 0x0001, 0x3380}, // subnormal in Float16, but not in BFloat16
+		{"-inf", 0xfc00, 0xff80},
+		{"nan", 0x7e00, 0x7fc0},
+		{"nan low payload", 0x7c01, 0x7fc0}, // the payload does not fit, but it remains a NaN
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Float16FromBits(tt.f16).BFloat16().Bits(); got != tt.bf16 {
+				t.Errorf("Float16FromBits(%#04x).BFloat16() = %#04x, expected %#04x", tt.f16, got, tt.bf16)
+			}
+		})
+	}
+
+	for _, tt := range []test{
+		{"one", 0x3c00, 0x3f80},
+		{"third", 0x3558, 0x3eab},
+		{"overflow", 0x7c00, 0x4780},
+		{"underflow", 0x0000, 0x0001},
+		{"nan", 0x7e00, 0x7fc0},
+	} {
+		if got := BFloat16FromBits(tt.bf16).Float16().Bits(); got != tt.f16 {
+			t.Errorf("%s: BFloat16FromBits(%#04x).Float16() = %#04x, expected %#04x", tt.name, tt.bf16, got, tt.f16)
+		}
+	}
+}
+
 func TestBFloat16OpAdd(t *testing.T) {
 	type test struct {
 		name string
@@ -312,3 +354,336 @@ func TestBFloat16OpSqrt(t *testing.T) {
 		})
 	}
 }
+
+// bulkValuesBF16 returns float32 values around every bfloat16 value, and the midpoints between them.
+func bulkValuesBF16() []float32 {
+	var xs []float32
+
+	for h := uint32(0); h <= 0x7f80; h++ {
+		u := h << 16
+
+		for _, v := range []uint32{u - 1, u, u + 1, u + 1<<15 - 1, u + 1<<15, u + 1<<15 + 1} {
+			xs = append(xs, math.Float32frombits(v), math.Float32frombits(v|1<<31))
+		}
+	}
+
+	return append(xs, float32(math.NaN()), float32(math.Inf(1)), float32(math.Inf(-1)))
+}
+
+func testEncodeBFloat16s[RND RoundingMode](t *testing.T, xs []float32) {
+	t.Helper()
+
+	dst := make([]uint16, len(xs))
+
+	if n := EncodeBFloat16sWithRound[RND](dst, xs); n != len(xs) {
+		t.Fatalf("EncodeBFloat16sWithRound[%T](float32) converted %d values, but expected %d", *new(RND), n, len(xs))
+	}
+
+	for i, x := range xs {
+		if expect := BFloat16WithRoundFromFloat[RND](x).Bits(); dst[i] != expect {
+			t.Errorf("EncodeBFloat16sWithRound[%T](%08x) = %04x, but expected %04x", *new(RND), math.Float32bits(x), dst[i], expect)
+		}
+	}
+
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		// Nudge the values, so that they are not exactly representable as float32.
+		ys[i] = math.Nextafter(float64(x), float64(i%3-1))
+	}
+
+	EncodeBFloat16sWithRound[RND](dst, ys)
+
+	for i, y := range ys {
+		if expect := BFloat16WithRoundFromFloat[RND](y).Bits(); dst[i] != expect {
+			t.Errorf("EncodeBFloat16sWithRound[%T](%016x) = %04x, but expected %04x", *new(RND), math.Float64bits(y), dst[i], expect)
+		}
+	}
+}
+
+func TestBFloat16Bulk(t *testing.T) {
+	xs := bulkValuesBF16()
+
+	testEncodeBFloat16s[RoundTiesToEven](t, xs)
+	testEncodeBFloat16s[RoundTiesToAway](t, xs)
+	testEncodeBFloat16s[RoundTiesToOdd](t, xs)
+	testEncodeBFloat16s[RoundTiesToZero](t, xs)
+	testEncodeBFloat16s[RoundTowardZero](t, xs)
+	testEncodeBFloat16s[RoundTowardPositive](t, xs)
+	testEncodeBFloat16s[RoundTowardNegative](t, xs)
+
+	src := make([]uint16, 1<<16)
+	for i := range src {
+		src[i] = uint16(i)
+	}
+
+	f32s := make([]float32, len(src))
+	f64s := make([]float64, len(src))
+
+	DecodeBFloat16s(f32s, src)
+	DecodeBFloat16s(f64s, src)
+
+	for i, h := range src {
+		f := BFloat16FromBits(h)
+
+		if got, expect := math.Float32bits(f32s[i]), f.Float32().Bits(); got != expect {
+			t.Errorf("DecodeBFloat16s(%04x) = %08x, but expected %08x", h, got, expect)
+		}
+
+		if got, expect := math.Float64bits(f64s[i]), f.Float64().Bits(); got != expect {
+			t.Errorf("DecodeBFloat16s(%04x) = %016x, but expected %016x", h, got, expect)
+		}
+	}
+}
+
+// exactFMABF16 returns x*y+a computed exactly, and rounded once to a BFloat16, where a nil a adds nothing.
+func exactFMABF16[RND RoundingMode](x, y BFloat16WithRound[RND], a *BFloat16WithRound[RND]) BFloat16WithRound[RND] {
+	p := x.Float64().Native() * y.Float64().Native() // exact
+	if a == nil {
+		return BFloat16WithRoundFromFloat[RND](p)
+	}
+
+	af := a.Float64().Native()
+
+	if math.IsInf(p, 0) || math.IsInf(af, 0) || p != p || af != af {
+		return BFloat16WithRoundFromFloat[RND](p + af)
+	}
+
+	// Enough precision for any sum of a product and a value in the format.
+	v := new(big.Float).SetPrec(600).SetFloat64(p)
+	if v.Add(v, new(big.Float).SetFloat64(af)).Sign() == 0 {
+		// An exact zero sum is -0 when both terms are -0,
+		// or when rounding toward negative, unless both terms are +0.
+		neg := math.Signbit(p) && math.Signbit(af)
+		if _, ok := any(*new(RND)).(RoundTowardNegative); ok {
+			neg = math.Signbit(p) || math.Signbit(af)
+		}
+
+		if neg {
+			return BFloat16WithRoundFromFloat[RND](math.Copysign(0, -1))
+		}
+
+		return BFloat16WithRoundFromFloat[RND](0.0)
+	}
+
+	return BFloat16WithRoundFromFloat[RND](v)
+}
+
+func testBFloat16Vec[RND RoundingMode](t *testing.T) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(16, 3))
+
+	// Not a multiple of the block size, so that the last block is partial.
+	const n = 1000
+
+	x, y, a := make(BFloat16VecWithRound[RND], n), make(BFloat16VecWithRound[RND], n), make(BFloat16VecWithRound[RND], n)
+	for i := range x {
+		x[i] = BFloat16WithRoundFromBits[RND](uint16(rng.Uint32()))
+		y[i] = BFloat16WithRoundFromBits[RND](uint16(rng.Uint32()))
+		a[i] = BFloat16WithRoundFromBits[RND](uint16(rng.Uint32()))
+
+		switch i % 8 {
+		case 0:
+			// Cancel exactly.
+			y[i] = x[i].Neg()
+			a[i] = x[i].Mul(x[i]).Neg()
+		case 1:
+			x[i] = BFloat16WithRoundFromBits[RND](uint16(i&2) << 14) // ±0
+		case 2:
+			// Overflow, which only some rounding modes take to infinity.
+			x[i], y[i] = BFloat16WithRoundFromBits[RND](0xff00), BFloat16WithRoundFromBits[RND](0x4100)
+		}
+	}
+
+	var rnd RND
+
+	z := make(BFloat16VecWithRound[RND], n)
+
+	check := func(op string, i int, got, expect BFloat16WithRound[RND]) {
+		if got.bits != expect.bits && !(got.IsNaN() && expect.IsNaN()) {
+			t.Errorf("%T: %s[%d] of %#04x, %#04x, %#04x = %#04x, expected %#04x", rnd, op, i, x[i].bits, y[i].bits, a[i].bits, got.bits, expect.bits)
+		}
+	}
+
+	one := BFloat16WithRoundFromFloat[RND](1.0)
+
+	z.Add(x, y)
+	for i := range z {
+		check("Add", i, z[i], exactFMABF16(x[i], one, &y[i]))
+		check("Add scalar", i, z[i], x[i].Add(y[i]))
+	}
+
+	z.Mul(x, y)
+	for i := range z {
+		check("Mul", i, z[i], exactFMABF16(x[i], y[i], nil))
+		check("Mul scalar", i, z[i], x[i].Mul(y[i]))
+	}
+
+	z.FMA(x, x, a)
+	for i := range z {
+		check("FMA", i, z[i], exactFMABF16(x[i], x[i], &a[i]))
+	}
+
+	z.FMA(x, y, a)
+	for i := range z {
+		check("FMA", i, z[i], exactFMABF16(x[i], y[i], &a[i]))
+		check("FMA scalar", i, z[i], x[i].FMA(y[i], a[i]))
+	}
+
+	z.Scale(a[2], x)
+	for i := range z {
+		check("Scale", i, z[i], exactFMABF16(a[2], x[i], nil))
+	}
+
+	// The destination may be an operand.
+	copy(z, x)
+	z.Add(z, y)
+	for i := range z {
+		check("Add aliased", i, z[i], exactFMABF16(x[i], one, &y[i]))
+	}
+
+	f := make([]float32, n)
+	x.Float32s(f)
+	for i := range f {
+		check("Float32s", i, BFloat16WithRoundFromFloat[RND](f[i]), x[i])
+	}
+
+	for i := range f {
+		f[i] = rng.Float32()*2 - 1
+	}
+	z.SetFloat32s(f)
+	for i := range z {
+		check("SetFloat32s", i, z[i], BFloat16WithRoundFromFloat[RND](f[i]))
+	}
+
+	// The reductions, over finite values, where the exact accumulator agrees with the exact sum.
+	xs, ys := []BFloat16WithRound[RND](x), []BFloat16WithRound[RND](y)
+	for i := range xs {
+		if !xs[i].IsFinite() {
+			xs[i] = one
+		}
+		if !ys[i].IsFinite() {
+			ys[i] = one
+		}
+	}
+
+	if got, expect := x.Sum(AccumulateExact), BFloat16Sum(xs, SumExact); got.bits != expect.bits {
+		t.Errorf("%T: Sum(AccumulateExact) = %#04x, expected %#04x", rnd, got.bits, expect.bits)
+	}
+
+	if got, expect := x.Dot(y, AccumulateExact), BFloat16Dot(xs, ys, SumExact); got.bits != expect.bits {
+		t.Errorf("%T: Dot(AccumulateExact) = %#04x, expected %#04x", rnd, got.bits, expect.bits)
+	}
+
+	var sum32 float32
+	var sum64 float64
+	for i := range x {
+		sum32 += float32(x[i].Float32().Native() * y[i].Float32().Native())
+		sum64 += float64(x[i].Float64().Native() * y[i].Float64().Native())
+	}
+
+	if got, expect := x.Dot(y, AccumulateFloat32), BFloat16WithRoundFromFloat[RND](sum32); got.bits != expect.bits {
+		t.Errorf("%T: Dot(AccumulateFloat32) = %#04x, expected %#04x", rnd, got.bits, expect.bits)
+	}
+
+	if got, expect := x.Dot(y, AccumulateFloat64), BFloat16WithRoundFromFloat[RND](sum64); got.bits != expect.bits {
+		t.Errorf("%T: Dot(AccumulateFloat64) = %#04x, expected %#04x", rnd, got.bits, expect.bits)
+	}
+
+	max := x[0]
+	for _, e := range x {
+		max = max.Max(e)
+	}
+
+	if got := x.Max(); got.bits != max.bits {
+		t.Errorf("%T: Max() = %#04x, expected %#04x", rnd, got.bits, max.bits)
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() {
+		z.FMA(x, y, a)
+		x.Dot(y, AccumulateExact)
+	}); allocs != 0 {
+		t.Errorf("%T: %v allocs per run, expected none", rnd, allocs)
+	}
+}
+
+func TestBFloat16Vec(t *testing.T) {
+	testBFloat16Vec[RoundTiesToEven](t)
+	testBFloat16Vec[RoundTiesToAway](t)
+	testBFloat16Vec[RoundTiesToOdd](t)
+	testBFloat16Vec[RoundTiesToZero](t)
+	testBFloat16Vec[RoundTowardZero](t)
+	testBFloat16Vec[RoundTowardPositive](t)
+	testBFloat16Vec[RoundTowardNegative](t)
+}
+
+func BenchmarkBFloat16FromFloat(b *testing.B) {
+	xs := benchmarkValues(1 << 12)
+	dst := make([]uint16, len(xs))
+
+	b.SetBytes(int64(len(xs) * 4))
+	for i := 0; i < b.N; i++ {
+		for j, x := range xs {
+			dst[j] = BFloat16FromFloat(x).Bits()
+		}
+	}
+}
+
+func BenchmarkEncodeBFloat16s(b *testing.B) {
+	xs := benchmarkValues(1 << 12)
+	dst := make([]uint16, len(xs))
+
+	b.SetBytes(int64(len(xs) * 4))
+	for i := 0; i < b.N; i++ {
+		EncodeBFloat16s(dst, xs)
+	}
+}
+
+func BenchmarkBFloat16Float32(b *testing.B) {
+	src := make([]uint16, 1<<12)
+	EncodeBFloat16s(src, benchmarkValues(len(src)))
+	dst := make([]float32, len(src))
+
+	b.SetBytes(int64(len(src) * 2))
+	for i := 0; i < b.N; i++ {
+		for j, h := range src {
+			dst[j] = BFloat16FromBits(h).Float32().Native()
+		}
+	}
+}
+
+func BenchmarkDecodeBFloat16s(b *testing.B) {
+	src := make([]uint16, 1<<12)
+	EncodeBFloat16s(src, benchmarkValues(len(src)))
+	dst := make([]float32, len(src))
+
+	b.SetBytes(int64(len(src) * 2))
+	for i := 0; i < b.N; i++ {
+		DecodeBFloat16s(dst, src)
+	}
+}
+
+func testBFloat16OpFMA[RND RoundingMode](t *testing.T) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(16, 3))
+
+	for i := 0; i < 1<<14; i++ {
+		x := BFloat16WithRound[RND]{uint16(rng.Uint32())}
+		y := BFloat16WithRound[RND]{uint16(rng.Uint32())}
+		z := BFloat16WithRound[RND]{uint16(rng.Uint32())}
+
+		got, expect := x.FMA(y, z), exactFMABF16(x, y, &z)
+		if got != expect && !(got.IsNaN() && expect.IsNaN()) {
+			t.Errorf("%T: %04x × %04x + %04x = %04x, expected %04x", x, x.bits, y.bits, z.bits, got.bits, expect.bits)
+		}
+	}
+}
+
+func TestBFloat16OpFMA(t *testing.T) {
+	testBFloat16OpFMA[RoundTiesToEven](t)
+	testBFloat16OpFMA[RoundTiesToAway](t)
+	testBFloat16OpFMA[RoundTowardZero](t)
+	testBFloat16OpFMA[RoundTowardPositive](t)
+	testBFloat16OpFMA[RoundTowardNegative](t)
+}
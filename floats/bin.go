@@ -12,58 +12,6 @@ var _ = fmt.Println
 
 type datum = bits.Uint
 
-func set[D2 datum, D1 datum](dst *D2, src D1) {
-	switch v := any(dst).(type) {
-	case *bits.Uint128:
-		switch src := any(src).(type) {
-		case bits.Uint128:
-			*v = src
-		case uint64:
-			v.Lo = uint64(src)
-		case uint32:
-			v.Lo = uint64(src)
-		case uint16:
-			v.Lo = uint64(src)
-		}
-
-	case *uint64:
-		switch src := any(src).(type) {
-		case bits.Uint128:
-			*v = uint64(src.Lo)
-		case uint64:
-			*v = uint64(src)
-		case uint32:
-			*v = uint64(src)
-		case uint16:
-			*v = uint64(src)
-		}
-
-	case *uint32:
-		switch src := any(src).(type) {
-		case bits.Uint128:
-			*v = uint32(src.Lo)
-		case uint64:
-			*v = uint32(src)
-		case uint32:
-			*v = uint32(src)
-		case uint16:
-			*v = uint32(src)
-		}
-
-	case *uint16:
-		switch src := any(src).(type) {
-		case bits.Uint128:
-			*v = uint16(src.Lo)
-		case uint64:
-			*v = uint16(src)
-		case uint32:
-			*v = uint16(src)
-		case uint16:
-			*v = uint16(src)
-		}
-	}
-}
-
 type spec[D datum] interface {
 	width() int
 	expWidth() int
@@ -71,6 +19,7 @@ type spec[D datum] interface {
 
 	exp2OverUnder() (over, under D)
 	expOverUnder() (over, under, nearZero D)
+	exp10OverUnder() (over, under D)
 	ln2HiLoE() (hi, lo, e D)
 	expPN() []D
 
@@ -93,6 +42,7 @@ func fromBigFloat[SPEC spec[D], D datum](v *big.Float, rounding RoundingMode) D
 
 	mant := new(big.Float).SetPrec(uint(spec.width()))
 	exp := v.MantExp(mant)
+	mant.Abs(mant)
 
 	tmp := new(big.Float).SetPrec(uint(spec.width()))
 
@@ -106,26 +56,32 @@ func fromBigFloat[SPEC spec[D], D datum](v *big.Float, rounding RoundingMode) D
 
 	switch any(z).(type) {
 	case bits.Uint128:
-		var t D
-
 		h := new(big.Float).Mul(mant, tmp.SetFloat64(math.Ldexp(1.0, 63)))
 		hi, _ := h.Uint64()
-		set(&t, hi)
 
-		g.m = spec.Shl(t, 64)
+		g.m = spec.Shl(spec.FromUint128(bits.Uint128{Lo: hi}), 64)
 
 		l := new(big.Float).Sub(h, tmp.SetUint64(hi))
 
 		l.Mul(l, tmp.SetFloat64(math.Ldexp(1.0, 64)))
 		lo, _ := l.Uint64()
-		set(&t, lo)
 
-		g.m = spec.Or(g.m, t)
+		g.m = spec.Or(g.m, spec.FromUint128(bits.Uint128{Lo: lo}))
+
+		if l.Cmp(tmp.SetUint64(lo)) != 0 {
+			// Stick any bits that did not fit into the least-significant guard bit.
+			g.m = spec.Or(g.m, spec.Pow2(0))
+		}
 
 	default:
 		h := new(big.Float).Mul(mant, tmp.SetFloat64(math.Ldexp(1.0, spec.width()-1)))
 		hi, _ := h.Uint64()
-		set(&g.m, hi)
+		g.m = spec.FromUint128(bits.Uint128{Lo: hi})
+
+		if h.Cmp(tmp.SetUint64(hi)) != 0 {
+			// Stick any bits that did not fit into the least-significant guard bit.
+			g.m = spec.Or(g.m, spec.Pow2(0))
+		}
 	}
 
 	switch {
@@ -141,6 +97,10 @@ func fromBigFloat[SPEC spec[D], D datum](v *big.Float, rounding RoundingMode) D
 
 	if spec.IsZero(g.m) {
 		// EXCEPTION: underflow
+		// A zero keeps the sign of the value, including that of a negative zero.
+		if g.s {
+			return signMask[SPEC]()
+		}
 		return z
 	}
 
@@ -294,10 +254,8 @@ func convert[SPEC1 spec[D1], SPEC2 spec[D2], D1, D2 datum](bits D1, rounding Rou
 	var spec2 SPEC2
 
 	Δw := spec2.width() - spec1.width()
-	if Δw == 0 {
-		var x D2
-		set(&x, bits)
-		return x
+	if Δw == 0 && spec2.expWidth() == spec1.expWidth() {
+		return spec2.FromUint128(spec1.Uint128(bits))
 	}
 
 	f := decode[SPEC1](bits)
@@ -318,21 +276,23 @@ func convert[SPEC1 spec[D1], SPEC2 spec[D2], D1, D2 datum](bits D1, rounding Rou
 
 	if Δw < 0 {
 		// we’re scaling down, cast after the shift or we will clip out the part we need.
-		set(&g.m, spec1.Shr(f.m, -Δw))
-		if spec2.IsZero(g.m) && !spec1.IsZero(f.m) {
-			// If we got zero, but we did not start with zero,
-			// then we round up the the least-significant guard bit.
-			g.m = spec2.Inc(g.m)
-		}
+		f.shr(-Δw)
+		g.m = spec2.FromUint128(spec1.Uint128(f.m))
 	} else {
 		// we’re scaling up, cast before the shift or we will shift the whole thing into the bitbucket.
-		set(&g.m, f.m)
+		g.m = spec2.FromUint128(spec1.Uint128(f.m))
 		g.m = spec2.Shl(g.m, Δw)
 	}
 
 	if fNaN {
 		// converting up to a larger data-type, and back down needs to preserve the NaN payload.
-		bits := spec2.Or(expMask[SPEC2](), spec2.Shr(g.m, spec2.expWidth()))
+		payload := spec2.Shr(g.m, spec2.expWidth())
+		if spec2.IsZero(payload) {
+			// the payload did not fit, but the result must still be a NaN.
+			payload = quietMask[SPEC2]()
+		}
+
+		bits := spec2.Or(expMask[SPEC2](), payload)
 
 		if f.s {
 			return spec2.Or(bits, signMask[SPEC2]())
@@ -376,10 +336,10 @@ func (f *binary[SPEC, D]) shr(shift int) {
 
 	start := f.m
 	f.m = spec.Shr(start, shift)
-	if spec.IsZero(f.m) && !spec.IsZero(start) {
-		// If we got zero, but did not start with zero,
-		// then we round up into the least-significant guard bit.
-		f.m = spec.Inc(f.m)
+	if spec.Neq(spec.Shl(f.m, shift), start) {
+		// If we shifted out any non-zero bits,
+		// then we stick them into the least-significant guard bit.
+		f.m = spec.Or(f.m, spec.Pow2(0))
 	}
 }
 
@@ -402,8 +362,15 @@ func (f *binary[SPEC, D]) add(inc D) {
 		return
 	}
 
-	f.m = spec.Or(f.m, carry)
-	f.m = spec.Rotl(f.m, -spec.Int(carry))
+	if spec.IsZero(carry) {
+		return
+	}
+
+	// Shift the carry back in at the top,
+	// and stick the bit shifted out into the least-significant guard bit.
+	sticky := spec.And(f.m, spec.Pow2(0))
+	f.m = spec.Or(spec.Shr(f.m, 1), signMask[SPEC]())
+	f.m = spec.Or(f.m, sticky)
 }
 
 func (f *binary[SPEC, D]) sub(dec D) {
@@ -422,25 +389,22 @@ func (f *binary[SPEC, D]) mulPrim(g *binary[SPEC, D]) {
 	var spec SPEC
 	var z D
 
+	f.prenorm()
+	g.prenorm()
+
 	f.s = f.s != g.s
 	f.e += g.e - expBias[SPEC]()
 	f.e++
 
-	if f.e < 1 {
-		// underflow
-		f.e = 1
-		f.m = z
-		return
-	}
-
 	var lo D
 	f.m, lo = spec.Mul(f.m, g.m)
 
 	if !spec.IsZero(lo) {
-		// Round a non-zero low word result up into the least-significant guard bit.
-		f.m = spec.Inc(f.m)
+		// Stick a non-zero low word result into the least-significant guard bit.
+		f.m = spec.Or(f.m, spec.Pow2(0))
 	}
 
+	f.denorm()
 	f.renorm()
 
 	if f.e >= expMax[SPEC]() {
@@ -453,30 +417,27 @@ func (f *binary[SPEC, D]) divPrim(g *binary[SPEC, D]) {
 	var spec SPEC
 	var z D
 
+	f.prenorm()
+	g.prenorm()
+
 	f.s = f.s != g.s
 	f.e -= g.e - expBias[SPEC]()
 	f.e--
 
-	for spec.Gte(f.m, g.m) {
+	if spec.Gte(f.m, g.m) {
 		f.e++
 		f.shr(1)
 	}
 
-	if f.e < 1 {
-		// underflow
-		f.e = 1
-		f.m = z
-		return
-	}
-
 	var rem D
 	f.m, rem = spec.Div(f.m, z, g.m)
 
 	if !spec.IsZero(rem) {
-		// Round a non-zero remainder up into the least-significant guard bit.
-		f.m = spec.Inc(f.m)
+		// Stick a non-zero remainder into the least-significant guard bit.
+		f.m = spec.Or(f.m, spec.Pow2(0))
 	}
 
+	f.denorm()
 	f.renorm()
 
 	if f.e >= expMax[SPEC]() {
@@ -485,6 +446,34 @@ func (f *binary[SPEC, D]) divPrim(g *binary[SPEC, D]) {
 	}
 }
 
+// prenorm shifts the mantissa so that its top bit is set,
+// allowing the exponent to go below the sub-normal exponent.
+// This ensures full precision for the mantissa in multiplication and division.
+func (f *binary[SPEC, D]) prenorm() {
+	var spec SPEC
+
+	lz := spec.Lzcnt(f.m)
+	if lz == spec.width() {
+		return
+	}
+
+	f.shl(lz)
+	f.e -= lz
+}
+
+// denorm shifts the mantissa back into the sub-normal range,
+// if the exponent has gone below the sub-normal exponent.
+func (f *binary[SPEC, D]) denorm() {
+	var spec SPEC
+
+	if f.e >= 1 {
+		return
+	}
+
+	f.shr(min(1-f.e, spec.width()))
+	f.e = 1
+}
+
 func (f *binary[SPEC, D]) renorm() {
 	var spec SPEC
 
@@ -566,6 +555,19 @@ func format[SPEC spec[D], D datum](x D, f fmt.State, verb rune, rounding Roundin
 				return
 			}
 
+			// The exponent of an infinity or NaN is far out of range of a big.Float that could be printed.
+			switch inf, nan := xf.classify(); {
+			case nan:
+				fmt.Fprintf(f, fmt.FormatString(f, verb), math.NaN())
+				return
+			case inf && xf.s:
+				fmt.Fprintf(f, fmt.FormatString(f, verb), math.Inf(-1))
+				return
+			case inf:
+				fmt.Fprintf(f, fmt.FormatString(f, verb), math.Inf(1))
+				return
+			}
+
 			one := 1.0
 			if xf.s {
 				one = -one
@@ -692,6 +694,48 @@ func nextDown[SPEC spec[D], D datum](x D) D {
 	return spec.Dec(x)
 }
 
+func nextAfter[SPEC spec[D], D datum](x, y D) D {
+	order, ordered := fcmp[SPEC](x, y)
+
+	switch {
+	case !ordered:
+		if isNaN[SPEC](x) {
+			return x
+		}
+		return y
+
+	case order < 0:
+		return nextUp[SPEC](x)
+	case order > 0:
+		return nextDown[SPEC](x)
+	}
+
+	return y // nextAfter(±0, ∓0) → ∓0
+}
+
+func ulp[SPEC spec[D], D datum](x D) D {
+	_, m := mag[SPEC](x)
+
+	var spec SPEC
+
+	switch {
+	case spec.Gt(m, magInf[SPEC]()):
+		return x
+	case spec.Eq(m, magInf[SPEC]()):
+		return m
+	}
+
+	// The difference of adjacent floating-point numbers is always exact.
+	var rnd RoundTiesToEven
+
+	if up := nextUp[SPEC](m); !spec.Eq(up, magInf[SPEC]()) {
+		return sub[SPEC](up, m, rnd)
+	}
+
+	// MaxFloat has the same ulp as its predecessor.
+	return sub[SPEC](m, nextDown[SPEC](m), rnd)
+}
+
 func add[SPEC spec[D], D datum](x, y D, rounding RoundingMode) D {
 	f, g := decode[SPEC](x), decode[SPEC](y)
 
@@ -726,6 +770,11 @@ func add[SPEC spec[D], D datum](x, y D, rounding RoundingMode) D {
 
 	if f.s != g.s {
 		f.sub(g.m)
+
+		if f.isZero() {
+			// The values cancel exactly.
+			return zeroSum[SPEC](true, false, rounding)
+		}
 	} else {
 		f.add(g.m)
 	}
@@ -819,6 +868,11 @@ func fcmp[SPEC spec[D], D datum](x, y D) (order int, ordered bool) {
 		return 1, true
 	}
 
+	if !spec.IsZero(xs) {
+		// both are negative, so the larger magnitude is the lesser number
+		return spec.Cmp(ym, xm), true
+	}
+
 	return spec.Cmp(xm, ym), true
 }
 
@@ -931,11 +985,16 @@ func fminMag[SPEC spec[D], D datum](x, y D) D {
 	}
 
 	if order > 0 {
-		// If x > y, then return y.
+		// If |x| > |y|, then return y.
 		return y
 	}
 
-	// Preferentially, return x over y.
+	if order == 0 {
+		// If |x| == |y|, then the signs decide, as per minimumNumber.
+		return fmin[SPEC](x, y)
+	}
+
+	// Otherwise, |x| < |y|, so return x.
 	return x
 }
 
@@ -956,14 +1015,69 @@ func fmaxMag[SPEC spec[D], D datum](x, y D) D {
 	}
 
 	if order < 0 {
-		// If x < y, then return y.
+		// If |x| < |y|, then return y.
 		return y
 	}
 
-	// Preferentially, return x over y.
+	if order == 0 {
+		// If |x| == |y|, then the signs decide, as per maximumNumber.
+		return fmax[SPEC](x, y)
+	}
+
+	// Otherwise, |x| > |y|, so return x.
 	return x
 }
 
+// propagateNaN returns whichever of x or y is a NaN, preferring x, and true;
+// or if neither is a NaN, it returns false.
+func propagateNaN[SPEC spec[D], D datum](x, y D) (D, bool) {
+	switch {
+	case isNaN[SPEC](x):
+		return x, true
+	case isNaN[SPEC](y):
+		return y, true
+	}
+
+	var z D
+	return z, false
+}
+
+// fminimum is IEEE 754-2019 minimum, which propagates NaNs, and orders -0 < +0.
+func fminimum[SPEC spec[D], D datum](x, y D) D {
+	if nan, ok := propagateNaN[SPEC](x, y); ok {
+		return nan
+	}
+
+	return fmin[SPEC](x, y)
+}
+
+// fmaximum is IEEE 754-2019 maximum, which propagates NaNs, and orders -0 < +0.
+func fmaximum[SPEC spec[D], D datum](x, y D) D {
+	if nan, ok := propagateNaN[SPEC](x, y); ok {
+		return nan
+	}
+
+	return fmax[SPEC](x, y)
+}
+
+// fminimumMag is IEEE 754-2019 minimumMagnitude, which propagates NaNs.
+func fminimumMag[SPEC spec[D], D datum](x, y D) D {
+	if nan, ok := propagateNaN[SPEC](x, y); ok {
+		return nan
+	}
+
+	return fminMag[SPEC](x, y)
+}
+
+// fmaximumMag is IEEE 754-2019 maximumMagnitude, which propagates NaNs.
+func fmaximumMag[SPEC spec[D], D datum](x, y D) D {
+	if nan, ok := propagateNaN[SPEC](x, y); ok {
+		return nan
+	}
+
+	return fmaxMag[SPEC](x, y)
+}
+
 // normalize returns a normal number y and exponent exp
 // satisfying mag == y × 2**exp. It assumes x is positive, finite, and non-zero.
 func normalize[SPEC spec[D], D datum](x D) (y D, exp int) {
@@ -975,11 +1089,11 @@ func normalize[SPEC spec[D], D datum](x D) (y D, exp int) {
 
 	var spec SPEC
 
-	// offset necessary to put the top bit of the mantissa into the exponent.
-	exp = (spec.Lzcnt(m) - expBias[SPEC]()) + 1
+	// shift necessary to put the top bit of the mantissa into the exponent.
+	shift := spec.Lzcnt(m) - spec.expWidth()
 
 	// There is no need to mask the implicit top bit out, as it fills in the exponent field for us.
-	return spec.Or(s, spec.Shl(m, exp)), exp
+	return spec.Or(s, spec.Shl(m, shift)), -shift
 }
 
 func frexp[SPEC spec[D], D datum](x D) (frac D, exp int) {
@@ -994,19 +1108,18 @@ func frexp[SPEC spec[D], D datum](x D) (frac D, exp int) {
 		return x, 0
 	}
 
-	_, exp, _ = decomp[SPEC](x)
-
 	x, e := normalize[SPEC](x)
+
+	_, exp, _ = decomp[SPEC](x)
 	exp += e - expBias[SPEC]() + 1
 	x = spec.MaskInsert(x, half[SPEC](), expMask[SPEC]())
 	return x, exp
 }
 
-func ldexp[SPEC spec[D], D datum](frac D, exp int) D {
+func ldexp[SPEC spec[D], D datum](frac D, exp int, rounding RoundingMode) D {
 	s, m := mag[SPEC](frac)
 
 	var spec SPEC
-	var z D
 
 	switch {
 	case spec.IsZero(m):
@@ -1015,26 +1128,31 @@ func ldexp[SPEC spec[D], D datum](frac D, exp int) D {
 		return frac // ±∞ and NaN
 	}
 
+	// Beyond this, any exponent overflows or underflows to zero all the same,
+	// and clamping it keeps the sums below from overflowing int.
+	limit := 2*expBias[SPEC]() + spec.mantWidth() + 2
+	exp = max(min(exp, limit), -limit)
+
 	frac, e := normalize[SPEC](frac)
 	exp += e
 
 	_, e, _ = decomp[SPEC](frac)
 	exp += e - expBias[SPEC]()
 
-	if exp < -(expBias[SPEC]() + spec.mantWidth()) {
-		// underflow
-		return s // ±0
+	if exp > expBias[SPEC]() {
+		// EXCEPTION: overflow
+		return overflow[SPEC](!spec.IsZero(s), rounding)
 	}
 
-	if exp >= expBias[SPEC]() {
-		// overflow
-		return spec.Or(s, magInf[SPEC]()) // ±∞
-	}
+	if exp < 1-expBias[SPEC]() {
+		// subnormal, which may require rounding.
+		f := decode[SPEC](frac)
+		f.shr(min(1-expBias[SPEC]()-exp, spec.width()))
+		f.e = 1
+
+		applyRounding(&f, rounding)
 
-	if exp < -(expBias[SPEC]() - 1) {
-		// subnormal
-		panic("subnormals not handled")
-		return z // TODO correct this
+		return f.encode()
 	}
 
 	ne := spec.Shl(spec.FromInt(exp+expBias[SPEC]()), spec.mantWidth())
@@ -1044,6 +1162,9 @@ func ldexp[SPEC spec[D], D datum](frac D, exp int) D {
 func modf[SPEC spec[D], D datum](x D) (i, f D) {
 	i = trunc[SPEC](x)
 	f = sub[SPEC](x, i, RoundTowardZero{})
+
+	// A zero fraction still takes the sign of x.
+	f = copySign[SPEC](f, x)
 	return
 }
 
@@ -1212,7 +1333,7 @@ func madd[SPEC spec[D], D datum](x, y, z D, rounding RoundingMode) D {
 
 		newSign := f.s != g.s // sign of product x*y
 
-		if newSign != h.s {
+		if hInf && newSign != h.s {
 			// EXCEPTION: illegal operation: adding: ±∞ + ∓∞
 			return nan[SPEC]()
 		}
@@ -1228,7 +1349,7 @@ func madd[SPEC spec[D], D datum](x, y, z D, rounding RoundingMode) D {
 
 		newSign := f.s != g.s // sign of product x*y
 
-		if newSign != h.s {
+		if hInf && newSign != h.s {
 			// EXCEPTION: illegal operation: adding: ±∞ + ∓∞
 			return nan[SPEC]()
 		}
@@ -1236,46 +1357,165 @@ func madd[SPEC spec[D], D datum](x, y, z D, rounding RoundingMode) D {
 		return inf[SPEC](newSign)
 	}
 
-	f.mulPrim(&g)
+	if hInf {
+		return z
+	}
 
-	if f.e == expMax[SPEC]() {
-		// arithmetic overflow to ±∞
+	if f.isZero() || g.isZero() {
+		if h.isZero() {
+			return zeroSum[SPEC](f.s != g.s, h.s, rounding)
+		}
 
-		if !hInf {
-			// EXCEPTION: overflow
-			return overflow[SPEC](f.s, rounding)
+		return z
+	}
+
+	var spec SPEC
+
+	f.prenorm()
+	g.prenorm()
+
+	// The product is kept exactly, as the double-width mantissa hi:lo, normalized so that its top bit is set.
+	s := f.s != g.s
+	e := f.e + g.e - expBias[SPEC]() + 1
+	hi, lo := spec.Mul(f.m, g.m)
+
+	if lz := spec.Lzcnt(hi); lz != 0 {
+		hi, lo = shl2[SPEC](hi, lo, lz)
+		e -= lz
+	}
+
+	if !h.isZero() {
+		h.prenorm()
+
+		var hlo D
+		hs, he, hhi := h.s, h.e, h.m
+
+		if he > e || (he == e && cmp2[SPEC](hhi, hlo, hi, lo) > 0) {
+			s, e, hi, lo, hs, he, hhi, hlo = hs, he, hhi, hlo, s, e, hi, lo
 		}
 
-		// recheck for sign mismatch infinite addition
-		if f.s != h.s {
-			// EXCEPTION: illegal operation: adding: ±∞ + ∓∞
-			return nan[SPEC]()
+		hhi, hlo = shr2[SPEC](hhi, hlo, e-he)
+
+		if s == hs {
+			var carry D
+			lo, carry = spec.Add(lo, hlo, carry)
+			hi, carry = spec.Add(hi, hhi, carry)
+
+			if !spec.IsZero(carry) {
+				hi, lo = shr2[SPEC](hi, lo, 1)
+				hi = spec.Or(hi, signMask[SPEC]())
+				e++
+			}
+		} else {
+			var borrow D
+			lo, borrow = spec.Sub(lo, hlo, borrow)
+			hi, _ = spec.Sub(hi, hhi, borrow)
+
+			lz := spec.Lzcnt(hi)
+			if lz == spec.width() {
+				lz += spec.Lzcnt(lo)
+			}
+
+			if lz == 2*spec.width() {
+				// The product and the addend cancel exactly.
+				return zeroSum[SPEC](s, hs, rounding)
+			}
+
+			hi, lo = shl2[SPEC](hi, lo, lz)
+			e -= lz
 		}
+	}
 
-		return z
+	f = binary[SPEC, D]{s: s, e: e, m: hi}
+	if !spec.IsZero(lo) {
+		// Stick a non-zero low word into the least-significant guard bit.
+		f.m = spec.Or(f.m, spec.Pow2(0))
 	}
 
-	if hInf {
-		return z
+	f.denorm()
+
+	if f.e >= expMax[SPEC]() {
+		// EXCEPTION: overflow
+		return overflow[SPEC](f.s, rounding)
 	}
 
+	applyRounding(&f, rounding)
+
+	return f.encode()
+}
+
+// zeroSum returns the sum of two values of the given signs that is exactly zero.
+// It is -0 if both values are negative, or if rounding toward negative, unless both are positive.
+func zeroSum[SPEC spec[D], D datum](s1, s2 bool, rounding RoundingMode) D {
+	var z D
+
+	if (s1 && s2) || ((s1 || s2) && towardNegative(rounding)) {
+		return neg[SPEC](z)
+	}
+
+	return z
+}
+
+// shl2 returns the double-width mantissa hi:lo shifted left by k.
+func shl2[SPEC spec[D], D datum](hi, lo D, k int) (D, D) {
 	var spec SPEC
 
-	if f.e < h.e || (f.e == h.e && spec.Lt(f.m, h.m)) {
-		f, h = h, f
+	var z D
+
+	w := spec.width()
+	if k >= w {
+		return spec.Shl(lo, k-w), z
 	}
 
-	h.shr(f.e - h.e)
+	if k == 0 {
+		return hi, lo
+	}
 
-	if f.s != h.s {
-		f.sub(h.m)
-	} else {
-		f.add(h.m)
+	return spec.Or(spec.Shl(hi, k), spec.Shr(lo, w-k)), spec.Shl(lo, k)
+}
+
+// shr2 returns the double-width mantissa hi:lo shifted right by k,
+// with any non-zero bits shifted out stuck into the least-significant guard bit.
+func shr2[SPEC spec[D], D datum](hi, lo D, k int) (D, D) {
+	var spec SPEC
+	var z D
+
+	w := spec.width()
+
+	var sticky bool
+	switch {
+	case k == 0:
+		return hi, lo
+
+	case k >= 2*w:
+		sticky = !spec.IsZero(hi) || !spec.IsZero(lo)
+		hi, lo = z, z
+
+	case k >= w:
+		sticky = !spec.IsZero(lo) || spec.Neq(spec.Shl(spec.Shr(hi, k-w), k-w), hi)
+		hi, lo = z, spec.Shr(hi, k-w)
+
+	default:
+		sticky = spec.Neq(spec.Shl(spec.Shr(lo, k), k), lo)
+		hi, lo = spec.Shr(hi, k), spec.Or(spec.Shr(lo, k), spec.Shl(hi, w-k))
 	}
 
-	applyRounding(&f, rounding)
+	if sticky {
+		lo = spec.Or(lo, spec.Pow2(0))
+	}
 
-	return f.encode()
+	return hi, lo
+}
+
+// cmp2 compares the double-width mantissas xhi:xlo and yhi:ylo.
+func cmp2[SPEC spec[D], D datum](xhi, xlo, yhi, ylo D) int {
+	var spec SPEC
+
+	if c := spec.Cmp(xhi, yhi); c != 0 {
+		return c
+	}
+
+	return spec.Cmp(xlo, ylo)
 }
 
 func mul[SPEC spec[D], D datum](x, y D, rounding RoundingMode) D {
@@ -1395,12 +1635,72 @@ func mod[SPEC spec[D], D datum](x, y D, rounding RoundingMode) D {
 		if spec.Lt(rfr, yfr) {
 			rexp--
 		}
-		xm = sub[SPEC](xm, ldexp[SPEC](y, rexp-yexp), rounding)
+
+		// The subtraction is exact, but rounding toward negative would turn a zero difference into -0.
+		xm = sub[SPEC](xm, ldexp[SPEC](ym, rexp-yexp, rounding), RoundTiesToEven{})
 	}
 
 	return spec.Or(s, xm)
 }
 
+func remainder[SPEC spec[D], D datum](x, y D) D {
+	s, xm := mag[SPEC](x)
+	_, ym := mag[SPEC](y)
+
+	var spec SPEC
+
+	switch {
+	case spec.Gt(xm, magInf[SPEC]()):
+		return x
+	case spec.Gt(ym, magInf[SPEC]()):
+		return y
+
+	case spec.Eq(xm, magInf[SPEC]()):
+		// EXCEPTION: invalid operation: remainder(±∞, y)
+		return nan[SPEC]()
+
+	case spec.IsZero(ym):
+		// EXCEPTION: invalid operation: remainder(x, 0)
+		return nan[SPEC]()
+
+	case spec.Eq(ym, magInf[SPEC]()):
+		return x // remainder(x, ±Inf) → x
+	}
+
+	// All of the following operations are exact, so the rounding mode does not matter,
+	// except to give the sign of a zero difference.
+	var rnd RoundTiesToEven
+
+	// Reduce |x| below 2|y|, so that the only remaining question is whether to subtract |y| once or twice.
+	if y2 := ldexp[SPEC](ym, 1, rnd); !spec.Eq(y2, magInf[SPEC]()) {
+		xm = mod[SPEC](xm, y2, rnd)
+	}
+
+	if spec.Lt(ym, spec.Shl(spec.FromInt(2), spec.mantWidth())) {
+		// |y| < 2×MinNormal, so |y|/2 might not be exact, but 2|x| is.
+		if spec.Gt(add[SPEC](xm, xm, rnd), ym) {
+			xm = sub[SPEC](xm, ym, rnd)
+
+			if compare[SPEC](add[SPEC](xm, xm, rnd), ym) >= 0 {
+				xm = sub[SPEC](xm, ym, rnd)
+			}
+		}
+	} else {
+		half := ldexp[SPEC](ym, -1, rnd)
+
+		if spec.Gt(xm, half) {
+			xm = sub[SPEC](xm, ym, rnd)
+
+			if compare[SPEC](xm, half) >= 0 {
+				xm = sub[SPEC](xm, ym, rnd)
+			}
+		}
+	}
+
+	// remainder(-x, y) = -remainder(x, y)
+	return spec.Xor(xm, s)
+}
+
 func sqrt[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
 	sign, m := mag[SPEC](x)
 
@@ -1428,8 +1728,7 @@ func sqrt[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
 		// subnorm
 		var e int
 		x, e = normalize[SPEC](x)
-		exp -= e
-		exp++
+		exp = 1 + e
 	}
 
 	shift := spec.mantWidth()
@@ -1456,14 +1755,33 @@ func sqrt[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
 		r = spec.Shr(r, 1)
 	}
 
-	// final rounding
-	if !spec.IsZero(x) {
-		q, _ = spec.Add(q, spec.And(q, spec.Pow2(0)), z)
+	// final rounding, from the guard bit at the bottom of q, and the remainder left in x.
+	// A square root is never exactly halfway between two values, so any set guard bit is above halfway.
+	guard := !spec.IsZero(spec.And(q, spec.Pow2(0)))
+	sticky := !spec.IsZero(x)
+
+	q = spec.Shr(q, 1)
+
+	var idx int
+	if !spec.IsZero(spec.And(q, spec.Pow2(0))) {
+		idx |= 1
+	}
+
+	switch rounding.bulkBias()[idx] {
+	case biasUnderHalf, biasHalf:
+		if guard {
+			q, _ = spec.Add(q, spec.Pow2(0), z)
+		}
+	case biasAll:
+		if guard || sticky {
+			q, _ = spec.Add(q, spec.Pow2(0), z)
+		}
 	}
 
 	exp += expBias[SPEC]() - 1
 
-	x, _ = spec.Add(spec.Shr(q, 1), spec.Shl(spec.FromInt(exp), shift), z)
+	// A carry out of the mantissa correctly increments the exponent.
+	x, _ = spec.Add(q, spec.Shl(spec.FromInt(exp), shift), z)
 	return x
 }
 
@@ -1635,7 +1953,7 @@ func expmulti[SPEC spec[D], D datum](hi, lo D, k int, rounding RoundingMode) D {
 
 	y := sub[SPEC](one[SPEC](), hi, rounding)
 
-	return ldexp[SPEC](y, k)
+	return ldexp[SPEC](y, k, rounding)
 }
 
 func ilogb[SPEC spec[D], D datum](x D) (int, bool) {
@@ -1647,16 +1965,17 @@ func ilogb[SPEC spec[D], D datum](x D) (int, bool) {
 	case spec.IsZero(m):
 		// EXCEPTION: invalid operation: ilogb(0) = ⟨implementation defined⟩
 		// But the result must be outside the range ±2×(emax+p-1)
-		return math.MinInt, false
+		return math.MinInt32, false
 	case spec.Gte(m, magInf[SPEC]()):
 		// EXCEPTION: invalid operation: ilogb(NaN or ±∞) = ⟨implementation defined⟩
 		// But the result must be outside the range ±2×(emax+p-1)
-		return math.MaxInt, false
+		return math.MaxInt32, false
 	}
 
-	_, e, _ := decomp[SPEC](x)
+	// frexp normalizes subnormals, and gives a fraction in [½, 1).
+	_, e := frexp[SPEC](x)
 
-	return e - expBias[SPEC](), true
+	return e - 1, true
 }
 
 func logb[SPEC spec[D], D datum](x D) D {
@@ -1677,7 +1996,8 @@ func logb[SPEC spec[D], D datum](x D) D {
 		return nan[SPEC]()
 	}
 
-	_, e, _ := decomp[SPEC](x)
+	// The exponent fits within the precision of every format, so this is exact.
+	e, _ := ilogb[SPEC](x)
 
-	return spec.FromInt(e - expBias[SPEC]())
+	return fromBigFloat[SPEC](big.NewFloat(float64(e)), RoundTiesToEven{})
 }
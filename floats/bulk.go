@@ -0,0 +1,382 @@
+package floats
+
+import (
+	"math"
+)
+
+// bulkBias selects what to add to a mantissa before truncating it, in order to round it.
+// It is indexed by the sign, and the least-significant bit being kept, as sign<<1 | lsb.
+type bulkBias [4]uint8
+
+const (
+	biasNone      = iota // never round up
+	biasUnderHalf        // round up only what is above the halfway point
+	biasHalf             // round up everything from the halfway point
+	biasAll              // round up everything above zero
+)
+
+// biasValue returns the value to add before truncating, where half is the halfway point of the bits being truncated.
+func biasValue[U uint32 | uint64](code uint8, half U) U {
+	switch code {
+	case biasUnderHalf:
+		return half - 1
+	case biasHalf:
+		return half
+	case biasAll:
+		return half<<1 - 1
+	}
+
+	return 0
+}
+
+// biasTable returns the values to add before truncating the bottom shift bits, indexed as the bulkBias is.
+func biasTable[U uint32 | uint64](b bulkBias, shift uint) (table [4]U) {
+	half := U(1) << (shift - 1)
+
+	for i, code := range b {
+		table[i] = biasValue(code, half)
+	}
+
+	return table
+}
+
+// roundShr returns x shifted right by shift bits, rounded according to b.
+func roundShr[U uint32 | uint64](x U, shift uint, sign U, b bulkBias) U {
+	lsb := (x >> shift) & 1
+
+	return (x + biasValue(b[(sign<<1|lsb)&3], U(1)<<(shift-1))) >> shift
+}
+
+// EncodeFloat16s converts each value of src into the binary representation of the closest IEEE 754 16-bit floating-point number,
+// using the RoundTiesToEven rounding mode, and stores them into dst.
+// It converts min(len(dst), len(src)) values, and returns the number of values converted.
+func EncodeFloat16s[F float32 | float64](dst []uint16, src []F) int {
+	return EncodeFloat16sWithRound[RoundTiesToEven](dst, src)
+}
+
+// EncodeFloat16sWithRound converts each value of src into the binary representation of the closest IEEE 754 16-bit floating-point number,
+// using the specified rounding mode, and stores them into dst.
+// It converts min(len(dst), len(src)) values, and returns the number of values converted.
+//
+// It gives the same results as Float16WithRoundFromFloat[RND](x).Bits(), but is much faster.
+func EncodeFloat16sWithRound[RND RoundingMode, F float32 | float64](dst []uint16, src []F) int {
+	var rnd RND
+
+	n := min(len(dst), len(src))
+
+	switch src := any(src[:n]).(type) {
+	case []float32:
+		encodeFloat16s32(dst[:n], src, rnd)
+	case []float64:
+		encodeFloat16s64(dst[:n], src, rnd)
+	default:
+		panic("impossible type")
+	}
+
+	return n
+}
+
+func encodeFloat16s32(dst []uint16, src []float32, rounding RoundingMode) {
+	b := rounding.bulkBias()
+	bias := biasTable[uint32](b, 13)
+	over := [2]uint16{overflow[binary16](false, rounding), overflow[binary16](true, rounding)}
+
+	dst = dst[:len(src)]
+
+	for i, x := range src {
+		u := math.Float32bits(x)
+		sign := u >> 31
+		a := u &^ (1 << 31)
+
+		var h uint32
+
+		switch {
+		case a > 0x7f800000:
+			dst[i] = nan[binary16]()
+			continue
+
+		case a == 0x7f800000:
+			h = 0x7c00
+
+		case a >= 0x47800000:
+			// 2**16 and above overflows, even before rounding.
+			dst[i] = over[sign]
+			continue
+
+		case a >= 0x38800000:
+			// Normal in binary16: rebias the exponent from 127 to 15, and round off 13 bits of mantissa.
+			// A mantissa rounding up carries into the exponent, which correctly gives the next binade, or ∞.
+			m := a - (112 << 23)
+			h = (m + bias[(sign<<1|(m>>13)&1)&3]) >> 13
+
+		default:
+			// Sub-normal in binary16.
+			e := a >> 23
+			m := a & (1<<23 - 1)
+			if e == 0 {
+				e = 1
+			} else {
+				m |= 1 << 23
+			}
+
+			h = roundShr(m, uint(min(126-e, 31)), sign, b)
+		}
+
+		dst[i] = uint16(h | sign<<15)
+	}
+}
+
+func encodeFloat16s64(dst []uint16, src []float64, rounding RoundingMode) {
+	b := rounding.bulkBias()
+	bias := biasTable[uint64](b, 42)
+	over := [2]uint16{overflow[binary16](false, rounding), overflow[binary16](true, rounding)}
+
+	dst = dst[:len(src)]
+
+	for i, x := range src {
+		u := math.Float64bits(x)
+		sign := u >> 63
+		a := u &^ (1 << 63)
+
+		var h uint64
+
+		switch {
+		case a > 0x7ff0000000000000:
+			dst[i] = nan[binary16]()
+			continue
+
+		case a == 0x7ff0000000000000:
+			h = 0x7c00
+
+		case a >= 0x40f0000000000000:
+			// 2**16 and above overflows, even before rounding.
+			dst[i] = over[sign]
+			continue
+
+		case a >= 0x3f10000000000000:
+			// Normal in binary16: rebias the exponent from 1023 to 15, and round off 42 bits of mantissa.
+			m := a - (1008 << 52)
+			h = (m + bias[(sign<<1|(m>>42)&1)&3]) >> 42
+
+		default:
+			// Sub-normal in binary16.
+			e := a >> 52
+			m := a & (1<<52 - 1)
+			if e == 0 {
+				e = 1
+			} else {
+				m |= 1 << 52
+			}
+
+			h = roundShr(m, uint(min(1051-e, 63)), sign, b)
+		}
+
+		dst[i] = uint16(h | sign<<15)
+	}
+}
+
+// DecodeFloat16s converts the binary representation of each IEEE 754 16-bit floating-point number in src
+// into a native floating-point number, and stores them into dst.
+// There is no loss of precision.
+// It converts min(len(dst), len(src)) values, and returns the number of values converted.
+func DecodeFloat16s[F float32 | float64](dst []F, src []uint16) int {
+	n := min(len(dst), len(src))
+
+	switch dst := any(dst[:n]).(type) {
+	case []float32:
+		decodeFloat16s32(dst, src[:n])
+	case []float64:
+		decodeFloat16s64(dst, src[:n])
+	default:
+		panic("impossible type")
+	}
+
+	return n
+}
+
+func decodeFloat16s32(dst []float32, src []uint16) {
+	dst = dst[:len(src)]
+
+	for i, h := range src {
+		sign := uint32(h&0x8000) << 16
+		a := uint32(h & 0x7fff)
+
+		// Placed into a float32 as is, the exponent is biased by 127 instead of 15,
+		// so scaling by 2**112 gives the right value, including for sub-normals, which become normal.
+		u := math.Float32bits(math.Float32frombits(a<<13) * 0x1p112)
+		if a >= 0x7c00 {
+			// ∞ and NaN, where the scaling has left the exponent short of all ones.
+			u |= 0x7f800000
+		}
+
+		dst[i] = math.Float32frombits(u | sign)
+	}
+}
+
+func decodeFloat16s64(dst []float64, src []uint16) {
+	dst = dst[:len(src)]
+
+	for i, h := range src {
+		sign := uint64(h&0x8000) << 48
+		a := uint64(h & 0x7fff)
+
+		// Placed into a float64 as is, the exponent is biased by 1023 instead of 15,
+		// so scaling by 2**1008 gives the right value, including for sub-normals, which become normal.
+		u := math.Float64bits(math.Float64frombits(a<<42) * 0x1p1008)
+		if a >= 0x7c00 {
+			// ∞ and NaN, where the scaling has left the exponent short of all ones.
+			u |= 0x7ff0000000000000
+		}
+
+		dst[i] = math.Float64frombits(u | sign)
+	}
+}
+
+// EncodeBFloat16s converts each value of src into the binary representation of the closest Google Brain floating-point number,
+// using the RoundTiesToEven rounding mode, and stores them into dst.
+// It converts min(len(dst), len(src)) values, and returns the number of values converted.
+func EncodeBFloat16s[F float32 | float64](dst []uint16, src []F) int {
+	return EncodeBFloat16sWithRound[RoundTiesToEven](dst, src)
+}
+
+// EncodeBFloat16sWithRound converts each value of src into the binary representation of the closest Google Brain floating-point number,
+// using the specified rounding mode, and stores them into dst.
+// It converts min(len(dst), len(src)) values, and returns the number of values converted.
+//
+// It gives the same results as BFloat16WithRoundFromFloat[RND](x).Bits(), but is much faster.
+func EncodeBFloat16sWithRound[RND RoundingMode, F float32 | float64](dst []uint16, src []F) int {
+	var rnd RND
+
+	n := min(len(dst), len(src))
+
+	switch src := any(src[:n]).(type) {
+	case []float32:
+		encodeBFloat16s32(dst[:n], src, rnd)
+	case []float64:
+		encodeBFloat16s64(dst[:n], src, rnd)
+	default:
+		panic("impossible type")
+	}
+
+	return n
+}
+
+func encodeBFloat16s32(dst []uint16, src []float32, rounding RoundingMode) {
+	bias := biasTable[uint32](rounding.bulkBias(), 16)
+
+	dst = dst[:len(src)]
+
+	for i, x := range src {
+		u := math.Float32bits(x)
+		sign := u >> 31
+		a := u &^ (1 << 31)
+
+		if a > 0x7f800000 {
+			dst[i] = nan[bfloat16]()
+			continue
+		}
+
+		// bfloat16 is just the top half of a float32, with the same exponent bias, so sub-normals need no special handling.
+		// A mantissa rounding up carries into the exponent, which correctly gives the next binade, or ∞.
+		// ∞ itself has nothing to round off.
+		h := (a + bias[(sign<<1|(a>>16)&1)&3]) >> 16
+
+		dst[i] = uint16(h | sign<<15)
+	}
+}
+
+func encodeBFloat16s64(dst []uint16, src []float64, rounding RoundingMode) {
+	b := rounding.bulkBias()
+	bias := biasTable[uint64](b, 45)
+	over := [2]uint16{overflow[bfloat16](false, rounding), overflow[bfloat16](true, rounding)}
+
+	dst = dst[:len(src)]
+
+	for i, x := range src {
+		u := math.Float64bits(x)
+		sign := u >> 63
+		a := u &^ (1 << 63)
+
+		var h uint64
+
+		switch {
+		case a > 0x7ff0000000000000:
+			dst[i] = nan[bfloat16]()
+			continue
+
+		case a == 0x7ff0000000000000:
+			h = 0x7f80
+
+		case a >= 0x47f0000000000000:
+			// 2**128 and above overflows, even before rounding.
+			dst[i] = over[sign]
+			continue
+
+		case a >= 0x3810000000000000:
+			// Normal in bfloat16: rebias the exponent from 1023 to 127, and round off 45 bits of mantissa.
+			m := a - (896 << 52)
+			h = (m + bias[(sign<<1|(m>>45)&1)&3]) >> 45
+
+		default:
+			// Sub-normal in bfloat16.
+			e := a >> 52
+			m := a & (1<<52 - 1)
+			if e == 0 {
+				e = 1
+			} else {
+				m |= 1 << 52
+			}
+
+			h = roundShr(m, uint(min(942-e, 63)), sign, b)
+		}
+
+		dst[i] = uint16(h | sign<<15)
+	}
+}
+
+// DecodeBFloat16s converts the binary representation of each Google Brain floating-point number in src
+// into a native floating-point number, and stores them into dst.
+// There is no loss of precision.
+// It converts min(len(dst), len(src)) values, and returns the number of values converted.
+func DecodeBFloat16s[F float32 | float64](dst []F, src []uint16) int {
+	n := min(len(dst), len(src))
+
+	switch dst := any(dst[:n]).(type) {
+	case []float32:
+		decodeBFloat16s32(dst, src[:n])
+	case []float64:
+		decodeBFloat16s64(dst, src[:n])
+	default:
+		panic("impossible type")
+	}
+
+	return n
+}
+
+func decodeBFloat16s32(dst []float32, src []uint16) {
+	dst = dst[:len(src)]
+
+	for i, h := range src {
+		// bfloat16 is just the top half of a float32.
+		dst[i] = math.Float32frombits(uint32(h) << 16)
+	}
+}
+
+func decodeBFloat16s64(dst []float64, src []uint16) {
+	dst = dst[:len(src)]
+
+	for i, h := range src {
+		sign := uint64(h&0x8000) << 48
+		a := uint64(h & 0x7fff)
+
+		// Placed into a float64 as is, the exponent is biased by 1023 instead of 127,
+		// so scaling by 2**896 gives the right value, including for sub-normals, which become normal.
+		u := math.Float64bits(math.Float64frombits(a<<45) * 0x1p896)
+		if a >= 0x7f80 {
+			// ∞ and NaN, where the scaling has left the exponent short of all ones.
+			u |= 0x7ff0000000000000
+		}
+
+		dst[i] = math.Float64frombits(u | sign)
+	}
+}
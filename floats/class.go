@@ -0,0 +1,110 @@
+package floats
+
+// Class is one of the ten classes of IEEE 754 floating-point numbers, as given by the class operation.
+type Class int
+
+// The classes of IEEE 754 floating-point numbers, in the order given by the standard.
+const (
+	SignalingNaN Class = iota
+	QuietNaN
+	NegativeInfinity
+	NegativeNormal
+	NegativeSubnormal
+	NegativeZero
+	PositiveZero
+	PositiveSubnormal
+	PositiveNormal
+	PositiveInfinity
+)
+
+var classNames = [...]string{
+	SignalingNaN:      "signalingNaN",
+	QuietNaN:          "quietNaN",
+	NegativeInfinity:  "negativeInfinity",
+	NegativeNormal:    "negativeNormal",
+	NegativeSubnormal: "negativeSubnormal",
+	NegativeZero:      "negativeZero",
+	PositiveZero:      "positiveZero",
+	PositiveSubnormal: "positiveSubnormal",
+	PositiveNormal:    "positiveNormal",
+	PositiveInfinity:  "positiveInfinity",
+}
+
+// String returns the name of the class, as spelled in IEEE 754.
+func (c Class) String() string {
+	if c < 0 || int(c) >= len(classNames) {
+		return "Class(?)"
+	}
+
+	return classNames[c]
+}
+
+func class[SPEC spec[D], D datum](x D) Class {
+	var spec SPEC
+
+	s, m := mag[SPEC](x)
+	neg := !spec.IsZero(s)
+
+	switch {
+	case spec.Gt(m, magInf[SPEC]()):
+		if spec.IsZero(spec.And(m, quietMask[SPEC]())) {
+			return SignalingNaN
+		}
+		return QuietNaN
+
+	case spec.Eq(m, magInf[SPEC]()):
+		if neg {
+			return NegativeInfinity
+		}
+		return PositiveInfinity
+
+	case spec.IsZero(m):
+		if neg {
+			return NegativeZero
+		}
+		return PositiveZero
+
+	case spec.IsZero(spec.And(m, expMask[SPEC]())):
+		if neg {
+			return NegativeSubnormal
+		}
+		return PositiveSubnormal
+	}
+
+	if neg {
+		return NegativeNormal
+	}
+	return PositiveNormal
+}
+
+func isZero[SPEC spec[D], D datum](x D) bool {
+	var spec SPEC
+
+	_, m := mag[SPEC](x)
+	return spec.IsZero(m)
+}
+
+func isFinite[SPEC spec[D], D datum](x D) bool {
+	var spec SPEC
+
+	_, m := mag[SPEC](x)
+	return spec.Lt(m, magInf[SPEC]())
+}
+
+func isNormal[SPEC spec[D], D datum](x D) bool {
+	var spec SPEC
+
+	_, m := mag[SPEC](x)
+	return spec.Lt(m, magInf[SPEC]()) && !spec.IsZero(spec.And(m, expMask[SPEC]()))
+}
+
+func isSubnormal[SPEC spec[D], D datum](x D) bool {
+	var spec SPEC
+
+	_, m := mag[SPEC](x)
+	return !spec.IsZero(m) && spec.IsZero(spec.And(m, expMask[SPEC]()))
+}
+
+func isSignaling[SPEC spec[D], D datum](x D) bool {
+	return class[SPEC](x) == SignalingNaN
+}
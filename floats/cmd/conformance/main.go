@@ -0,0 +1,135 @@
+// Command conformance runs floating-point test vector files against the floats types,
+// and reports every vector whose result or exception flags do not match those expected.
+//
+// Usage:
+//
+//	conformance [flags] file...
+//
+// Files ending in .fptest are read as IBM FPgen test vectors,
+// and all other files as Berkeley TestFloat test vectors, one vector per line, as in:
+//
+//	f16_add rnear_even 3C00 4000 4200 00
+//
+// The output of testfloat_gen, which has no function or rounding mode on each line,
+// is read by naming them with -function and -rounding:
+//
+//	testfloat_gen -rminMag f16_mul | conformance -function f16_mul -rounding rminMag -
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/puellanivis/math/floats/conformance"
+)
+
+var (
+	syntax   = flag.String("syntax", "", "read files as `testfloat` or `fpgen` vectors, instead of by their extension")
+	function = flag.String("function", "", "the TestFloat `function` of vectors that do not name one, such as f16_add")
+	rounding = flag.String("rounding", "", "the TestFloat `rounding` mode of vectors that do not name one, such as rnear_even")
+	before   = flag.Bool("tininessbefore", false, "detect underflow before rounding, rather than after")
+	verbose  = flag.Bool("v", false, "also report vectors that are unsupported")
+)
+
+// tally counts the outcomes of the vectors run.
+type tally struct {
+	passed, failed, unsupported, malformed int
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] file...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	r := new(conformance.Runner)
+	if *before {
+		r.Tininess = conformance.TininessBeforeRounding
+	}
+
+	var t tally
+
+	for _, name := range flag.Args() {
+		if err := runFile(r, name, &t); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Printf("%d passed, %d failed, %d unsupported, %d malformed\n", t.passed, t.failed, t.unsupported, t.malformed)
+
+	if t.failed > 0 || t.malformed > 0 {
+		os.Exit(1)
+	}
+}
+
+func runFile(r *conformance.Runner, name string, t *tally) error {
+	var in io.Reader = os.Stdin
+
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		in = f
+	}
+
+	var s *conformance.Scanner
+
+	switch *syntax {
+	case "fpgen":
+		s = conformance.NewFPgenScanner(in)
+	case "testfloat":
+		s = conformance.NewTestFloatScanner(in, *function, *rounding)
+	case "":
+		if strings.HasSuffix(name, ".fptest") {
+			s = conformance.NewFPgenScanner(in)
+		} else {
+			s = conformance.NewTestFloatScanner(in, *function, *rounding)
+		}
+	default:
+		return fmt.Errorf("unknown syntax %q", *syntax)
+	}
+
+	for s.Scan() {
+		c := s.Case()
+
+		o, err := r.Run(c)
+		switch {
+		case errors.Is(err, conformance.ErrUnsupported):
+			t.unsupported++
+			if *verbose {
+				fmt.Printf("%s:%d: %s: %v\n", name, c.Line, c.Text, err)
+			}
+
+		case err != nil:
+			t.malformed++
+			fmt.Printf("%s:%d: %s: %v\n", name, c.Line, c.Text, err)
+
+		case !c.Check(o):
+			t.failed++
+			fmt.Printf("%s:%d: %s: got %x %v, expected %x %v\n", name, c.Line, c.Text, o.Result, o.Flags, c.Result, c.Flags)
+
+		default:
+			t.passed++
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	return nil
+}
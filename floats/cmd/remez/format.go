@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// format describes a target format, and how its spec declares its tables.
+type format struct {
+	name      string
+	mantWidth int
+	expWidth  int
+
+	// spec is the name of the spec type of the format, which prefixes the names of its tables,
+	// and typ the Go type of its encodings.
+	spec string
+	typ  string
+}
+
+var formats = []format{
+	{"f16", 10, 5, "binary16", "uint16"},
+	{"bf16", 7, 8, "bfloat16", "uint16"},
+	{"f32", 23, 8, "binary32", "uint32"},
+	{"f64", 52, 11, "binary64", "uint64"},
+	{"f128", 112, 15, "binary128", "bits.Uint128"},
+}
+
+func lookupFormat(name string) (format, error) {
+	for _, f := range formats {
+		if f.name == name {
+			return f, nil
+		}
+	}
+
+	return format{}, fmt.Errorf("unknown format %q", name)
+}
+
+func (f format) width() int {
+	return 1 + f.expWidth + f.mantWidth
+}
+
+func (f format) bias() int {
+	return 1<<(f.expWidth-1) - 1
+}
+
+// encode returns the encoding of x rounded to the nearest value of the format, ties to even,
+// along with the value it rounded to.
+func (f format) encode(x *big.Float) (*big.Int, *big.Float, error) {
+	u := new(big.Int)
+
+	if x.Sign() == 0 {
+		return u, newFloat(), nil
+	}
+
+	mag := newFloat().Abs(x)
+
+	// Values below the smallest normal number have the quantum of the subnormals.
+	exp := mag.MantExp(nil) - 1
+	exp = max(exp, 1-f.bias())
+
+	q := newFloat().SetMantExp(mag, f.mantWidth-exp)
+
+	n, _ := q.Int(nil)
+	frac := q.Sub(q, newFloat().SetInt(n))
+
+	if c := frac.Cmp(big.NewFloat(0.5)); c > 0 || (c == 0 && n.Bit(0) == 1) {
+		n.Add(n, big.NewInt(1))
+	}
+
+	rounded := newFloat().SetInt(n)
+	rounded.SetMantExp(rounded, exp-f.mantWidth)
+	if x.Sign() < 0 {
+		rounded.Neg(rounded)
+	}
+
+	// The biased exponent, less one, is added to the integral significand, which includes the implicit bit,
+	// so that a significand that rounds up into the next binade carries into the exponent.
+	u.Lsh(big.NewInt(int64(exp+f.bias()-1)), uint(f.mantWidth))
+	u.Add(u, n)
+
+	if new(big.Int).Rsh(u, uint(f.mantWidth)).Int64() >= 1<<f.expWidth-1 {
+		return nil, nil, fmt.Errorf("%v overflows %s", x, f.name)
+	}
+
+	if x.Sign() < 0 {
+		u.SetBit(u, f.width()-1, 1)
+	}
+
+	return u, rounded, nil
+}
+
+// literal returns the Go literal of the encoding u.
+func (f format) literal(u *big.Int) string {
+	switch f.width() {
+	case 128:
+		lo := new(big.Int).And(u, new(big.Int).SetUint64(^uint64(0)))
+		hi := new(big.Int).Rsh(u, 64)
+		return fmt.Sprintf("bits.Uint128{Hi: 0x%016x, Lo: 0x%016x}", hi, lo)
+
+	case 64:
+		v := u.Uint64()
+		return fmt.Sprintf("0x%08X_%08X", v>>32, v&(1<<32-1))
+	}
+
+	return fmt.Sprintf("0x%0*x", f.width()/4, u)
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// prec is the working precision of the Remez exchange, well beyond that of any target format.
+const prec = 256
+
+func newFloat() *big.Float {
+	return new(big.Float).SetPrec(prec)
+}
+
+func newInt(n int64) *big.Float {
+	return newFloat().SetInt64(n)
+}
+
+// function is a function to approximate.
+//
+// The kernels are functions of z = x², whose polynomial approximations make up
+// the approximation of the underlying function, as done in expmulti.
+type function struct {
+	name string
+	doc  string
+
+	// lo and hi are the default interval, and min and max the domain over which eval is accurate.
+	lo, hi   float64
+	min, max float64
+
+	eval func(x *big.Float) *big.Float
+}
+
+var functions = []function{
+	{
+		name: "exp",
+		doc:  "eˣ",
+		lo:   -0.34657359027997264, hi: 0.34657359027997264, // ±ln(2)/2
+		min: -64, max: 64,
+		eval: bigExp,
+	},
+	{
+		name: "expr",
+		doc:  "(R(r) - 2)/z, where R(r) = r(eʳ + 1)/(eʳ - 1), the kernel of Exp, whose coefficients are P1 to P5 of expPN",
+		lo:   0, hi: 0.12011325347955035, // (ln(2)/2)²
+		min: 0, max: 4,
+		eval: expKernel,
+	},
+	{
+		name: "logr",
+		doc:  "(log((1 + s)/(1 - s)) - 2s)/s³, where z = s², the kernel of a log with s = f/(2 + f)",
+		lo:   0, hi: 0.02943725152285941, // (3 - 2√2)², for f in [√2/2 - 1, √2 - 1]
+		min: 0, max: 0.25,
+		eval: series(func(k int64) *big.Float {
+			// 2/(2k + 3)
+			return newFloat().Quo(newInt(2), newInt(2*k+3))
+		}),
+	},
+	{
+		name: "sinr",
+		doc:  "(sin(x) - x)/x³, the kernel of a sin",
+		lo:   0, hi: 0.6168502750680849, // (π/4)²
+		min: 0, max: 16,
+		eval: series(func(k int64) *big.Float {
+			// (-1)**(k+1)/(2k + 3)!
+			return newFloat().Quo(newInt(sign(k+1)), factorial(2*k+3))
+		}),
+	},
+	{
+		name: "cosr",
+		doc:  "(cos(x) - 1 + x²/2)/x⁴, the kernel of a cos",
+		lo:   0, hi: 0.6168502750680849, // (π/4)²
+		min: 0, max: 16,
+		eval: series(func(k int64) *big.Float {
+			// (-1)**k/(2k + 4)!
+			return newFloat().Quo(newInt(sign(k)), factorial(2*k+4))
+		}),
+	},
+}
+
+func lookup(name string) (function, error) {
+	for _, f := range functions {
+		if f.name == name {
+			return f, nil
+		}
+	}
+
+	return function{}, fmt.Errorf("unknown function %q", name)
+}
+
+func sign(k int64) int64 {
+	if k&1 == 1 {
+		return -1
+	}
+	return 1
+}
+
+func factorial(n int64) *big.Float {
+	return newFloat().SetInt(new(big.Int).MulRange(1, n))
+}
+
+// series returns the function of z summing the power series with the coefficients given by coeff.
+func series(coeff func(k int64) *big.Float) func(z *big.Float) *big.Float {
+	return func(z *big.Float) *big.Float {
+		sum := newFloat()
+		pow := newInt(1)
+
+		for k := int64(0); ; k++ {
+			term := newFloat().Mul(coeff(k), pow)
+			sum.Add(sum, term)
+
+			// The series of the kernels have terms that shrink, once k is past z, faster than geometrically.
+			if k > 2 && (term.Sign() == 0 || term.MantExp(nil) < sum.MantExp(nil)-prec-8) {
+				return sum
+			}
+
+			pow.Mul(pow, z)
+		}
+	}
+}
+
+// bigExp returns eˣ.
+func bigExp(x *big.Float) *big.Float {
+	return newFloat().Set(expPrec(x, prec))
+}
+
+// expPrec returns eˣ to the precision wp, by the Taylor series of e**(x/2**s), squared s times.
+func expPrec(x *big.Float, wp uint) *big.Float {
+	s := max(x.MantExp(nil)+16, 0)
+	wp += 64 + uint(s)
+
+	r := new(big.Float).SetPrec(wp).SetMantExp(x, -s)
+
+	sum := new(big.Float).SetPrec(wp).SetInt64(1)
+	term := new(big.Float).SetPrec(wp).SetInt64(1)
+
+	for k := int64(1); term.Sign() != 0 && term.MantExp(nil) > -int(wp); k++ {
+		term.Mul(term, r)
+		term.Quo(term, new(big.Float).SetInt64(k))
+		sum.Add(sum, term)
+	}
+
+	for ; s > 0; s-- {
+		sum.Mul(sum, sum)
+	}
+
+	return sum
+}
+
+// expKernel returns (R(r) - 2)/z, where r = √z, and R(r) = r(eʳ + 1)/(eʳ - 1) = 2 + z/6 - z²/360 + …
+func expKernel(z *big.Float) *big.Float {
+	if z.Sign() == 0 {
+		return newFloat().Quo(newInt(1), newInt(6))
+	}
+
+	// R(r) - 2 cancels about as many bits as z is small, so work with that many more.
+	wp := uint(prec + 64 + max(-z.MantExp(nil), 0))
+
+	r := new(big.Float).SetPrec(wp).Sqrt(new(big.Float).SetPrec(wp).Set(z))
+	er := expPrec(r, wp)
+
+	one := new(big.Float).SetPrec(wp).SetInt64(1)
+
+	R := new(big.Float).SetPrec(wp).Add(er, one)
+	R.Mul(R, r)
+	R.Quo(R, new(big.Float).SetPrec(wp).Sub(er, one))
+	R.Sub(R, new(big.Float).SetPrec(wp).SetInt64(2))
+
+	return newFloat().Quo(R, z)
+}
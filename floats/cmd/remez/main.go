@@ -0,0 +1,154 @@
+// Command remez generates minimax polynomial coefficients for the spec tables of the floats package,
+// such as the expPN coefficients binary16P5toP0 through binary128P5toP0.
+//
+// It runs the Remez exchange algorithm in big.Float to find the polynomial of a given degree
+// that minimizes the greatest relative (or, with -abs, absolute) error to a function over an interval,
+// then rounds its coefficients to the nearest values of the target format,
+// and writes the Go source of a table of their encodings, highest degree first, as Horner’s rule takes them:
+//
+//	remez -func expr -degree 4 -format f64
+//
+// The functions are:
+//
+//	exp    eˣ
+//	expr   (R(r) - 2)/z, where R(r) = r(eʳ + 1)/(eʳ - 1), the kernel of expmulti, whose coefficients are P1 to P5
+//	logr   (log((1 + s)/(1 - s)) - 2s)/s³, where z = s², the kernel of a log with s = f/(2 + f)
+//	sinr   (sin(x) - x)/x³, where z = x², the kernel of a sin
+//	cosr   (cos(x) - 1 + x²/2)/x⁴, where z = x², the kernel of a cos
+//
+// The kernels are functions of z, over the interval of z that the reduced argument of the function covers,
+// unless set by -interval.
+//
+// The expPN tables of binary16 and bfloat16 are those generated by -func expr -degree 4.
+// Those of binary32, binary64, and binary128 are the coefficients of fdlibm’s e_exp.c,
+// which minimize the error of R(r) rather than the relative error of the kernel,
+// so they differ from those generated here in their lower digits.
+//
+// Rounding each coefficient to nearest is not in general the best choice among the nearby values of the format,
+// so the error of the rounded polynomial is reported along with that of the exact minimax polynomial.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	gofmt "go/format"
+	"math"
+	"math/big"
+	"os"
+	"strings"
+)
+
+var (
+	funcName   = flag.String("func", "expr", "`function` to approximate: exp, expr, logr, sinr, or cosr")
+	interval   = flag.String("interval", "", "interval `lo,hi` to approximate over, instead of that of the function")
+	degree     = flag.Int("degree", 4, "`degree` of the polynomial")
+	formatName = flag.String("format", "f64", "target `format` of the coefficients: f16, bf16, f32, f64, or f128")
+	varName    = flag.String("name", "", "`name` of the table, instead of <spec>P<degree+1>toP0")
+	absolute   = flag.Bool("abs", false, "minimize the absolute error, instead of the relative error")
+)
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "remez:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	fn, err := lookup(*funcName)
+	if err != nil {
+		return fmt.Errorf("-func: %w", err)
+	}
+
+	f, err := lookupFormat(*formatName)
+	if err != nil {
+		return fmt.Errorf("-format: %w", err)
+	}
+
+	if *degree < 0 {
+		return fmt.Errorf("-degree: negative degree %d", *degree)
+	}
+
+	lo, hi := fn.lo, fn.hi
+	if *interval != "" {
+		if _, err := fmt.Sscanf(*interval, "%g,%g", &lo, &hi); err != nil {
+			return fmt.Errorf("-interval: malformed interval %q", *interval)
+		}
+
+		if lo < fn.min || hi > fn.max {
+			return fmt.Errorf("-interval: [%g, %g] is outside the domain [%g, %g] of %s", lo, hi, fn.min, fn.max, fn.name)
+		}
+	}
+
+	p := &problem{
+		f:      fn.eval,
+		lo:     newFloat().SetFloat64(lo),
+		hi:     newFloat().SetFloat64(hi),
+		degree: *degree,
+		abs:    *absolute,
+	}
+
+	sol, err := p.solve()
+	if err != nil {
+		return err
+	}
+
+	name := *varName
+	if name == "" {
+		name = fmt.Sprintf("%sP%dtoP0", f.spec, *degree+1)
+	}
+
+	kind := "relative"
+	if *absolute {
+		kind = "absolute"
+	}
+
+	rounded := make([]*big.Float, len(sol.coeffs))
+
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "// %s is the minimax polynomial of degree %d to %s over [%g, %g], highest degree first.\n", name, *degree, fn.name, lo, hi)
+	fmt.Fprintf(&b, "// Generated by: remez %s\n", strings.Join(os.Args[1:], " "))
+	fmt.Fprintf(&b, "var %s = []%s{\n", name, f.typ)
+
+	for i := len(sol.coeffs) - 1; i >= 0; i-- {
+		u, r, err := f.encode(sol.coeffs[i])
+		if err != nil {
+			return err
+		}
+		rounded[i] = r
+
+		fmt.Fprintf(&b, "%s, // %s\n", f.literal(u), r.Text('e', 20))
+	}
+
+	fmt.Fprintln(&b, "}")
+
+	src, err := gofmt.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stdout.Write(src); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "minimax %s error: 2**%.2f\n", kind, log2(sol.err))
+	fmt.Fprintf(os.Stderr, "%s error with coefficients rounded to %s: 2**%.2f\n", kind, f.name, log2(p.maxError(rounded, sol.ref)))
+
+	return nil
+}
+
+func log2(x *big.Float) float64 {
+	if x.Sign() == 0 {
+		return math.Inf(-1)
+	}
+
+	mant := new(big.Float)
+	exp := x.MantExp(mant)
+	m, _ := mant.Float64()
+
+	return math.Log2(m) + float64(exp)
+}
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// problem is the approximation of a function by a polynomial of a degree, over an interval,
+// minimizing either the relative or absolute error.
+type problem struct {
+	f      func(x *big.Float) *big.Float
+	lo, hi *big.Float
+	degree int
+	abs    bool
+}
+
+// poly evaluates the polynomial with the coefficients c, lowest degree first, at x.
+func poly(c []*big.Float, x *big.Float) *big.Float {
+	p := newFloat()
+	for i := len(c) - 1; i >= 0; i-- {
+		p.Mul(p, x)
+		p.Add(p, c[i])
+	}
+	return p
+}
+
+// weight returns the weight of the error at x, where the value of the function is fx.
+func (p *problem) weight(fx *big.Float) (*big.Float, error) {
+	if p.abs {
+		return newInt(1), nil
+	}
+
+	if fx.Sign() == 0 {
+		return nil, errors.New("function is zero in the interval, so relative error is unbounded; use -abs")
+	}
+
+	return newFloat().Quo(newInt(1), newFloat().Abs(fx)), nil
+}
+
+// errorAt returns the weighted error of the polynomial with the coefficients c at x.
+func (p *problem) errorAt(c []*big.Float, x *big.Float) *big.Float {
+	fx := p.f(x)
+
+	e := newFloat().Sub(fx, poly(c, x))
+	if !p.abs && fx.Sign() != 0 {
+		e.Quo(e, newFloat().Abs(fx))
+	}
+
+	return e
+}
+
+// at returns the point a fraction t of the way across the interval.
+func (p *problem) at(t *big.Float) *big.Float {
+	x := newFloat().Sub(p.hi, p.lo)
+	x.Mul(x, t)
+	return x.Add(x, p.lo)
+}
+
+// chebyshev returns the n+2 extrema of the Chebyshev polynomial of degree n+1 mapped onto the interval,
+// which are a starting reference close to the one for the minimax polynomial of degree n.
+func (p *problem) chebyshev() []*big.Float {
+	n := p.degree + 1
+
+	ref := make([]*big.Float, n+1)
+	for i := range ref {
+		// (1 - cos(iπ/n))/2, computed in float64, since the starting reference need only be close.
+		t := (1 - math.Cos(float64(i)*math.Pi/float64(n))) / 2
+		ref[i] = p.at(newFloat().SetFloat64(t))
+	}
+
+	return ref
+}
+
+// level returns the coefficients of the polynomial whose weighted error at the reference alternates in sign,
+// with the same magnitude, which it also returns.
+func (p *problem) level(ref []*big.Float) ([]*big.Float, *big.Float, error) {
+	n := len(ref)
+
+	// Solve for c₀…cₙ₋₂ and E: Σ cⱼxᵢʲ + (-1)ⁱE/w(xᵢ) = f(xᵢ).
+	a := make([][]*big.Float, n)
+	b := make([]*big.Float, n)
+
+	for i, x := range ref {
+		fx := p.f(x)
+
+		w, err := p.weight(fx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		a[i] = make([]*big.Float, n)
+
+		pow := newInt(1)
+		for j := 0; j < n-1; j++ {
+			a[i][j] = newFloat().Set(pow)
+			pow.Mul(pow, x)
+		}
+
+		e := newFloat().Quo(newInt(1), w)
+		if i&1 == 1 {
+			e.Neg(e)
+		}
+		a[i][n-1] = e
+
+		b[i] = fx
+	}
+
+	sol, err := gauss(a, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sol[:n-1], sol[n-1], nil
+}
+
+// gauss solves the linear system ax = b, by Gaussian elimination with partial pivoting.
+func gauss(a [][]*big.Float, b []*big.Float) ([]*big.Float, error) {
+	n := len(b)
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if newFloat().Abs(a[row][col]).Cmp(newFloat().Abs(a[pivot][col])) > 0 {
+				pivot = row
+			}
+		}
+
+		if a[pivot][col].Sign() == 0 {
+			return nil, errors.New("singular system")
+		}
+
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+
+		for row := col + 1; row < n; row++ {
+			m := newFloat().Quo(a[row][col], a[col][col])
+
+			for k := col; k < n; k++ {
+				a[row][k] = newFloat().Sub(a[row][k], newFloat().Mul(m, a[col][k]))
+			}
+			b[row] = newFloat().Sub(b[row], newFloat().Mul(m, b[col]))
+		}
+	}
+
+	x := make([]*big.Float, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := newFloat().Set(b[row])
+		for k := row + 1; k < n; k++ {
+			sum.Sub(sum, newFloat().Mul(a[row][k], x[k]))
+		}
+		x[row] = sum.Quo(sum, a[row][row])
+	}
+
+	return x, nil
+}
+
+// searchSteps is the number of steps of each bisection and golden-section search,
+// which narrow the interval searched well below the precision of any target format.
+const searchSteps = 200
+
+// zero returns a zero of the error between lo and hi, across which it changes sign.
+func (p *problem) zero(c []*big.Float, lo, hi *big.Float) *big.Float {
+	lo, hi = newFloat().Set(lo), newFloat().Set(hi)
+	sign := p.errorAt(c, lo).Sign()
+
+	for i := 0; i < searchSteps; i++ {
+		mid := newFloat().Add(lo, hi)
+		mid.SetMantExp(mid, -1)
+
+		if p.errorAt(c, mid).Sign() == sign {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo
+}
+
+// extremum returns the point between lo and hi where the error, multiplied by sign, is greatest.
+func (p *problem) extremum(c []*big.Float, lo, hi *big.Float, sign int) *big.Float {
+	value := func(x *big.Float) *big.Float {
+		e := p.errorAt(c, x)
+		if sign < 0 {
+			e.Neg(e)
+		}
+		return e
+	}
+
+	// Narrow to the best of a few samples first, in case the error is not unimodal.
+	const samples = 16
+
+	width := newFloat().Sub(hi, lo)
+	width.Quo(width, newInt(samples))
+
+	best, bestValue := lo, value(lo)
+	for i := 1; i <= samples; i++ {
+		x := newFloat().Mul(width, newInt(int64(i)))
+		x.Add(x, lo)
+
+		if v := value(x); v.Cmp(bestValue) > 0 {
+			best, bestValue = x, v
+		}
+	}
+
+	a := newFloat().Sub(best, width)
+	if a.Cmp(lo) < 0 {
+		a.Set(lo)
+	}
+	b := newFloat().Add(best, width)
+	if b.Cmp(hi) > 0 {
+		b.Set(hi)
+	}
+
+	// Golden-section search, keeping the best point seen, which may be an end of the interval.
+	ratio := newFloat().Sqrt(newInt(5))
+	ratio.Sub(ratio, newInt(1))
+	ratio.SetMantExp(ratio, -1) // (√5 - 1)/2
+
+	step := func(a, b *big.Float) *big.Float {
+		d := newFloat().Sub(b, a)
+		return d.Mul(d, ratio)
+	}
+
+	x1 := newFloat().Sub(b, step(a, b))
+	x2 := newFloat().Add(a, step(a, b))
+	v1, v2 := value(x1), value(x2)
+
+	for i := 0; i < searchSteps; i++ {
+		if v1.Cmp(v2) > 0 {
+			b, x2, v2 = x2, x1, v1
+			x1 = newFloat().Sub(b, step(a, b))
+			v1 = value(x1)
+		} else {
+			a, x1, v1 = x1, x2, v2
+			x2 = newFloat().Add(a, step(a, b))
+			v2 = value(x2)
+		}
+	}
+
+	for _, x := range []*big.Float{x1, x2} {
+		if v := value(x); v.Cmp(bestValue) > 0 {
+			best, bestValue = x, v
+		}
+	}
+
+	return best
+}
+
+// exchange returns the reference of the extrema of the error of the polynomial with the coefficients c,
+// whose error alternates in sign over the reference ref.
+func (p *problem) exchange(c []*big.Float, ref []*big.Float) []*big.Float {
+	// The zeros of the error between the points of the reference split the interval
+	// into as many parts as there are points, each with one extremum.
+	bounds := []*big.Float{p.lo}
+	for i := 1; i < len(ref); i++ {
+		bounds = append(bounds, p.zero(c, ref[i-1], ref[i]))
+	}
+	bounds = append(bounds, p.hi)
+
+	next := make([]*big.Float, len(ref))
+	for i := range next {
+		next[i] = p.extremum(c, bounds[i], bounds[i+1], p.errorAt(c, ref[i]).Sign())
+	}
+
+	return next
+}
+
+// maxIterations bounds the number of exchanges, which usually converge within ten.
+const maxIterations = 64
+
+// solution is the minimax polynomial found.
+type solution struct {
+	// coeffs are the coefficients of the polynomial, lowest degree first.
+	coeffs []*big.Float
+
+	// ref is the final reference, where the error equioscillates.
+	ref []*big.Float
+
+	// err is the greatest magnitude of the error.
+	err *big.Float
+}
+
+// solve returns the minimax polynomial of the problem, by the Remez exchange algorithm.
+func (p *problem) solve() (*solution, error) {
+	if p.lo.Cmp(p.hi) >= 0 {
+		return nil, fmt.Errorf("empty interval [%v, %v]", p.lo, p.hi)
+	}
+
+	ref := p.chebyshev()
+
+	for i := 0; i < maxIterations; i++ {
+		c, _, err := p.level(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		ref = p.exchange(c, ref)
+
+		// The levelled error bounds the minimax error from below, and the greatest error from above,
+		// so stop once the errors at the new reference are within a part in a million of each other.
+		lo, hi := p.spread(c, ref)
+
+		spread := newFloat().Sub(hi, lo)
+		if spread.Cmp(newFloat().Quo(hi, newInt(1_000_000))) <= 0 {
+			c, _, err := p.level(ref)
+			if err != nil {
+				return nil, err
+			}
+
+			return &solution{coeffs: c, ref: ref, err: p.maxError(c, ref)}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no convergence after %d exchanges", maxIterations)
+}
+
+// spread returns the least and greatest magnitude of the error over the reference.
+func (p *problem) spread(c []*big.Float, ref []*big.Float) (lo, hi *big.Float) {
+	for _, x := range ref {
+		e := newFloat().Abs(p.errorAt(c, x))
+
+		if lo == nil || e.Cmp(lo) < 0 {
+			lo = e
+		}
+		if hi == nil || e.Cmp(hi) > 0 {
+			hi = e
+		}
+	}
+
+	return lo, hi
+}
+
+// gridPoints is the number of points, evenly spaced across the interval, at which maxError samples the error.
+const gridPoints = 1024
+
+// maxError returns the greatest magnitude of the error of the polynomial with the coefficients c,
+// over the points of ref, and a grid across the interval.
+func (p *problem) maxError(c []*big.Float, ref []*big.Float) *big.Float {
+	_, worst := p.spread(c, ref)
+
+	for i := 0; i <= gridPoints; i++ {
+		x := p.at(newFloat().Quo(newInt(int64(i)), newInt(gridPoints)))
+
+		if e := newFloat().Abs(p.errorAt(c, x)); e.Cmp(worst) > 0 {
+			worst = e
+		}
+	}
+
+	return worst
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestKernels(t *testing.T) {
+	x := 0.5
+	z := x * x
+
+	tests := []struct {
+		name   string
+		expect float64
+	}{
+		{"exp", math.Exp(z)},
+		{"expr", (x*(math.Exp(x)+1)/(math.Exp(x)-1) - 2) / z},
+		{"logr", (math.Log((1+x)/(1-x)) - 2*x) / (x * z)},
+		{"sinr", (math.Sin(x) - x) / (x * z)},
+		{"cosr", (math.Cos(x) - 1 + z/2) / (z * z)},
+	}
+
+	for _, tt := range tests {
+		fn, err := lookup(tt.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, _ := fn.eval(newFloat().SetFloat64(z)).Float64()
+
+		if math.Abs(got-tt.expect) > 1e-12*math.Abs(tt.expect) {
+			t.Errorf("%s(%g) = %g, expected %g", tt.name, z, got, tt.expect)
+		}
+	}
+
+	// The kernels take their limits at zero.
+	for _, tt := range []struct {
+		name   string
+		expect float64
+	}{
+		{"expr", 1.0 / 6},
+		{"logr", 2.0 / 3},
+		{"sinr", -1.0 / 6},
+		{"cosr", 1.0 / 24},
+	} {
+		fn, _ := lookup(tt.name)
+
+		if got, _ := fn.eval(newFloat()).Float64(); got != tt.expect {
+			t.Errorf("%s(0) = %g, expected %g", tt.name, got, tt.expect)
+		}
+	}
+}
+
+func TestEquioscillation(t *testing.T) {
+	fn, _ := lookup("exp")
+
+	p := &problem{
+		f:      fn.eval,
+		lo:     newFloat().SetFloat64(-1),
+		hi:     newFloat().SetFloat64(1),
+		degree: 3,
+	}
+
+	sol, err := p.solve()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sol.ref) != p.degree+2 {
+		t.Fatalf("reference has %d points, expected %d", len(sol.ref), p.degree+2)
+	}
+
+	// The error alternates in sign over the reference, at the greatest magnitude of the error.
+	e := make([]float64, len(sol.ref))
+	for i, x := range sol.ref {
+		e[i], _ = p.errorAt(sol.coeffs, x).Float64()
+	}
+
+	worst, _ := sol.err.Float64()
+
+	for i := range e {
+		if i > 0 && (e[i] < 0) == (e[i-1] < 0) {
+			t.Errorf("error does not alternate in sign: %g", e)
+		}
+
+		if math.Abs(math.Abs(e[i])-worst) > 1e-5*worst {
+			t.Errorf("error %g at %v is not levelled to %g", e[i], sol.ref[i], worst)
+		}
+	}
+
+	// Chebyshev economization bounds the error of degree 3 on [-1, 1] at a little over e/(2⁴·4!).
+	if bound := math.E / (16 * 24); worst > bound {
+		t.Errorf("minimax error %g, expected below %g", worst, bound)
+	}
+}
+
+func TestExpTables(t *testing.T) {
+	fn, _ := lookup("expr")
+
+	p := &problem{
+		f:      fn.eval,
+		lo:     newFloat().SetFloat64(fn.lo),
+		hi:     newFloat().SetFloat64(fn.hi),
+		degree: 4,
+	}
+
+	sol, err := p.solve()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// binary16P5toP0 and bfloat16P5toP0 of the floats package.
+	tests := []struct {
+		format string
+		expect []uint64
+	}{
+		{"f16", []uint64{0x0001, 0x801c, 0x0456, 0x99b0, 0x3155}},
+		{"bf16", []uint64{0x3332, 0xb5de, 0x388b, 0xbb36, 0x3e2b}},
+	}
+
+	for _, tt := range tests {
+		f, err := lookupFormat(tt.format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, expect := range tt.expect {
+			u, _, err := f.encode(sol.coeffs[len(sol.coeffs)-1-i])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if u.Uint64() != expect {
+				t.Errorf("%s: P%d = %#04x, expected %#04x", tt.format, 5-i, u, expect)
+			}
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	f16, _ := lookupFormat("f16")
+
+	tests := []struct {
+		x      *big.Float
+		expect uint64
+	}{
+		{newFloat().SetMantExp(newInt(1), -24), 0x0001},
+		{newFloat().SetMantExp(newInt(3), -26), 0x0001}, // ¾ of the least subnormal rounds up to it
+		{newFloat().Quo(newInt(1), newInt(3)), 0x3555},
+		{newFloat().Quo(newInt(-1), newInt(3)), 0xb555},
+		{newFloat().SetFloat64(1 + 0x1p-11), 0x3c00},
+		{newFloat().SetFloat64(1 + 0x3p-11), 0x3c02},
+		{newFloat().SetFloat64(0x1.ffep0), 0x4000}, // rounds up into the next binade
+		{newFloat().SetFloat64(65504), 0x7bff},
+	}
+
+	for _, tt := range tests {
+		u, _, err := f16.encode(tt.x)
+		if err != nil {
+			t.Errorf("encode(%v): %v", tt.x, err)
+			continue
+		}
+
+		if u.Uint64() != tt.expect {
+			t.Errorf("encode(%v) = %#04x, expected %#04x", tt.x, u, tt.expect)
+		}
+	}
+
+	if _, _, err := f16.encode(newFloat().SetFloat64(65520)); err == nil {
+		t.Error("encode(65520) did not overflow")
+	}
+
+	f128, _ := lookupFormat("f128")
+
+	u, _, err := f128.encode(newFloat().Quo(newInt(1), newInt(6)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expect := f128.literal(u), "bits.Uint128{Hi: 0x3ffc555555555555, Lo: 0x5555555555555555}"; got != expect {
+		t.Errorf("encode(1/6) = %s, expected %s", got, expect)
+	}
+}
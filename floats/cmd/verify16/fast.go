@@ -0,0 +1,189 @@
+package main
+
+import (
+	"cmp"
+	"math"
+)
+
+// The fast paths evaluate operations on values of formats up to binary32 in float64, which holds them exactly,
+// and report whether they could, so that the oracle falls back to big.Float only when they cannot.
+// Every product of two 16-bit values is exact in float64, as is every sum of two Float16 values,
+// and most sums of two BFloat16 values.
+
+// float64 returns the value encoded by u in the format f, which must be no wider than binary32.
+func (f format) float64(u uint64) float64 {
+	neg := u&f.signBit() != 0
+	exp := int(u>>f.mantWidth) & int(f.expMax())
+	mant := u & (1<<f.mantWidth - 1)
+
+	sign := 1.0
+	if neg {
+		sign = -1
+	}
+
+	if exp == int(f.expMax()) {
+		if mant != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	}
+
+	if exp == 0 {
+		exp = 1
+	} else {
+		mant |= 1 << f.mantWidth
+	}
+
+	return math.Copysign(math.Ldexp(float64(mant), exp-f.bias()-f.mantWidth), sign)
+}
+
+// round64 is round, for a float64 v.
+func (f format) round64(v float64, exact bool, neg bool, r rounding) uint64 {
+	if v == 0 && exact {
+		if neg {
+			return f.signBit()
+		}
+		return 0
+	}
+
+	mag := math.Abs(v)
+
+	_, exp := math.Frexp(mag)
+	exp-- // mag is in [2**exp, 2**(exp+1))
+	if v == 0 || exp < 1-f.bias() {
+		exp = 1 - f.bias()
+	}
+
+	// Scaling by a power of two is exact, and the quotient fits well within the precision of a float64.
+	q := math.Ldexp(mag, f.mantWidth-exp)
+
+	n := math.Floor(q)
+	frac := q - n
+
+	half := cmp.Compare(frac, 0.5)
+	if half == 0 && !exact {
+		half = 1
+	}
+	nonzero := frac != 0 || !exact
+
+	inc := increment(r, uint64(n), half, nonzero, neg)
+
+	return f.encode(exp, uint64(n), inc, neg, r)
+}
+
+// finite64 is finite, for a float64 v.
+func (t format) finite64(v float64, neg bool, r rounding) uint64 {
+	if v != 0 {
+		neg = v < 0
+	}
+
+	return t.round64(v, true, neg, r)
+}
+
+// inexact64 is inexact, for a float64 v.
+func (t format) inexact64(v float64, r rounding) uint64 {
+	return t.round64(v, false, v < 0, r)
+}
+
+func isFinite(x float64) bool {
+	return !math.IsNaN(x) && !math.IsInf(x, 0)
+}
+
+func (t format) add64(x, y float64, r rounding) (uint64, bool) {
+	if !isFinite(x) || !isFinite(y) {
+		return 0, false
+	}
+
+	sum := x + y
+
+	// The error of the sum is exactly representable, and zero only if the sum is exact.
+	yy := sum - x
+	if (x-(sum-yy))+(y-yy) != 0 {
+		return 0, false
+	}
+
+	neg := math.Signbit(x) && math.Signbit(y)
+	if r == towardNegative {
+		neg = math.Signbit(x) || math.Signbit(y)
+	}
+
+	return t.finite64(sum, neg, r), true
+}
+
+func (t format) sub64(x, y float64, r rounding) (uint64, bool) {
+	return t.add64(x, -y, r)
+}
+
+func (t format) mul64(x, y float64, r rounding) (uint64, bool) {
+	if !isFinite(x) || !isFinite(y) {
+		return 0, false
+	}
+
+	p := x * y
+	if math.FMA(x, y, -p) != 0 {
+		return 0, false
+	}
+
+	return t.finite64(p, math.Signbit(x) != math.Signbit(y), r), true
+}
+
+func (t format) fma64(x, y, z float64, r rounding) (uint64, bool) {
+	if !isFinite(x) || !isFinite(y) {
+		return 0, false
+	}
+
+	// A product that is exact, zero included, has the sign of the product, so the sum is add64 of it.
+	p := x * y
+	if math.FMA(x, y, -p) != 0 {
+		return 0, false
+	}
+
+	return t.add64(p, z, r)
+}
+
+func (t format) div64(x, y float64, r rounding) (uint64, bool) {
+	if !isFinite(x) || !isFinite(y) || x == 0 || y == 0 {
+		return 0, false
+	}
+
+	q := x / y
+
+	// The residual x - q*y is exact, and its sign tells on which side of q the true quotient lies.
+	res := math.FMA(-q, y, x)
+	switch {
+	case res == 0:
+		return t.finite64(q, false, r), true
+	case ((res < 0) != (y < 0)) == (q < 0):
+		return t.inexact64(q, r), true
+	}
+
+	// No representable number, or halfway point, lies between adjacent float64 values,
+	// so the float64 just toward zero from q is close enough.
+	return t.inexact64(math.Nextafter(q, 0), r), true
+}
+
+func (t format) sqrt64(x float64, r rounding) (uint64, bool) {
+	if !isFinite(x) || x <= 0 {
+		return 0, false
+	}
+
+	s := math.Sqrt(x)
+
+	res := math.FMA(s, s, -x)
+	switch {
+	case res == 0:
+		return t.finite64(s, false, r), true
+	case res < 0:
+		return t.inexact64(s, r), true
+	}
+
+	return t.inexact64(math.Nextafter(s, 0), r), true
+}
+
+func (t format) convert64(x float64, r rounding) (uint64, bool) {
+	if !isFinite(x) {
+		return 0, false
+	}
+
+	return t.finite64(x, math.Signbit(x), r), true
+}
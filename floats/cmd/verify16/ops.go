@@ -0,0 +1,211 @@
+package main
+
+import (
+	"math"
+
+	"github.com/puellanivis/math/floats"
+)
+
+// op is an operation to verify.
+type op struct {
+	name string
+
+	// inputWidth is the number of bits of input to the operation:
+	// 16 for a unary operation, 32 for a binary operation, a conversion from float32,
+	// or a fused multiply-add, whose addend is sampled from the factors.
+	inputWidth int
+
+	// resultFormat returns the format of the result, given the format of the operands.
+	resultFormat func(f format) format
+
+	// oracle returns the expected encoding of the result for input i.
+	oracle func(f format, i uint64, r rounding) uint64
+}
+
+func same(f format) format { return f }
+
+func to(t format) func(format) format {
+	return func(format) format { return t }
+}
+
+// unary returns the oracle of a unary operation, which tries the fast path, if any, before the slow path.
+func unary(fast func(t format, x float64, r rounding) (uint64, bool), slow func(t format, x value, r rounding) uint64) func(f format, i uint64, r rounding) uint64 {
+	return func(f format, i uint64, r rounding) uint64 {
+		if fast != nil {
+			if u, ok := fast(f, f.float64(i), r); ok {
+				return u
+			}
+		}
+
+		return slow(f, f.decode(i), r)
+	}
+}
+
+// binary returns the oracle of a binary operation, which tries the fast path before the slow path.
+func binary(fast func(t format, x, y float64, r rounding) (uint64, bool), slow func(t format, x, y value, r rounding) uint64) func(f format, i uint64, r rounding) uint64 {
+	return func(f format, i uint64, r rounding) uint64 {
+		if u, ok := fast(f, f.float64(i>>16), f.float64(i&0xffff), r); ok {
+			return u
+		}
+
+		return slow(f, f.decode(i>>16), f.decode(i&0xffff), r)
+	}
+}
+
+// convert returns the oracle of a conversion to the format t.
+func convert(t format) func(f format, i uint64, r rounding) uint64 {
+	return func(f format, i uint64, r rounding) uint64 {
+		if u, ok := t.convert64(f.float64(i), r); ok {
+			return u
+		}
+
+		return t.convert(f.decode(i), r)
+	}
+}
+
+// addend returns the addend of the fused multiply-add in the format f for the input i, which holds the two factors.
+// It is pseudo-random, but half the time it takes the exponent of the product, and the opposite sign,
+// so that sums which cancel are sampled as well.
+func addend(f format, i uint64) uint64 {
+	// The finalizer of SplitMix64 spreads every bit of i across the whole of h.
+	h := i + 0x9e3779b97f4a7c15
+	h = (h ^ h>>30) * 0xbf58476d1ce4e5b9
+	h = (h ^ h>>27) * 0x94d049bb133111eb
+	h ^= h >> 31
+
+	z := h & (f.signBit()<<1 - 1)
+	if h&(1<<32) == 0 {
+		return z
+	}
+
+	x, y := i>>16, i&0xffff
+	exp := int(x>>f.mantWidth&f.expMax()) + int(y>>f.mantWidth&f.expMax()) - f.bias()
+	if exp <= 0 || exp >= int(f.expMax()) {
+		return z
+	}
+
+	z &= 1<<f.mantWidth - 1
+	z |= uint64(exp) << f.mantWidth
+	if (x^y)&f.signBit() == 0 {
+		z |= f.signBit()
+	}
+
+	return z
+}
+
+// fma is the oracle of a fused multiply-add, x×y + z, of the factors in the input i and their addend.
+func fma(f format, i uint64, r rounding) uint64 {
+	x, y, z := i>>16, i&0xffff, addend(f, i)
+
+	if u, ok := f.fma64(f.float64(x), f.float64(y), f.float64(z), r); ok {
+		return u
+	}
+
+	return f.fma(f.decode(x), f.decode(y), f.decode(z), r)
+}
+
+// fromFloat32 is the oracle of a conversion from binary32 to the format of the operands.
+func fromFloat32(f format, i uint64, r rounding) uint64 {
+	return convert(f)(binary32, i, r)
+}
+
+var ops = []op{
+	{"add", 32, same, binary(format.add64, format.add)},
+	{"sub", 32, same, binary(format.sub64, format.sub)},
+	{"mul", 32, same, binary(format.mul64, format.mul)},
+	{"div", 32, same, binary(format.div64, format.div)},
+	{"fma", 32, same, fma},
+	{"sqrt", 16, same, unary(format.sqrt64, format.sqrt)},
+	{"exp", 16, same, unary(nil, format.exp)},
+	{"to_f16", 16, to(binary16), convert(binary16)},
+	{"to_bf16", 16, to(bfloat16), convert(bfloat16)},
+	{"to_f32", 16, to(binary32), convert(binary32)},
+	{"to_f64", 16, to(binary64), convert(binary64)},
+	{"from_f32", 32, same, fromFloat32},
+}
+
+// float16 is the set of methods of the 16-bit floats types that are verified.
+type float16[T any, RND floats.RoundingMode] interface {
+	Bits() uint16
+
+	Add(y T) T
+	Sub(y T) T
+	Mul(y T) T
+	Div(y T) T
+	FMA(y, z T) T
+	Sqrt() T
+	Exp() T
+
+	Float16() floats.Float16WithRound[RND]
+	BFloat16() floats.BFloat16WithRound[RND]
+	Float32() floats.Float32WithRound[RND]
+	Float64() floats.Float64WithRound[RND]
+}
+
+// subject returns the floats implementation of the named operation, on the input i.
+func subject[T float16[T, RND], RND floats.RoundingMode](f format, name string, fromBits func(uint16) T, fromFloat32 func(float32) T) func(i uint64) uint64 {
+	x := func(i uint64) T { return fromBits(uint16(i >> 16)) }
+	y := func(i uint64) T { return fromBits(uint16(i)) }
+
+	switch name {
+	case "add":
+		return func(i uint64) uint64 { return uint64(x(i).Add(y(i)).Bits()) }
+	case "sub":
+		return func(i uint64) uint64 { return uint64(x(i).Sub(y(i)).Bits()) }
+	case "mul":
+		return func(i uint64) uint64 { return uint64(x(i).Mul(y(i)).Bits()) }
+	case "div":
+		return func(i uint64) uint64 { return uint64(x(i).Div(y(i)).Bits()) }
+	case "fma":
+		return func(i uint64) uint64 { return uint64(x(i).FMA(y(i), fromBits(uint16(addend(f, i)))).Bits()) }
+	case "sqrt":
+		return func(i uint64) uint64 { return uint64(y(i).Sqrt().Bits()) }
+	case "exp":
+		return func(i uint64) uint64 { return uint64(y(i).Exp().Bits()) }
+	case "to_f16":
+		return func(i uint64) uint64 { return uint64(y(i).Float16().Bits()) }
+	case "to_bf16":
+		return func(i uint64) uint64 { return uint64(y(i).BFloat16().Bits()) }
+	case "to_f32":
+		return func(i uint64) uint64 { return uint64(y(i).Float32().Bits()) }
+	case "to_f64":
+		return func(i uint64) uint64 { return y(i).Float64().Bits() }
+	case "from_f32":
+		return func(i uint64) uint64 { return uint64(fromFloat32(math.Float32frombits(uint32(i))).Bits()) }
+	}
+
+	panic("unknown operation " + name)
+}
+
+func subjectFormat[RND floats.RoundingMode](f format, name string) func(i uint64) uint64 {
+	switch f {
+	case binary16:
+		return subject[floats.Float16WithRound[RND]](f, name, floats.Float16WithRoundFromBits[RND], floats.Float16WithRoundFromFloat[RND, float32])
+	case bfloat16:
+		return subject[floats.BFloat16WithRound[RND]](f, name, floats.BFloat16WithRoundFromBits[RND], floats.BFloat16WithRoundFromFloat[RND, float32])
+	}
+
+	panic("unknown format " + f.name)
+}
+
+// implementation returns the floats implementation of the named operation on the format f, rounded per r.
+func implementation(f format, name string, r rounding) func(i uint64) uint64 {
+	switch r {
+	case tiesToEven:
+		return subjectFormat[floats.RoundTiesToEven](f, name)
+	case tiesToAway:
+		return subjectFormat[floats.RoundTiesToAway](f, name)
+	case tiesToZero:
+		return subjectFormat[floats.RoundTiesToZero](f, name)
+	case tiesToOdd:
+		return subjectFormat[floats.RoundTiesToOdd](f, name)
+	case towardZero:
+		return subjectFormat[floats.RoundTowardZero](f, name)
+	case towardPositive:
+		return subjectFormat[floats.RoundTowardPositive](f, name)
+	case towardNegative:
+		return subjectFormat[floats.RoundTowardNegative](f, name)
+	}
+
+	panic("unknown rounding " + r.String())
+}
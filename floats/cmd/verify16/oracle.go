@@ -0,0 +1,461 @@
+package main
+
+import (
+	"math/big"
+)
+
+// format describes the encoding of a binary floating-point format of up to 64 bits.
+type format struct {
+	name      string
+	mantWidth int
+	expWidth  int
+}
+
+var (
+	binary16 = format{"f16", 10, 5}
+	bfloat16 = format{"bf16", 7, 8}
+	binary32 = format{"f32", 23, 8}
+	binary64 = format{"f64", 52, 11}
+)
+
+func (f format) bias() int {
+	return 1<<(f.expWidth-1) - 1
+}
+
+func (f format) signBit() uint64 {
+	return 1 << (f.mantWidth + f.expWidth)
+}
+
+func (f format) expMax() uint64 {
+	return 1<<f.expWidth - 1
+}
+
+func (f format) inf(neg bool) uint64 {
+	u := f.expMax() << f.mantWidth
+	if neg {
+		u |= f.signBit()
+	}
+	return u
+}
+
+func (f format) nan() uint64 {
+	return f.inf(false) | 1<<(f.mantWidth-1)
+}
+
+func (f format) isNaN(u uint64) bool {
+	return (u>>f.mantWidth)&f.expMax() == f.expMax() && u&(1<<f.mantWidth-1) != 0
+}
+
+// rounding is a rounding-direction attribute, named as the floats.RoundingMode that implements it.
+type rounding int
+
+const (
+	tiesToEven rounding = iota
+	tiesToAway
+	tiesToZero
+	tiesToOdd
+	towardZero
+	towardPositive
+	towardNegative
+)
+
+var roundingNames = []string{
+	tiesToEven:     "RoundTiesToEven",
+	tiesToAway:     "RoundTiesToAway",
+	tiesToZero:     "RoundTiesToZero",
+	tiesToOdd:      "RoundTiesToOdd",
+	towardZero:     "RoundTowardZero",
+	towardPositive: "RoundTowardPositive",
+	towardNegative: "RoundTowardNegative",
+}
+
+func (r rounding) String() string {
+	return roundingNames[r]
+}
+
+// value is a decoded floating-point value.
+type value struct {
+	nan, inf bool
+	neg      bool
+
+	// f is the value, if it is finite.
+	f *big.Float
+}
+
+func (v value) isZero() bool {
+	return !v.nan && !v.inf && v.f.Sign() == 0
+}
+
+// decode returns the value encoded by u in the format f.
+func (f format) decode(u uint64) value {
+	neg := u&f.signBit() != 0
+	exp := int(u>>f.mantWidth) & int(f.expMax())
+	mant := u & (1<<f.mantWidth - 1)
+
+	if exp == int(f.expMax()) {
+		return value{nan: mant != 0, inf: mant == 0, neg: neg}
+	}
+
+	if exp == 0 {
+		// Subnormals have the same exponent as the smallest normal numbers.
+		exp = 1
+	} else {
+		mant |= 1 << f.mantWidth
+	}
+
+	v := new(big.Float).SetUint64(mant)
+	v.SetMantExp(v, exp-f.bias()-f.mantWidth)
+
+	if neg {
+		v.Neg(v)
+	}
+
+	return value{neg: neg, f: v}
+}
+
+// round returns the encoding in f of the real number v rounded per r.
+//
+// If exact is false, then v is not the exact value to be rounded, but a value just toward zero from it,
+// close enough that no representable number or halfway point between them lies between the two.
+// The sign of a zero v is taken from neg.
+func (f format) round(v *big.Float, exact bool, neg bool, r rounding) uint64 {
+	var sign uint64
+	if neg {
+		sign = f.signBit()
+	}
+
+	if v.Sign() == 0 && exact {
+		return sign
+	}
+
+	mag := new(big.Float).Abs(v)
+
+	// The quantum is the distance between adjacent values of the format about mag.
+	exp := mag.MantExp(nil) - 1 // mag is in [2**exp, 2**(exp+1))
+	if v.Sign() == 0 || exp < 1-f.bias() {
+		exp = 1 - f.bias()
+	}
+
+	q := new(big.Float).SetMantExp(mag, f.mantWidth-exp)
+
+	n, _ := q.Uint64()
+	frac := q.Sub(q, new(big.Float).SetUint64(n))
+
+	// Compare the fraction to ½, where any inexactness places it just above where it appears.
+	half := frac.Cmp(big.NewFloat(0.5))
+	if half == 0 && !exact {
+		half = 1
+	}
+	nonzero := frac.Sign() != 0 || !exact
+
+	inc := increment(r, n, half, nonzero, neg)
+
+	return f.encode(exp, n, inc, neg, r)
+}
+
+// increment reports whether a value of n quanta, and a fraction of a quantum more, rounds up to n+1 quanta,
+// where half is the comparison of the fraction to ½, and nonzero reports whether the fraction is not zero.
+func increment(r rounding, n uint64, half int, nonzero bool, neg bool) bool {
+	switch r {
+	case tiesToEven:
+		return half > 0 || (half == 0 && n&1 == 1)
+	case tiesToAway:
+		return half >= 0
+	case tiesToZero:
+		return half > 0
+	case tiesToOdd:
+		return half > 0 || (half == 0 && n&1 == 0)
+	case towardZero:
+		return false
+	case towardPositive:
+		return nonzero && !neg
+	case towardNegative:
+		return nonzero && neg
+	}
+
+	return false
+}
+
+// encode returns the encoding of n quanta of 2**(exp-mantWidth), incremented by one if inc is set, with the sign neg.
+// It handles overflow per r.
+func (f format) encode(exp int, n uint64, inc bool, neg bool, r rounding) uint64 {
+	var sign uint64
+	if neg {
+		sign = f.signBit()
+	}
+
+	if inc {
+		n++
+	}
+
+	// The biased exponent of the quantum, less one, is added to the integral significand,
+	// so that the carry of a significand that rounds up to the next binade is carried into the exponent.
+	u := uint64(exp+f.bias()-1)<<f.mantWidth + n
+
+	if u >= f.inf(false) {
+		// Overflow goes to the largest finite value, when rounding would never go that far.
+		switch r {
+		case towardZero:
+			u = f.inf(false) - 1
+		case towardPositive:
+			if neg {
+				u = f.inf(false) - 1
+			}
+		case towardNegative:
+			if !neg {
+				u = f.inf(false) - 1
+			}
+		}
+
+		u = min(u, f.inf(false))
+	}
+
+	return u | sign
+}
+
+// exactPrec is a precision enough to hold exactly any sum or product of two values of 16-bit formats,
+// whose exponent range spans less than 300 bits, and any sum of such a product and a third value.
+const exactPrec = 512
+
+// finite returns the encoding in t of the exact finite value v, rounded per r.
+// A zero v has the sign given by neg.
+func (t format) finite(v *big.Float, neg bool, r rounding) uint64 {
+	if v.Sign() != 0 {
+		neg = v.Sign() < 0
+	}
+
+	return t.round(v, true, neg, r)
+}
+
+// inexact returns the encoding in t of a non-zero value approximated by v, rounded per r,
+// where v is known to be toward zero from the value, and close enough to it to round the same way.
+func (t format) inexact(v *big.Float, r rounding) uint64 {
+	return t.round(v, false, v.Sign() < 0, r)
+}
+
+func (t format) add(x, y value, r rounding) uint64 {
+	switch {
+	case x.nan || y.nan:
+		return t.nan()
+	case x.inf && y.inf && x.neg != y.neg:
+		return t.nan()
+	case x.inf:
+		return t.inf(x.neg)
+	case y.inf:
+		return t.inf(y.neg)
+	}
+
+	sum := new(big.Float).SetPrec(exactPrec).Add(x.f, y.f)
+
+	// An exact zero sum is negative only if both operands are, or when rounding toward negative.
+	neg := x.neg && y.neg
+	if r == towardNegative {
+		neg = x.neg || y.neg
+	}
+
+	return t.finite(sum, neg, r)
+}
+
+func (v value) negate() value {
+	v.neg = !v.neg
+	if v.f != nil {
+		v.f = new(big.Float).Neg(v.f)
+	}
+	return v
+}
+
+func (t format) sub(x, y value, r rounding) uint64 {
+	return t.add(x, y.negate(), r)
+}
+
+func (t format) mul(x, y value, r rounding) uint64 {
+	neg := x.neg != y.neg
+
+	switch {
+	case x.nan || y.nan:
+		return t.nan()
+	case x.inf && y.isZero(), x.isZero() && y.inf:
+		return t.nan()
+	case x.inf || y.inf:
+		return t.inf(neg)
+	}
+
+	return t.finite(new(big.Float).SetPrec(exactPrec).Mul(x.f, y.f), neg, r)
+}
+
+func (t format) fma(x, y, z value, r rounding) uint64 {
+	neg := x.neg != y.neg
+
+	switch {
+	case x.nan || y.nan || z.nan:
+		return t.nan()
+	case x.inf && y.isZero(), x.isZero() && y.inf:
+		return t.nan()
+	case x.inf || y.inf:
+		return t.add(value{inf: true, neg: neg}, z, r)
+	}
+
+	// The product is exact, and keeps its sign even when zero, so the sum rounds just as add does.
+	p := value{neg: neg, f: new(big.Float).SetPrec(exactPrec).Mul(x.f, y.f)}
+
+	return t.add(p, z, r)
+}
+
+func (t format) div(x, y value, r rounding) uint64 {
+	neg := x.neg != y.neg
+
+	switch {
+	case x.nan || y.nan:
+		return t.nan()
+	case x.inf && y.inf, x.isZero() && y.isZero():
+		return t.nan()
+	case x.inf, y.isZero():
+		return t.inf(neg)
+	case y.inf:
+		return t.finite(new(big.Float), neg, r)
+	}
+
+	q := new(big.Float).SetPrec(exactPrec).SetMode(big.ToZero).Quo(x.f, y.f)
+	if q.Acc() == big.Exact {
+		return t.finite(q, neg, r)
+	}
+
+	return t.inexact(q, r)
+}
+
+func (t format) sqrt(x value, r rounding) uint64 {
+	switch {
+	case x.nan:
+		return t.nan()
+	case x.isZero():
+		return t.finite(x.f, x.neg, r)
+	case x.neg:
+		return t.nan()
+	case x.inf:
+		return t.inf(false)
+	}
+
+	s := new(big.Float).SetPrec(exactPrec).Sqrt(x.f)
+
+	sq := new(big.Float).SetPrec(2*exactPrec).Mul(s, s)
+	if sq.Cmp(x.f) == 0 {
+		return t.finite(s, false, r)
+	}
+
+	// The square root is correct to within an ulp, so a step of two ulps down is toward zero from the true value.
+	return t.inexact(below(s, exactPrec-2), r)
+}
+
+// below returns v moved toward zero by a relative 2**-bits.
+func below(v *big.Float, bits uint) *big.Float {
+	step := new(big.Float).SetMantExp(v, -int(bits))
+	return new(big.Float).SetPrec(v.Prec()).Sub(v, step)
+}
+
+func (t format) convert(x value, r rounding) uint64 {
+	switch {
+	case x.nan:
+		return t.nan()
+	case x.inf:
+		return t.inf(x.neg)
+	}
+
+	return t.finite(x.f, x.neg, r)
+}
+
+func (t format) exp(x value, r rounding) uint64 {
+	switch {
+	case x.nan:
+		return t.nan()
+	case x.inf && x.neg:
+		return t.finite(new(big.Float), false, r)
+	case x.inf:
+		return t.inf(false)
+	case x.isZero():
+		return t.finite(big.NewFloat(1), false, r)
+	}
+
+	// Far enough out, e**x is well past the largest finite value, or half the smallest subnormal,
+	// and only its direction matters to rounding.
+	if limit := float64(2 * (t.bias() + t.mantWidth + 2)); x.f.Cmp(big.NewFloat(limit)) > 0 {
+		return t.inexact(new(big.Float).SetMantExp(big.NewFloat(1), 4*t.bias()), r)
+	} else if x.f.Cmp(big.NewFloat(-limit)) < 0 {
+		return t.inexact(new(big.Float).SetMantExp(big.NewFloat(1), -4*t.bias()), r)
+	}
+
+	// e**x is transcendental for any rational x other than zero, and so never exact.
+	// Evaluate it at increasing precisions until the bounds on the error round to the same value.
+	for prec := uint(128); ; prec *= 2 {
+		v := bigExp(x.f, prec)
+
+		lo := t.inexact(below(v, prec-8), r)
+		hi := t.inexact(above(v, prec-8), r)
+
+		if lo == hi {
+			return lo
+		}
+	}
+}
+
+// above returns v moved away from zero by a relative 2**-bits.
+func above(v *big.Float, bits uint) *big.Float {
+	step := new(big.Float).SetMantExp(v, -int(bits))
+	return new(big.Float).SetPrec(v.Prec()).Add(v, step)
+}
+
+// bigLn2 returns ln(2) to a relative precision of about 2**-prec.
+func bigLn2(prec uint) *big.Float {
+	// ln(2) = 2 atanh(⅓) = 2 Σ 1 / ((2k+1) 3**(2k+1))
+	wp := prec + 32
+
+	sum := new(big.Float).SetPrec(wp)
+	pow := new(big.Float).SetPrec(wp).Quo(big.NewFloat(1), big.NewFloat(3))
+	ninth := new(big.Float).SetPrec(wp).Quo(big.NewFloat(1), big.NewFloat(9))
+	term := new(big.Float).SetPrec(wp)
+
+	for k := int64(0); ; k++ {
+		term.Quo(pow, new(big.Float).SetInt64(2*k+1))
+		if term.Sign() == 0 || term.MantExp(nil) < -int(wp) {
+			break
+		}
+
+		sum.Add(sum, term)
+		pow.Mul(pow, ninth)
+	}
+
+	return sum.Mul(sum, big.NewFloat(2))
+}
+
+// bigExp returns e**x to a relative precision of about 2**-prec.
+func bigExp(x *big.Float, prec uint) *big.Float {
+	wp := prec + 64
+
+	// e**x = 2**k × e**r, with k = round(x / ln 2), and |r| ≤ ½ ln 2.
+	ln2 := bigLn2(wp)
+
+	kf := new(big.Float).SetPrec(wp).Quo(x, ln2)
+	k, _ := kf.Int64()
+	if kf.Sign() < 0 {
+		k--
+	}
+
+	r := new(big.Float).SetPrec(wp).Mul(ln2, new(big.Float).SetInt64(k))
+	r.Sub(x, r)
+
+	// e**r = Σ r**n / n!
+	sum := new(big.Float).SetPrec(wp).SetInt64(1)
+	term := new(big.Float).SetPrec(wp).SetInt64(1)
+
+	for n := int64(1); ; n++ {
+		term.Mul(term, r)
+		term.Quo(term, new(big.Float).SetInt64(n))
+
+		if term.Sign() == 0 || term.MantExp(nil) < -int(wp) {
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	return sum.SetMantExp(sum, int(k))
+}
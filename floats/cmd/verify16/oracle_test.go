@@ -0,0 +1,206 @@
+package main
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// TestOracleFloat32 checks the oracle for binary32 against native float32 arithmetic,
+// which is correctly rounded with ties to even.
+func TestOracleFloat32(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	tests := []struct {
+		name   string
+		oracle func(x, y value) uint64
+		native func(x, y float32) float32
+	}{
+		{"add", func(x, y value) uint64 { return binary32.add(x, y, tiesToEven) }, func(x, y float32) float32 { return x + y }},
+		{"sub", func(x, y value) uint64 { return binary32.sub(x, y, tiesToEven) }, func(x, y float32) float32 { return x - y }},
+		{"mul", func(x, y value) uint64 { return binary32.mul(x, y, tiesToEven) }, func(x, y float32) float32 { return x * y }},
+		{"div", func(x, y value) uint64 { return binary32.div(x, y, tiesToEven) }, func(x, y float32) float32 { return x / y }},
+		{"sqrt", func(x, _ value) uint64 { return binary32.sqrt(x, tiesToEven) }, func(x, _ float32) float32 { return float32(math.Sqrt(float64(x))) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for n := 0; n < 1<<14; n++ {
+				a, b := rng.Uint32(), rng.Uint32()
+
+				// Bring the exponents together at times, so that sums cancel, and products stay finite.
+				if n&1 == 1 {
+					b = b&0x807fffff | a&0x7f800000
+				}
+
+				x, y := math.Float32frombits(a), math.Float32frombits(b)
+
+				got := tt.oracle(binary32.decode(uint64(a)), binary32.decode(uint64(b)))
+				expect := uint64(math.Float32bits(tt.native(x, y)))
+
+				if got != expect && !(binary32.isNaN(got) && binary32.isNaN(expect)) {
+					t.Fatalf("%s(%08x, %08x) = %08x, expected %08x", tt.name, a, b, got, expect)
+				}
+			}
+		})
+	}
+}
+
+func TestOracleRounding(t *testing.T) {
+	// 1 + 2⁻¹¹ is halfway between 1 and the next Float16.
+	tie := binary16.decode(0x3c00)
+	tie.f.Add(tie.f, binary16.decode(0x1000).f)
+
+	tests := []struct {
+		r      rounding
+		expect uint64
+	}{
+		{tiesToEven, 0x3c00},
+		{tiesToAway, 0x3c01},
+		{tiesToZero, 0x3c00},
+		{tiesToOdd, 0x3c01},
+		{towardZero, 0x3c00},
+		{towardPositive, 0x3c01},
+		{towardNegative, 0x3c00},
+	}
+
+	for _, tt := range tests {
+		if got := binary16.finite(tie.f, false, tt.r); got != tt.expect {
+			t.Errorf("%v: 1 + 2⁻¹¹ rounded to %04x, expected %04x", tt.r, got, tt.expect)
+		}
+	}
+
+	// The largest finite value, plus half an ulp, is a tie that overflows unless it rounds toward zero.
+	big := binary16.decode(0x7bff)
+	big.f.Add(big.f, binary16.decode(0x4c00).f)
+
+	for _, tt := range []struct {
+		r      rounding
+		expect uint64
+	}{
+		{tiesToEven, 0x7c00},
+		{tiesToZero, 0x7bff},
+		{towardZero, 0x7bff},
+		{towardPositive, 0x7c00},
+	} {
+		if got := binary16.finite(big.f, false, tt.r); got != tt.expect {
+			t.Errorf("%v: MaxFloat16 + ½ulp rounded to %04x, expected %04x", tt.r, got, tt.expect)
+		}
+	}
+}
+
+func TestOracleExp(t *testing.T) {
+	tests := []struct {
+		x, expect uint64
+	}{
+		{0x0000, 0x3c00},
+		{0x1000, 0x3c01}, // e**(2⁻¹¹) is just above halfway
+		{0x1d7c, 0x3c05}, // and e**0x1.5fp-8 just below halfway
+		{0x3c00, 0x4170},
+		{0x4a00, 0x7c00},
+		{0xcd00, 0x0000},
+		{0xfc00, 0x0000},
+	}
+
+	for _, tt := range tests {
+		if got := binary16.exp(binary16.decode(tt.x), tiesToEven); got != tt.expect {
+			t.Errorf("exp(%04x) = %04x, expected %04x", tt.x, got, tt.expect)
+		}
+	}
+}
+
+// TestFastPaths checks that the float64 fast paths agree with big.Float, wherever they apply.
+func TestFastPaths(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+
+	tests := []struct {
+		name string
+		fast func(f format, x, y float64, r rounding) (uint64, bool)
+		slow func(f format, x, y value, r rounding) uint64
+	}{
+		{"add", format.add64, format.add},
+		{"sub", format.sub64, format.sub},
+		{"mul", format.mul64, format.mul},
+		{"div", format.div64, format.div},
+		{"sqrt", func(f format, x, _ float64, r rounding) (uint64, bool) { return f.sqrt64(x, r) }, func(f format, x, _ value, r rounding) uint64 { return f.sqrt(x, r) }},
+		{"to_f16", func(_ format, x, _ float64, r rounding) (uint64, bool) { return binary16.convert64(x, r) }, func(_ format, x, _ value, r rounding) uint64 { return binary16.convert(x, r) }},
+		{"to_bf16", func(_ format, x, _ float64, r rounding) (uint64, bool) { return bfloat16.convert64(x, r) }, func(_ format, x, _ value, r rounding) uint64 { return bfloat16.convert(x, r) }},
+	}
+
+	for _, f := range []format{binary16, bfloat16, binary32} {
+		for _, tt := range tests {
+			t.Run(f.name+"_"+tt.name, func(t *testing.T) {
+				fast := 0
+
+				for n := 0; n < 1<<13; n++ {
+					a, b := rng.Uint64()&(f.signBit()<<1-1), rng.Uint64()&(f.signBit()<<1-1)
+					r := rounding(n % len(roundingNames))
+
+					got, ok := tt.fast(f, f.float64(a), f.float64(b), r)
+					if !ok {
+						continue
+					}
+					fast++
+
+					if expect := tt.slow(f, f.decode(a), f.decode(b), r); got != expect {
+						t.Fatalf("%v: %s(%x, %x) = %x, expected %x", r, tt.name, a, b, got, expect)
+					}
+				}
+
+				if fast < 1<<10 {
+					t.Errorf("only %d of %d inputs took the fast path", fast, 1<<13)
+				}
+			})
+		}
+	}
+}
+
+// TestOracleFMA checks the oracle of fused multiply-add for binary64 against math.FMA,
+// and its fast path against its slow path, on sampled addends.
+func TestOracleFMA(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+
+	for n := 0; n < 1<<14; n++ {
+		// Keep the exponents within ±64, so that the exact sum fits within exactPrec.
+		var u [3]uint64
+		for k := range u {
+			u[k] = rng.Uint64()&0x800fffffffffffff | uint64(1023-64+rng.IntN(128))<<52
+		}
+		if n&1 == 1 {
+			// Cancel the product with the addend.
+			p := math.Float64frombits(u[0]) * math.Float64frombits(u[1])
+			u[2] = math.Float64bits(-p) ^ rng.Uint64()&0xff
+		}
+
+		x, y, z := math.Float64frombits(u[0]), math.Float64frombits(u[1]), math.Float64frombits(u[2])
+
+		got := binary64.fma(binary64.decode(u[0]), binary64.decode(u[1]), binary64.decode(u[2]), tiesToEven)
+		if expect := math.Float64bits(math.FMA(x, y, z)); got != expect {
+			t.Fatalf("fma(%016x, %016x, %016x) = %016x, expected %016x", u[0], u[1], u[2], got, expect)
+		}
+	}
+
+	for _, f := range []format{binary16, bfloat16} {
+		fast := 0
+
+		for n := 0; n < 1<<14; n++ {
+			i := rng.Uint64() & 0xffffffff
+			x, y, z := i>>16, i&0xffff, addend(f, i)
+			r := rounding(n % len(roundingNames))
+
+			got, ok := f.fma64(f.float64(x), f.float64(y), f.float64(z), r)
+			if !ok {
+				continue
+			}
+			fast++
+
+			if expect := f.fma(f.decode(x), f.decode(y), f.decode(z), r); got != expect {
+				t.Fatalf("%s %v: fma(%04x, %04x, %04x) = %x, expected %x", f.name, r, x, y, z, got, expect)
+			}
+		}
+
+		if fast < 1<<10 {
+			t.Errorf("%s: only %d of %d inputs took the fast path", f.name, fast, 1<<14)
+		}
+	}
+}
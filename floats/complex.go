@@ -0,0 +1,414 @@
+package floats
+
+import (
+	"fmt"
+	"strings"
+)
+
+// complexPart is the set of floating-point types that may be used as the parts of a Complex number.
+type complexPart[T any] interface {
+	Floating[T]
+
+	ldexp(exp int) T
+	logHypot(T) T
+	sinCos() (sin, cos T)
+	atan2(x T) T
+}
+
+// Complex is a complex number with real and imaginary parts of the floating-point type T.
+//
+// Arithmetic follows the semantics of Annex G of the C standard,
+// so that a complex number with an infinite part is treated as an infinity, even if the other part is NaN.
+type Complex[T complexPart[T]] struct {
+	re, im T
+}
+
+// Complex32 is a complex number made of two IEEE 754 16-bit floating-point numbers.
+type Complex32 = Complex[Float16]
+
+// Complex64 is a complex number made of two IEEE 754 32-bit floating-point numbers.
+type Complex64 = Complex[Float32]
+
+// Complex128 is a complex number made of two IEEE 754 64-bit floating-point numbers.
+type Complex128 = Complex[Float64]
+
+// Complex256 is a complex number made of two IEEE 754 128-bit floating-point numbers.
+type Complex256 = Complex[Float128]
+
+// ComplexFromParts returns the complex number re + im×i.
+func ComplexFromParts[T complexPart[T]](re, im T) Complex[T] {
+	return Complex[T]{re, im}
+}
+
+// Format implements [fmt.Formatter].
+// The number is formatted as (re+imi), as the builtin complex types are.
+func (z Complex[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' {
+		// As with the builtin complex types, %v formats each part as %g.
+		verb = 'g'
+	}
+
+	spec := fmt.FormatString(f, verb)
+
+	// The imaginary part always shows its sign.
+	imSpec := "%+" + strings.TrimLeft(spec[1:], "+")
+
+	fmt.Fprintf(f, "("+spec+imSpec+"i)", z.re, z.im)
+}
+
+// Real returns the real part of z.
+func (z Complex[T]) Real() T {
+	return z.re
+}
+
+// Imag returns the imaginary part of z.
+func (z Complex[T]) Imag() T {
+	return z.im
+}
+
+// IsInf reports whether either part of z is an infinity.
+func (z Complex[T]) IsInf() bool {
+	return z.re.IsInf(0) || z.im.IsInf(0)
+}
+
+// IsNaN reports whether either part of z is NaN, and neither is an infinity.
+func (z Complex[T]) IsNaN() bool {
+	return (z.re.IsNaN() || z.im.IsNaN()) && !z.IsInf()
+}
+
+// Conj returns the complex conjugate of z.
+func (z Complex[T]) Conj() Complex[T] {
+	return Complex[T]{z.re, z.im.Neg()}
+}
+
+// Neg returns the negation of z.
+func (z Complex[T]) Neg() Complex[T] {
+	return Complex[T]{z.re.Neg(), z.im.Neg()}
+}
+
+// Abs returns the absolute value, or modulus, of z.
+func (z Complex[T]) Abs() T {
+	return z.re.Hypot(z.im)
+}
+
+// Phase returns the phase, or argument, of z, in the range [-π, π].
+func (z Complex[T]) Phase() T {
+	return z.im.atan2(z.re)
+}
+
+// Add returns the sum of z and w.
+func (z Complex[T]) Add(w Complex[T]) Complex[T] {
+	return Complex[T]{z.re.Add(w.re), z.im.Add(w.im)}
+}
+
+// Sub returns the difference of z and w.
+func (z Complex[T]) Sub(w Complex[T]) Complex[T] {
+	return Complex[T]{z.re.Sub(w.re), z.im.Sub(w.im)}
+}
+
+// infOrZero returns ±1 with the sign of x if x is an infinity, or ±0 with the sign of x otherwise.
+func infOrZero[T complexPart[T]](x T) T {
+	var zero T
+
+	if x.IsInf(0) {
+		return zero.fromFloat64(1).CopySign(x)
+	}
+
+	return zero.CopySign(x)
+}
+
+// zeroIfNaN returns ±0 with the sign of x if x is NaN, or x otherwise.
+func zeroIfNaN[T complexPart[T]](x T) T {
+	var zero T
+
+	if x.IsNaN() {
+		return zero.CopySign(x)
+	}
+
+	return x
+}
+
+// Mul returns the product of z and w.
+//
+// The product is computed in the usual way, with each multiplication and addition rounded,
+// except that an infinite operand always gives an infinite result, following Annex G of the C standard.
+func (z Complex[T]) Mul(w Complex[T]) Complex[T] {
+	a, b, c, d := z.re, z.im, w.re, w.im
+
+	ac, bd := a.Mul(c), b.Mul(d)
+	ad, bc := a.Mul(d), b.Mul(c)
+
+	x, y := ac.Sub(bd), ad.Add(bc)
+
+	if !x.IsNaN() || !y.IsNaN() {
+		return Complex[T]{x, y}
+	}
+
+	var recalc bool
+
+	if a.IsInf(0) || b.IsInf(0) {
+		// z is infinite, so turn it into a box.
+		a, b = infOrZero(a), infOrZero(b)
+		c, d = zeroIfNaN(c), zeroIfNaN(d)
+		recalc = true
+	}
+
+	if c.IsInf(0) || d.IsInf(0) {
+		// w is infinite, so turn it into a box.
+		c, d = infOrZero(c), infOrZero(d)
+		a, b = zeroIfNaN(a), zeroIfNaN(b)
+		recalc = true
+	}
+
+	if !recalc && (ac.IsInf(0) || bd.IsInf(0) || ad.IsInf(0) || bc.IsInf(0)) {
+		// Recover infinities from overflow, by changing NaNs to 0.
+		a, b = zeroIfNaN(a), zeroIfNaN(b)
+		c, d = zeroIfNaN(c), zeroIfNaN(d)
+		recalc = true
+	}
+
+	if recalc {
+		var zero T
+		inf := zero.fromFloat64(1).Div(zero)
+
+		x = inf.Mul(a.Mul(c).Sub(b.Mul(d)))
+		y = inf.Mul(a.Mul(d).Add(b.Mul(c)))
+	}
+
+	return Complex[T]{x, y}
+}
+
+// Div returns the quotient of z and w.
+//
+// The divisor is scaled by a power of two to avoid unnecessary overflow and underflow,
+// and infinite and zero operands are handled as in Annex G of the C standard.
+func (z Complex[T]) Div(w Complex[T]) Complex[T] {
+	a, b, c, d := z.re, z.im, w.re, w.im
+
+	var zero T
+
+	var ilogbw int
+
+	maxw := c.Abs().Max(d.Abs())
+
+	logbw := maxw.LogB()
+	if !logbw.IsInf(0) && !logbw.IsNaN() {
+		ilogbw, _ = maxw.ILogB()
+
+		c, d = c.ldexp(-ilogbw), d.ldexp(-ilogbw)
+	}
+
+	denom := c.Mul(c).Add(d.Mul(d))
+
+	x := a.Mul(c).Add(b.Mul(d)).Div(denom).ldexp(-ilogbw)
+	y := b.Mul(c).Sub(a.Mul(d)).Div(denom).ldexp(-ilogbw)
+
+	if !x.IsNaN() || !y.IsNaN() {
+		return Complex[T]{x, y}
+	}
+
+	inf := zero.fromFloat64(1).Div(zero)
+
+	switch {
+	case denom == zero && (!a.IsNaN() || !b.IsNaN()):
+		// Division of a non-zero number by zero.
+		inf = inf.CopySign(c)
+		x, y = inf.Mul(a), inf.Mul(b)
+
+	case (a.IsInf(0) || b.IsInf(0)) && !c.IsInf(0) && !c.IsNaN() && !d.IsInf(0) && !d.IsNaN():
+		// Division of an infinity by a finite number.
+		a, b = infOrZero(a), infOrZero(b)
+		x = inf.Mul(a.Mul(c).Add(b.Mul(d)))
+		y = inf.Mul(b.Mul(c).Sub(a.Mul(d)))
+
+	case logbw.IsInf(1) && !a.IsInf(0) && !a.IsNaN() && !b.IsInf(0) && !b.IsNaN():
+		// Division of a finite number by an infinity.
+		c, d = infOrZero(c), infOrZero(d)
+		x = zero.Mul(a.Mul(c).Add(b.Mul(d)))
+		y = zero.Mul(b.Mul(c).Sub(a.Mul(d)))
+	}
+
+	return Complex[T]{x, y}
+}
+
+// Sqrt returns the principal square root of z, which has a non-negative real part.
+//
+// Special cases are:
+//
+//	(±0+0i).Sqrt() = +0+0i
+//	(x+∞i).Sqrt() = +∞+∞i, for all x, even NaN
+//	(-∞+yi).Sqrt() = +0+∞i, for finite y ≥ 0
+//	(+∞+yi).Sqrt() = +∞+0i, for finite y ≥ 0
+//	(-∞+NaNi).Sqrt() = NaN+∞i
+//	(+∞+NaNi).Sqrt() = +∞+NaNi
+//
+// Otherwise, if either part is NaN, the result is NaN+NaNi.
+// The result for z.Conj() is the conjugate of the result for z.
+func (z Complex[T]) Sqrt() Complex[T] {
+	a, b := z.re, z.im
+
+	var zero T
+
+	switch {
+	case b.IsInf(0):
+		return Complex[T]{b.Abs(), b}
+
+	case a.IsNaN():
+		return Complex[T]{a, a}
+
+	case a.IsInf(0):
+		if a.SignBit() {
+			return Complex[T]{b.Sub(b).Abs(), a.Abs().CopySign(b)}
+		}
+		return Complex[T]{a, b.Sub(b).CopySign(b)}
+
+	case b.IsNaN():
+		return Complex[T]{b, b}
+	}
+
+	re, okRe := a.ILogB()
+	im, okIm := b.ILogB()
+
+	var k int
+
+	switch {
+	case okRe && okIm:
+		k = max(re, im)
+	case okRe:
+		k = re
+	case okIm:
+		k = im
+	default:
+		return Complex[T]{zero, b}
+	}
+
+	// Scale z by an even power of two, so that neither overflow nor underflow occurs,
+	// and the square root is then scaled back by half that power.
+	k &^= 1
+
+	sa, sb := a.ldexp(-k), b.ldexp(-k)
+
+	t := sa.Abs().Add(sa.Hypot(sb)).ldexp(-1).Sqrt().ldexp(k / 2)
+
+	// other = |b| / 2t, which cannot overflow, since t² ≥ |b|/2.
+	other := b.Abs().Div(t.Add(t))
+
+	if a.SignBit() {
+		return Complex[T]{other, t.CopySign(b)}
+	}
+
+	return Complex[T]{t, other.CopySign(b)}
+}
+
+// Exp returns e**z, the base-e exponential of z.
+//
+// Special cases are:
+//
+//	(±0+0i).Exp() = 1+0i
+//	(x+∞i).Exp() = NaN+NaNi, for finite x
+//	(+∞+0i).Exp() = +∞+0i
+//	(-∞+yi).Exp() = +0×(cos(y)+sin(y)i), for finite y
+//	(+∞+yi).Exp() = +∞×(cos(y)+sin(y)i), for finite y ≠ 0
+//	(-∞+∞i).Exp() = +0+0i
+//	(+∞+∞i).Exp() = +∞+NaNi
+//	(NaN+0i).Exp() = NaN+0i
+//
+// Otherwise, if either part is NaN, the result is NaN+NaNi.
+// The result for z.Conj() is the conjugate of the result for z.
+func (z Complex[T]) Exp() Complex[T] {
+	a, b := z.re, z.im
+
+	var zero T
+
+	switch {
+	case b == zero || b == zero.Neg():
+		return Complex[T]{a.Exp(), b}
+
+	case a.IsInf(0):
+		if b.IsInf(0) || b.IsNaN() {
+			if a.SignBit() {
+				return Complex[T]{zero, zero.CopySign(b)}
+			}
+			return Complex[T]{a, b.Sub(b)}
+		}
+
+	case a.IsNaN() || b.IsInf(0) || b.IsNaN():
+		nan := zero.Div(zero)
+		return Complex[T]{nan, nan}
+	}
+
+	sin, cos := b.sinCos()
+
+	r := a.Exp()
+	if !r.IsInf(1) || a.IsInf(1) {
+		return Complex[T]{r.Mul(cos), r.Mul(sin)}
+	}
+
+	// e**a overflows, but e**a × cos(b) or e**a × sin(b) might not.
+	h := a.ldexp(-1).Exp()
+
+	return Complex[T]{h.Mul(cos).Mul(h), h.Mul(sin).Mul(h)}
+}
+
+// Log returns the principal natural logarithm of z.
+// The imaginary part of the result is in the range [-π, π].
+//
+// Special cases are:
+//
+//	(-0+0i).Log() = -∞+πi
+//	(+0+0i).Log() = -∞+0i
+//	(x+∞i).Log() = +∞+πi/2, for finite x
+//	(x+NaNi).Log() = NaN+NaNi, for finite x
+//	(-∞+yi).Log() = +∞+πi, for finite y ≥ 0
+//	(+∞+yi).Log() = +∞+0i, for finite y ≥ 0
+//	(-∞+∞i).Log() = +∞+3πi/4
+//	(+∞+∞i).Log() = +∞+πi/4
+//	(±∞+NaNi).Log() = +∞+NaNi
+//	(NaN+yi).Log() = NaN+NaNi, for finite y
+//	(NaN+∞i).Log() = +∞+NaNi
+//
+// The result for z.Conj() is the conjugate of the result for z.
+func (z Complex[T]) Log() Complex[T] {
+	return Complex[T]{z.re.logHypot(z.im), z.im.atan2(z.re)}
+}
+
+// Pow returns z**w, the base-z exponential of w, computed as (w × z.Log()).Exp().
+//
+// Special cases follow those of [math/cmplx.Pow]:
+//
+//	0.Pow(NaN) = NaN+NaNi
+//	0.Pow(w) = 1+0i, for real(w) = 0
+//	0.Pow(w) = +∞+0i, for real(w) < 0 and imag(w) = 0
+//	0.Pow(w) = +∞+∞i, for real(w) < 0 and imag(w) ≠ 0
+//	0.Pow(w) = 0, for real(w) > 0
+func (z Complex[T]) Pow(w Complex[T]) Complex[T] {
+	var zero T
+
+	isZero := func(x T) bool {
+		return x == zero || x == zero.Neg()
+	}
+
+	if isZero(z.re) && isZero(z.im) {
+		one := zero.fromFloat64(1)
+		inf := one.Div(zero)
+
+		switch {
+		case w.re.IsNaN() || w.im.IsNaN():
+			nan := zero.Div(zero)
+			return Complex[T]{nan, nan}
+
+		case isZero(w.re):
+			return Complex[T]{one, zero}
+
+		case w.re.SignBit():
+			if isZero(w.im) {
+				return Complex[T]{inf, zero}
+			}
+			return Complex[T]{inf, inf}
+		}
+
+		return Complex[T]{}
+	}
+
+	return w.Mul(z.Log()).Exp()
+}
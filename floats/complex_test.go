@@ -0,0 +1,117 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestComplex32(t *testing.T) {
+	type test struct {
+		name string
+		op   func(z, w Complex32) Complex32
+		z, w [2]uint16
+
+		expect [2]uint16
+	}
+
+	add := func(z, w Complex32) Complex32 { return z.Add(w) }
+	mul := func(z, w Complex32) Complex32 { return z.Mul(w) }
+	div := func(z, w Complex32) Complex32 { return z.Div(w) }
+	sqrt := func(z, _ Complex32) Complex32 { return z.Sqrt() }
+	exp := func(z, _ Complex32) Complex32 { return z.Exp() }
+	log := func(z, _ Complex32) Complex32 { return z.Log() }
+	pow := func(z, w Complex32) Complex32 { return z.Pow(w) }
+
+	tests := []test{
+		{"add", add, [2]uint16{0x3c00, 0x4000}, [2]uint16{0x4200, 0xc400}, [2]uint16{0x4400, 0xc000}},
+		{"mul", mul, [2]uint16{0x3c00, 0x4000}, [2]uint16{0x4200, 0x4400}, [2]uint16{0xc500, 0x4900}},
+		{"mul inf by nan", mul, [2]uint16{0x7c00, 0x7e00}, [2]uint16{0x4000, 0x0000}, [2]uint16{0x7c00, 0x7e00}},
+		{"mul nan by inf", mul, [2]uint16{0x7e00, 0x7e00}, [2]uint16{0x7e00, 0xfc00}, [2]uint16{0x7e00, 0x7e00}},
+		{"mul overflow", mul, [2]uint16{0x7bff, 0x7bff}, [2]uint16{0x7bff, 0xfbff}, [2]uint16{0x7c00, 0x7e00}},
+		{"div", div, [2]uint16{0xc500, 0x4900}, [2]uint16{0x4200, 0x4400}, [2]uint16{0x3c00, 0x4000}},
+		{"div by zero", div, [2]uint16{0x3c00, 0x3c00}, [2]uint16{0x0000, 0x0000}, [2]uint16{0x7c00, 0x7c00}},
+		{"div inf by finite", div, [2]uint16{0x7c00, 0x7e00}, [2]uint16{0x3c00, 0x4000}, [2]uint16{0x7c00, 0xfc00}},
+		{"div finite by inf", div, [2]uint16{0x3c00, 0x4000}, [2]uint16{0x7c00, 0x7e00}, [2]uint16{0x0000, 0x0000}},
+		{"div without underflow", div, [2]uint16{0x3c00, 0x3c00}, [2]uint16{0x7800, 0x7800}, [2]uint16{0x0200, 0x0000}},
+		{"sqrt of -4", sqrt, [2]uint16{0xc400, 0x0000}, [2]uint16{}, [2]uint16{0x0000, 0x4000}},
+		{"sqrt of -4-0i", sqrt, [2]uint16{0xc400, 0x8000}, [2]uint16{}, [2]uint16{0x0000, 0xc000}},
+		{"sqrt of 2i", sqrt, [2]uint16{0x0000, 0x4000}, [2]uint16{}, [2]uint16{0x3c00, 0x3c00}},
+		{"sqrt of max", sqrt, [2]uint16{0x7bff, 0x7bff}, [2]uint16{}, [2]uint16{0x5c65, 0x5747}},
+		{"sqrt of nan+inf", sqrt, [2]uint16{0x7e00, 0x7c00}, [2]uint16{}, [2]uint16{0x7c00, 0x7c00}},
+		{"sqrt of -inf+nan", sqrt, [2]uint16{0xfc00, 0x7e00}, [2]uint16{}, [2]uint16{0x7e00, 0x7c00}},
+		{"exp of zero", exp, [2]uint16{0x0000, 0x8000}, [2]uint16{}, [2]uint16{0x3c00, 0x8000}},
+		{"exp of -inf", exp, [2]uint16{0xfc00, 0x3c00}, [2]uint16{}, [2]uint16{0x0000, 0x0000}},
+		{"exp of inf+inf", exp, [2]uint16{0x7c00, 0x7c00}, [2]uint16{}, [2]uint16{0x7c00, 0x7e00}},
+		{"exp of 1i", exp, [2]uint16{0x0000, 0x3c00}, [2]uint16{}, [2]uint16{0x3853, 0x3abb}},
+		{"log of -1", log, [2]uint16{0xbc00, 0x0000}, [2]uint16{}, [2]uint16{0x0000, 0x4248}},
+		{"log of -0", log, [2]uint16{0x8000, 0x0000}, [2]uint16{}, [2]uint16{0xfc00, 0x4248}},
+		{"log of inf i", log, [2]uint16{0x3c00, 0x7c00}, [2]uint16{}, [2]uint16{0x7c00, 0x3e48}},
+		{"log of -inf+inf", log, [2]uint16{0xfc00, 0x7c00}, [2]uint16{}, [2]uint16{0x7c00, 0x40b6}},
+		{"log of 3+4i", log, [2]uint16{0x4200, 0x4400}, [2]uint16{}, [2]uint16{0x3e70, 0x3b6b}},
+		{"pow of zero", pow, [2]uint16{0x0000, 0x0000}, [2]uint16{0x0000, 0x3c00}, [2]uint16{0x3c00, 0x0000}},
+		{"pow of zero by negative", pow, [2]uint16{0x0000, 0x0000}, [2]uint16{0xbc00, 0x0000}, [2]uint16{0x7c00, 0x0000}},
+		{"pow of zero by positive", pow, [2]uint16{0x0000, 0x0000}, [2]uint16{0x3c00, 0x3c00}, [2]uint16{0x0000, 0x0000}},
+		{"pow square root", pow, [2]uint16{0x4400, 0x0000}, [2]uint16{0x3800, 0x0000}, [2]uint16{0x4000, 0x0000}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			z := ComplexFromParts(Float16FromBits(tt.z[0]), Float16FromBits(tt.z[1]))
+			w := ComplexFromParts(Float16FromBits(tt.w[0]), Float16FromBits(tt.w[1]))
+
+			got := tt.op(z, w)
+			if re, im := got.Real().Bits(), got.Imag().Bits(); re != tt.expect[0] || im != tt.expect[1] {
+				t.Errorf("%s(%04x, %04x) = %04x, but expected %04x", tt.name, tt.z, tt.w, [2]uint16{re, im}, tt.expect)
+			}
+		})
+	}
+}
+
+func TestComplexFormat(t *testing.T) {
+	z := ComplexFromParts(Float64FromFloat(1.5), Float64FromFloat(-2.0))
+	w := ComplexFromParts(Float16FromFloat(0.25), Float16FromFloat(3.0))
+
+	tests := []struct {
+		format string
+		val    any
+		expect string
+	}{
+		{"%v", z, fmt.Sprintf("%v", complex(1.5, -2))},
+		{"%v", w, fmt.Sprintf("%v", complex(0.25, 3))},
+		{"%.2f", w, fmt.Sprintf("%.2f", complex(0.25, 3))},
+		{"%6.1f", z, fmt.Sprintf("%6.1f", complex(1.5, -2))},
+		{"%+g", w, fmt.Sprintf("%+g", complex(0.25, 3))},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.val); got != tt.expect {
+			t.Errorf("Sprintf(%q, %v) = %q, but expected %q", tt.format, tt.val, got, tt.expect)
+		}
+	}
+}
+
+func TestComplex128Elementary(t *testing.T) {
+	within1ULP := func(got Float64, expect float64) bool {
+		g := got.Native()
+		return g == expect || math.Nextafter(g, expect) == expect
+	}
+
+	for _, v := range []complex128{1 + 2i, -3 + 0.5i, 1e300 - 1e300i, -2 - 1e-5i, 100 + 7i, 0.5 - 1e10i} {
+		z := ComplexFromParts(Float64FromFloat(real(v)), Float64FromFloat(imag(v)))
+
+		if got, expect := z.Log(), cmplx.Log(v); !within1ULP(got.Real(), real(expect)) || !within1ULP(got.Imag(), imag(expect)) {
+			t.Errorf("(%v).Log() = %v, but expected %v", v, got, expect)
+		}
+
+		if got, expect := z.Sqrt(), cmplx.Sqrt(v); !within1ULP(got.Real(), real(expect)) || !within1ULP(got.Imag(), imag(expect)) {
+			t.Errorf("(%v).Sqrt() = %v, but expected %v", v, got, expect)
+		}
+
+		if got, expect := z.Phase(), cmplx.Phase(v); !within1ULP(got, expect) {
+			t.Errorf("(%v).Phase() = %v, but expected %v", v, got, expect)
+		}
+	}
+}
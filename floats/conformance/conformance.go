@@ -0,0 +1,303 @@
+// Package conformance runs floating-point test vectors against the floats types.
+//
+// Test vectors are read in the format written by testfloat_gen of Berkeley TestFloat,
+// or in the .fptest format of the IBM FPgen test suite.
+// Each vector is run against the floats type of its format with the rounding mode of the vector,
+// and the result, and the exception flags, are compared to those expected.
+//
+// The floats types do not signal exceptions.
+// Instead, the flags of a result are those that IEEE 754 requires of the operation,
+// derived from its operands and the exact value of the operation, evaluated with big.Float,
+// except that inexact is derived from whether the result returned is equal to that exact value.
+// Underflow is signaled when the result is both tiny and inexact,
+// where tininess is detected either before or after rounding, as selected by the Runner.
+//
+// NaN results are all equal to each other, regardless of sign or payload.
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/puellanivis/math/bits"
+)
+
+// ErrUnsupported is returned for a test vector of an operation, format, or rounding mode that cannot be run.
+var ErrUnsupported = errors.New("conformance: unsupported test vector")
+
+// Flags is a set of IEEE 754 exception flags.
+//
+// The values of the flags are those of Berkeley TestFloat and SoftFloat.
+type Flags uint8
+
+// IEEE 754 exception flags.
+const (
+	Inexact Flags = 1 << iota
+	Underflow
+	Overflow
+	DivideByZero
+	Invalid
+)
+
+// String returns the flags as the letters used by IBM FPgen: x, u, o, z, and i, in that order.
+func (f Flags) String() string {
+	var b strings.Builder
+
+	for i, c := range "xuozi" {
+		if f&(1<<i) != 0 {
+			b.WriteRune(c)
+		}
+	}
+
+	if b.Len() == 0 {
+		return "-"
+	}
+
+	return b.String()
+}
+
+// Format is a binary floating-point format.
+type Format int
+
+// Binary floating-point formats, each of which corresponds to a floats type.
+const (
+	Binary16 Format = iota
+	BFloat16
+	Binary32
+	Binary64
+	Binary128
+)
+
+var formats = []struct {
+	name      string
+	mantWidth int
+	expWidth  int
+}{
+	Binary16:  {"binary16", 10, 5},
+	BFloat16:  {"bfloat16", 7, 8},
+	Binary32:  {"binary32", 23, 8},
+	Binary64:  {"binary64", 52, 11},
+	Binary128: {"binary128", 112, 15},
+}
+
+func (f Format) String() string {
+	if f < 0 || int(f) >= len(formats) {
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+
+	return formats[f].name
+}
+
+func (f Format) mantWidth() int {
+	return formats[f].mantWidth
+}
+
+func (f Format) expWidth() int {
+	return formats[f].expWidth
+}
+
+// bias returns the exponent bias of the format, which is also its maximum exponent.
+func (f Format) bias() int {
+	return 1<<(f.expWidth()-1) - 1
+}
+
+// Rounding is an IEEE 754 rounding-direction attribute.
+type Rounding int
+
+// IEEE 754 rounding-direction attributes.
+const (
+	TiesToEven Rounding = iota
+	TiesToAway
+	TowardZero
+	TowardNegative
+	TowardPositive
+)
+
+var roundingNames = []string{
+	TiesToEven:     "roundTiesToEven",
+	TiesToAway:     "roundTiesToAway",
+	TowardZero:     "roundTowardZero",
+	TowardNegative: "roundTowardNegative",
+	TowardPositive: "roundTowardPositive",
+}
+
+func (r Rounding) String() string {
+	if r < 0 || int(r) >= len(roundingNames) {
+		return fmt.Sprintf("Rounding(%d)", int(r))
+	}
+
+	return roundingNames[r]
+}
+
+// Op is an operation exercised by a test vector.
+type Op int
+
+// Operations that may be exercised by a test vector.
+//
+// Comparisons return a result of 1 for true, and 0 for false.
+// OpEq, OpLeQuiet, and OpLtQuiet are quiet comparisons, which signal invalid only for a signaling NaN,
+// while OpEqSignaling, OpLe, and OpLt are signaling comparisons, which signal invalid for any NaN.
+//
+// OpFMA is a fused multiply-add, x×y + z, rounded once.
+//
+// OpRoundToInt rounds to an integral value in the rounding mode of the vector, without signaling inexact.
+const (
+	OpAdd Op = iota
+	OpSub
+	OpMul
+	OpDiv
+	OpFMA
+	OpRem
+	OpSqrt
+	OpRoundToInt
+	OpConvert
+	OpEq
+	OpLe
+	OpLt
+	OpEqSignaling
+	OpLeQuiet
+	OpLtQuiet
+)
+
+var ops = []struct {
+	name     string
+	operands int
+}{
+	OpAdd:         {"add", 2},
+	OpSub:         {"sub", 2},
+	OpMul:         {"mul", 2},
+	OpDiv:         {"div", 2},
+	OpFMA:         {"mulAdd", 3},
+	OpRem:         {"rem", 2},
+	OpSqrt:        {"sqrt", 1},
+	OpRoundToInt:  {"roundToInt", 1},
+	OpConvert:     {"convert", 1},
+	OpEq:          {"eq", 2},
+	OpLe:          {"le", 2},
+	OpLt:          {"lt", 2},
+	OpEqSignaling: {"eq_signaling", 2},
+	OpLeQuiet:     {"le_quiet", 2},
+	OpLtQuiet:     {"lt_quiet", 2},
+}
+
+func (op Op) String() string {
+	if op < 0 || int(op) >= len(ops) {
+		return fmt.Sprintf("Op(%d)", int(op))
+	}
+
+	return ops[op].name
+}
+
+// operands returns the number of operands taken by the operation.
+func (op Op) operands() int {
+	return ops[op].operands
+}
+
+// isCompare reports whether the operation is a comparison, with a result of 0 or 1.
+func (op Op) isCompare() bool {
+	return op >= OpEq
+}
+
+// Case is a single test vector.
+type Case struct {
+	// Line is the line number of the vector in its source, counting from 1.
+	Line int
+
+	// Text is the line of the vector, as it appears in its source.
+	Text string
+
+	// Err is set if the vector could not be parsed, or cannot be run,
+	// in which case the other fields, except Line and Text, might not be set.
+	// It wraps ErrUnsupported for a vector that is well-formed, but cannot be run.
+	Err error
+
+	Op       Op
+	Rounding Rounding
+
+	// Format is the format of the operands,
+	// and ResultFormat is the format of the result, which differs only for OpConvert.
+	Format       Format
+	ResultFormat Format
+
+	// Inputs are the encodings of the operands.
+	Inputs []bits.Uint128
+
+	// Result is the encoding of the expected result.
+	Result bits.Uint128
+
+	// Flags are the expected exception flags.
+	Flags Flags
+
+	// NoFlags is set when the vector does not specify which flags are expected,
+	// in which case only its result is checked.
+	NoFlags bool
+}
+
+// Tininess selects when an underflow is detected.
+//
+// IEEE 754 permits a binary format to detect tininess either before or after rounding.
+type Tininess int
+
+const (
+	// TininessAfterRounding detects a tiny result after rounding it to the precision of the format,
+	// as if the exponent range were unbounded. This is the default of SoftFloat on x86.
+	TininessAfterRounding Tininess = iota
+
+	// TininessBeforeRounding detects a tiny result before it is rounded.
+	TininessBeforeRounding
+)
+
+// Outcome is the result of running a test vector.
+type Outcome struct {
+	// Result is the encoding of the result returned.
+	Result bits.Uint128
+
+	// Flags are the exception flags of the result.
+	Flags Flags
+}
+
+// Runner runs test vectors against the floats types.
+type Runner struct {
+	// Tininess selects when an underflow is detected.
+	Tininess Tininess
+}
+
+// Run runs the test vector c, and returns its outcome.
+// It returns an error if c.Err is set, or if the vector cannot be run.
+func (r *Runner) Run(c *Case) (Outcome, error) {
+	if c.Err != nil {
+		return Outcome{}, c.Err
+	}
+
+	if len(c.Inputs) != c.Op.operands() {
+		return Outcome{}, fmt.Errorf("conformance: %v takes %d operands, but vector has %d", c.Op, c.Op.operands(), len(c.Inputs))
+	}
+
+	result, err := run(c)
+	if err != nil {
+		return Outcome{}, err
+	}
+
+	return Outcome{
+		Result: result,
+		Flags:  r.flags(c, result),
+	}, nil
+}
+
+// Check reports whether the outcome o of running the test vector c is the outcome expected.
+func (c *Case) Check(o Outcome) bool {
+	if !c.NoFlags && o.Flags != c.Flags {
+		return false
+	}
+
+	if c.Op.isCompare() {
+		return o.Result == c.Result
+	}
+
+	if isNaN(c.ResultFormat, c.Result) {
+		return isNaN(c.ResultFormat, o.Result)
+	}
+
+	return o.Result == c.Result
+}
@@ -0,0 +1,168 @@
+package conformance
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// runAll runs every vector read by s, and fails t for any vector that does not have its expected outcome.
+func runAll(t *testing.T, r *Runner, s *Scanner) {
+	t.Helper()
+
+	var n int
+
+	for s.Scan() {
+		c := s.Case()
+		n++
+
+		o, err := r.Run(c)
+		if err != nil {
+			t.Errorf("line %d: %q: unexpected error: %v", c.Line, c.Text, err)
+			continue
+		}
+
+		if !c.Check(o) {
+			t.Errorf("line %d: %q: got %x %v, expected %x %v", c.Line, c.Text, o.Result, o.Flags, c.Result, c.Flags)
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if n == 0 {
+		t.Fatal("no vectors were read")
+	}
+}
+
+const testFloatVectors = `
+# Exact, and inexact, arithmetic.
+f16_add rnear_even 3C00 4000 4200 00
+f16_sub rnear_even 3C00 4000 BC00 00
+f16_mul rnear_even 3C00 4000 4000 00
+f16_sqrt rnear_even 4000 3DA8 01
+f16_div rnear_even 3C00 4200 3555 01
+f16_div rmax 3C00 4200 3556 01
+f64_add rnear_even 3FF0000000000000 3CA0000000000000 3FF0000000000000 01
+f128_mul rnear_even 3FFF0000000000000000000000000000 40000000000000000000000000000000 40000000000000000000000000000000 00
+f16_rem rnear_even 4200 4000 BC00 00
+f16_roundToInt rnear_maxMag 3800 3C00 00
+f16_roundToInt rnear_even 3800 0000 00
+
+# Fused multiply-add, rounded once, and cancelling exactly to a zero of the sign of the rounding.
+f16_mulAdd rnear_even 3C00 4000 3C00 4200 00
+f16_mulAdd rnear_even 3C01 3C01 BC00 1800 01
+f16_mulAdd rmin 3C00 3C00 BC00 8000 00
+f16_mulAdd rminMag 7BFF 4000 0000 7BFF 05
+f16_mulAdd rnear_even 7C00 0000 3C00 7E00 10
+f16_mulAdd rnear_even 7C00 3C00 FC00 7E00 10
+
+# Overflow, and underflow.
+f16_add rnear_even 7BFF 7BFF 7C00 05
+f16_mul rnear_even 0001 3800 0000 03
+f32_to_f16 rnear_even 33000000 0000 03
+f16_to_f64 rnear_even 3C00 3FF0000000000000 00
+
+# Invalid, and divide by zero.
+f16_div rnear_even 3C00 0000 7C00 08
+f16_div rnear_even 0000 0000 7E00 10
+f16_sqrt rnear_even BC00 7E00 10
+f16_sqrt rnear_even 8000 8000 00
+f16_add rnear_even 7C00 FC00 7E00 10
+f16_add rnear_even 7D00 3C00 7E00 10
+
+# Comparisons.
+f16_eq rnear_even 7E00 3C00 0 00
+f16_eq rnear_even 7D00 3C00 0 10
+f16_lt rnear_even 7E00 3C00 0 10
+f16_lt_quiet rnear_even 7E00 3C00 0 00
+f16_le rnear_even 8000 0000 1 00
+f16_lt rnear_even 8000 0000 0 00
+`
+
+func TestTestFloat(t *testing.T) {
+	runAll(t, new(Runner), NewTestFloatScanner(strings.NewReader(testFloatVectors), "", ""))
+}
+
+func TestTestFloatGen(t *testing.T) {
+	// The output of testfloat_gen f16_mul -rminMag has no function or rounding mode on each line.
+	vectors := "3C00 4000 4000 00\n3C01 3C01 3C02 01\n"
+
+	runAll(t, new(Runner), NewTestFloatScanner(strings.NewReader(vectors), "f16_mul", "-rminMag"))
+}
+
+func TestTininess(t *testing.T) {
+	// (1 + 2⁻¹⁰) × 0x1.ff8p-15 is just below the smallest normal number, but rounds up to it.
+	vector := "f16_mul rnear_even 3C01 03FF 0400 %s\n"
+
+	runAll(t, &Runner{Tininess: TininessAfterRounding}, NewTestFloatScanner(strings.NewReader(strings.ReplaceAll(vector, "%s", "01")), "", ""))
+	runAll(t, &Runner{Tininess: TininessBeforeRounding}, NewTestFloatScanner(strings.NewReader(strings.ReplaceAll(vector, "%s", "03")), "", ""))
+}
+
+const fpgenVectors = `
+b32+ =0 +1.000000P0 +1.000000P0 -> +1.000000P1
+b32- =0 +1.000000P0 +1.000000P0 -> +Zero
+b32*+ =0 +1.000000P0 +1.000000P0 +1.000000P0 -> +1.000000P1
+b32/ =0 +1.000000P0 +Zero -> +Inf z
+b32/ =0 +1.000000P0 +1.400000P1 -> +1.2AAAABP-2 x
+b32/ 0 +1.000000P0 +1.400000P1 -> +1.2AAAAAP-2 x
+b32/ > +1.000000P0 +1.400000P1 -> +1.2AAAABP-2 x
+b32* =0 +1.7FFFFFP127 +1.000000P1 -> +Inf xo
+b32* =0 +0.000001P-126 +1.000000P-1 -> +Zero xu
+b32* =^ +0.000001P-126 +1.000000P-1 -> +0.000001P-126 xu
+b32V =0 -1.000000P0 -> Q i
+b32V =0 +1.000000P2 -> +1.000000P1
+b32% =0 +1.400000P1 +1.000000P1 -> -1.000000P0
+b32rfi =0 +1.200000P1 -> +1.000000P1
+b32b64cff =0 +0.000001P-126 -> +1.0000000000000P-149
+b64b32cff =0 +1.0000000000000P-150 -> +Zero xu
+b64+ =0 S +1.0000000000000P0 -> Q i
+b128* =0 +1.0000000000000000000000000000P0 -Inf -> -Inf
+`
+
+func TestFPgen(t *testing.T) {
+	runAll(t, new(Runner), NewFPgenScanner(strings.NewReader(fpgenVectors)))
+}
+
+func TestUnsupported(t *testing.T) {
+	vectors := []struct {
+		name string
+		s    *Scanner
+	}{
+		{"decimal", NewFPgenScanner(strings.NewReader("d64+ =0 +1E0 +1E0 -> +2E0\n"))},
+		{"trapped", NewFPgenScanner(strings.NewReader("b32* =0 o +1.7FFFFFP127 +1.000000P1 -> # o\n"))},
+		{"round to odd", NewTestFloatScanner(strings.NewReader("f16_add rodd 3C00 4000 4200 00\n"), "", "")},
+		{"integer", NewTestFloatScanner(strings.NewReader("f16_to_i32 rnear_even 3C00 00000001 00\n"), "", "")},
+	}
+
+	for _, tt := range vectors {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.s.Scan() {
+				t.Fatal("no vector was read")
+			}
+
+			c := tt.s.Case()
+			if _, err := new(Runner).Run(c); !errors.Is(err, ErrUnsupported) {
+				t.Errorf("Run(%q) = %v, expected ErrUnsupported", c.Text, err)
+			}
+		})
+	}
+}
+
+func TestMismatch(t *testing.T) {
+	s := NewTestFloatScanner(strings.NewReader("f16_add rnear_even 3C00 4000 4200 01\nf16_add rnear_even 3C00 4000 4400 00\n"), "", "")
+
+	for s.Scan() {
+		c := s.Case()
+
+		o, err := new(Runner).Run(c)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if c.Check(o) {
+			t.Errorf("line %d: %q: Check() = true, expected false", c.Line, c.Text)
+		}
+	}
+}
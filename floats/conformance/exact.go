@@ -0,0 +1,292 @@
+package conformance
+
+import (
+	"math/big"
+
+	"github.com/puellanivis/math/bits"
+)
+
+// class is the class of an encoded floating-point value.
+type class int
+
+const (
+	classFinite class = iota // including zero
+	classInf
+	classQNaN
+	classSNaN
+)
+
+// value is a decoded floating-point value.
+type value struct {
+	class class
+	neg   bool
+
+	// f is the value, if it is finite, and nil otherwise.
+	f *big.Float
+}
+
+func (v value) isNaN() bool {
+	return v.class == classQNaN || v.class == classSNaN
+}
+
+func (v value) isZero() bool {
+	return v.class == classFinite && v.f.Sign() == 0
+}
+
+func shr(x bits.Uint128, k int) bits.Uint128 {
+	var b bits.Bits128
+	return b.Shr(x, k)
+}
+
+// fields splits an encoding of f into its sign, biased exponent, and mantissa.
+func fields(f Format, u bits.Uint128) (neg bool, exp int, mant bits.Uint128) {
+	var b bits.Bits128
+
+	mant = b.And(u, b.Pow2m1(f.mantWidth()))
+	exp = int(shr(u, f.mantWidth()).Lo) & (1<<f.expWidth() - 1)
+	neg = shr(u, f.mantWidth()+f.expWidth()).Lo&1 != 0
+
+	return neg, exp, mant
+}
+
+func isNaN(f Format, u bits.Uint128) bool {
+	_, exp, mant := fields(f, u)
+	return exp == 1<<f.expWidth()-1 && mant != (bits.Uint128{})
+}
+
+// decode returns the value encoded by u in the format f.
+func decode(f Format, u bits.Uint128) value {
+	neg, exp, mant := fields(f, u)
+
+	if exp == 1<<f.expWidth()-1 {
+		switch {
+		case mant == (bits.Uint128{}):
+			return value{class: classInf, neg: neg}
+		case shr(mant, f.mantWidth()-1).Lo&1 != 0:
+			return value{class: classQNaN, neg: neg}
+		}
+		return value{class: classSNaN, neg: neg}
+	}
+
+	m := new(big.Int).Lsh(new(big.Int).SetUint64(mant.Hi), 64)
+	m.Or(m, new(big.Int).SetUint64(mant.Lo))
+
+	if exp == 0 {
+		// Subnormals have the same exponent as the smallest normal numbers.
+		exp = 1
+	} else {
+		m.SetBit(m, f.mantWidth(), 1)
+	}
+
+	v := new(big.Float).SetInt(m)
+	v.SetMantExp(v, exp-f.bias()-f.mantWidth())
+
+	if neg {
+		v.Neg(v)
+	}
+
+	return value{neg: neg, f: v}
+}
+
+// limits returns the largest finite magnitude, and the smallest normal magnitude of the format f.
+func limits(f Format) (max, minNormal *big.Float) {
+	one := big.NewFloat(1)
+
+	ulp := new(big.Float).SetMantExp(one, -f.mantWidth())
+	max = new(big.Float).SetPrec(uint(f.mantWidth())+1).Sub(big.NewFloat(2), ulp)
+	max.SetMantExp(max, f.bias())
+
+	return max, new(big.Float).SetMantExp(one, 1-f.bias())
+}
+
+var bigModes = []big.RoundingMode{
+	TiesToEven:     big.ToNearestEven,
+	TiesToAway:     big.ToNearestAway,
+	TowardZero:     big.ToZero,
+	TowardNegative: big.ToNegativeInf,
+	TowardPositive: big.ToPositiveInf,
+}
+
+// flags returns the exception flags of the result of the test vector c.
+func (r *Runner) flags(c *Case, result bits.Uint128) Flags {
+	var flags Flags
+
+	xs := make([]value, len(c.Inputs))
+	nan := false
+
+	for i, in := range c.Inputs {
+		xs[i] = decode(c.Format, in)
+
+		if xs[i].class == classSNaN {
+			flags |= Invalid
+		}
+
+		nan = nan || xs[i].isNaN()
+	}
+
+	if nan {
+		switch c.Op {
+		case OpEqSignaling, OpLe, OpLt:
+			flags |= Invalid
+		}
+
+		// A quiet NaN propagates through every other operation without an exception.
+		return flags
+	}
+
+	if c.Op.isCompare() {
+		return 0
+	}
+
+	x := xs[0]
+
+	var y, z value
+	if len(xs) > 1 {
+		y = xs[1]
+	}
+	if len(xs) > 2 {
+		z = xs[2]
+	}
+
+	prec := uint(c.Format.mantWidth()+1) * 3
+
+	var exact *big.Float
+	isExact := true
+
+	switch c.Op {
+	case OpAdd, OpSub:
+		yNeg := y.neg != (c.Op == OpSub)
+
+		switch {
+		case x.class == classInf && y.class == classInf && x.neg != yNeg:
+			return Invalid
+		case x.class == classInf || y.class == classInf:
+			return 0
+		}
+
+		// An exact sum can need as many bits as the full exponent range of the format.
+		exact = new(big.Float).SetPrec(uint(1<<c.Format.expWidth() + 2*c.Format.mantWidth() + 4))
+
+		if c.Op == OpSub {
+			exact.Sub(x.f, y.f)
+		} else {
+			exact.Add(x.f, y.f)
+		}
+
+	case OpMul:
+		switch {
+		case x.class == classInf && y.isZero(), x.isZero() && y.class == classInf:
+			return Invalid
+		case x.class == classInf || y.class == classInf:
+			return 0
+		}
+
+		exact = new(big.Float).SetPrec(prec).Mul(x.f, y.f)
+
+	case OpFMA:
+		prodNeg := x.neg != y.neg
+
+		switch {
+		case x.class == classInf && y.isZero(), x.isZero() && y.class == classInf:
+			return Invalid
+		case x.class == classInf || y.class == classInf:
+			if z.class == classInf && z.neg != prodNeg {
+				return Invalid
+			}
+			return 0
+		case z.class == classInf:
+			return 0
+		}
+
+		// The product is exact in twice the precision, and its sum can need as many bits as its exponent range.
+		exact = new(big.Float).SetPrec(uint(2<<c.Format.expWidth() + 4*c.Format.mantWidth() + 8))
+		exact.Mul(x.f, y.f)
+		exact.Add(exact, z.f)
+
+	case OpDiv:
+		switch {
+		case x.class == classInf && y.class == classInf, x.isZero() && y.isZero():
+			return Invalid
+		case x.class == classInf || y.class == classInf:
+			return 0
+		case y.isZero():
+			return DivideByZero
+		}
+
+		// A quotient that is exact at all has no more significant bits than its dividend.
+		exact = new(big.Float).SetPrec(prec).Quo(x.f, y.f)
+		isExact = exact.Acc() == big.Exact
+
+	case OpRem:
+		if x.class == classInf || y.isZero() {
+			return Invalid
+		}
+
+		// The remainder is always exact.
+		return 0
+
+	case OpSqrt:
+		switch {
+		case x.isZero():
+			return 0
+		case x.neg:
+			return Invalid
+		case x.class == classInf:
+			return 0
+		}
+
+		exact = new(big.Float).SetPrec(prec).Sqrt(x.f)
+
+		sq := new(big.Float).SetPrec(2*prec).Mul(exact, exact)
+		isExact = sq.Cmp(x.f) == 0
+
+	case OpRoundToInt:
+		// Rounding to an integral value never raises an exception for a non-NaN operand.
+		return 0
+
+	case OpConvert:
+		if x.class == classInf {
+			return 0
+		}
+
+		exact = x.f
+	}
+
+	return r.numericFlags(c, exact, isExact, decode(c.ResultFormat, result))
+}
+
+// numericFlags returns the exception flags of rounding the finite value exact to the result format of c,
+// where isExact reports whether exact is the exact value of the operation,
+// and got is the result that was returned.
+func (r *Runner) numericFlags(c *Case, exact *big.Float, isExact bool, got value) Flags {
+	if exact.Sign() == 0 {
+		return 0
+	}
+
+	f := c.ResultFormat
+	max, minNormal := limits(f)
+
+	// Round to the precision of the format, as if the exponent range were unbounded.
+	rounded := new(big.Float).SetPrec(uint(f.mantWidth()) + 1).SetMode(bigModes[c.Rounding]).Set(exact)
+
+	mag := new(big.Float).Abs(rounded)
+	if mag.Cmp(max) > 0 {
+		return Overflow | Inexact
+	}
+
+	var flags Flags
+
+	if !isExact || got.class != classFinite || got.f.Cmp(exact) != 0 {
+		flags |= Inexact
+	}
+
+	if r.Tininess == TininessBeforeRounding {
+		mag = new(big.Float).Abs(exact)
+	}
+
+	if flags&Inexact != 0 && mag.Cmp(minNormal) < 0 {
+		flags |= Underflow
+	}
+
+	return flags
+}
@@ -0,0 +1,250 @@
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/puellanivis/math/bits"
+)
+
+var fpgenFormats = map[string]Format{
+	"b16":  Binary16,
+	"b32":  Binary32,
+	"b64":  Binary64,
+	"b128": Binary128,
+}
+
+var fpgenOps = map[string]Op{
+	"+":   OpAdd,
+	"-":   OpSub,
+	"*":   OpMul,
+	"/":   OpDiv,
+	"*+":  OpFMA,
+	"%":   OpRem,
+	"V":   OpSqrt,
+	"rfi": OpRoundToInt,
+	"cff": OpConvert,
+}
+
+var fpgenRoundings = map[string]Rounding{
+	"=0": TiesToEven,
+	"=^": TiesToAway,
+	"0":  TowardZero,
+	"<":  TowardNegative,
+	">":  TowardPositive,
+}
+
+var fpgenFlags = map[rune]Flags{
+	'x': Inexact,
+	'u': Underflow,
+	'v': Underflow,
+	'w': Underflow,
+	'o': Overflow,
+	'z': DivideByZero,
+	'i': Invalid,
+}
+
+// NewFPgenScanner returns a Scanner that reads test vectors in the .fptest format of IBM FPgen from r.
+//
+// Each line is the format and operation, the rounding mode, optionally the exceptions that are trapped,
+// the operands, "->", the result, and the exceptions expected, as in:
+//
+//	b32+ =0 +1.000000P0 +1.000000P0 -> +1.000000P1
+//	b32/ =0 +1.000000P0 +Zero -> +Inf z
+//
+// The operations supported are +, -, *, /, *+ (fused multiply-add), % (remainder), V (square root),
+// rfi (round to integral), and cff (conversion, as in b32b64cff) of the binary formats.
+// Vectors of any other operation, of a decimal format, or with any exceptions trapped, are unsupported.
+// The underflow exceptions u, v, and w are all read as underflow.
+func NewFPgenScanner(r io.Reader) *Scanner {
+	return newScanner(r, "#", parseFPgen)
+}
+
+func parseFPgen(c *Case, fields []string) error {
+	if err := parseFPgenOp(c, fields[0]); err != nil {
+		return err
+	}
+
+	if len(fields) < 2 {
+		return errors.New("conformance: missing rounding mode")
+	}
+
+	rnd, ok := fpgenRoundings[fields[1]]
+	if !ok {
+		return fmt.Errorf("conformance: unknown rounding mode %q", fields[1])
+	}
+	c.Rounding = rnd
+
+	fields = fields[2:]
+
+	if len(fields) > 0 && isFPgenFlags(fields[0]) {
+		return fmt.Errorf("%w: trapped exceptions %q", ErrUnsupported, fields[0])
+	}
+
+	n := c.Op.operands()
+	if len(fields) < n+2 || fields[n] != "->" {
+		return fmt.Errorf("conformance: %v takes %d operands, then -> and the result", c.Op, n)
+	}
+
+	for _, field := range fields[:n] {
+		in, err := parseFPgenValue(c.Format, field)
+		if err != nil {
+			return err
+		}
+
+		c.Inputs = append(c.Inputs, in)
+	}
+
+	if fields[n+1] == "#" {
+		return fmt.Errorf("%w: no result", ErrUnsupported)
+	}
+
+	result, err := parseFPgenValue(c.ResultFormat, fields[n+1])
+	if err != nil {
+		return err
+	}
+	c.Result = result
+
+	for _, field := range fields[n+2:] {
+		if !isFPgenFlags(field) {
+			return fmt.Errorf("conformance: malformed exceptions %q", field)
+		}
+
+		for _, r := range field {
+			c.Flags |= fpgenFlags[r]
+		}
+	}
+
+	return nil
+}
+
+// parseFPgenOp sets the operation and formats of c from an FPgen operation, such as b32+ or b32b64cff.
+func parseFPgenOp(c *Case, s string) error {
+	f, rest, err := cutFPgenFormat(s)
+	if err != nil {
+		return err
+	}
+	c.Format, c.ResultFormat = f, f
+
+	if strings.HasPrefix(rest, "b") {
+		f, rest, err = cutFPgenFormat(rest)
+		if err != nil {
+			return err
+		}
+		c.ResultFormat = f
+	}
+
+	op, ok := fpgenOps[rest]
+	if !ok {
+		return fmt.Errorf("%w: operation %q", ErrUnsupported, s)
+	}
+	c.Op = op
+
+	if op != OpConvert && c.Format != c.ResultFormat {
+		return fmt.Errorf("%w: operation %q", ErrUnsupported, s)
+	}
+
+	return nil
+}
+
+// cutFPgenFormat cuts a binary format, such as b32, from the start of s.
+func cutFPgenFormat(s string) (Format, string, error) {
+	if !strings.HasPrefix(s, "b") {
+		return 0, "", fmt.Errorf("%w: operation %q", ErrUnsupported, s)
+	}
+
+	i := 1
+	for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+		i++
+	}
+
+	f, ok := fpgenFormats[s[:i]]
+	if !ok {
+		return 0, "", fmt.Errorf("%w: format %q", ErrUnsupported, s[:i])
+	}
+
+	return f, s[i:], nil
+}
+
+func isFPgenFlags(s string) bool {
+	for _, r := range s {
+		if _, ok := fpgenFlags[r]; !ok {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// parseFPgenValue parses an FPgen value of the format f.
+//
+// Finite values are written as a sign, a leading digit of 1 for a normal value, or 0 for a subnormal,
+// the mantissa in hexadecimal, and an unbiased exponent in decimal, as in -1.7FFFFFP127 or +0.000001P-126.
+// Other values are written as a sign and Zero or Inf, or as Q for a quiet NaN, and S for a signaling NaN.
+func parseFPgenValue(f Format, s string) (bits.Uint128, error) {
+	var b bits.Bits128
+
+	neg := strings.HasPrefix(s, "-")
+	body := strings.TrimLeft(s, "+-")
+
+	expMax := 1<<f.expWidth() - 1
+	quiet := b.Pow2(f.mantWidth() - 1)
+
+	var exp int
+	var mant bits.Uint128
+
+	switch body {
+	case "Zero":
+	case "Inf":
+		exp = expMax
+	case "Q":
+		exp, mant = expMax, quiet
+	case "S":
+		exp, mant = expMax, bits.Uint128{Lo: 1}
+
+	default:
+		lead, frac, ok := strings.Cut(body, ".")
+		if !ok || (lead != "0" && lead != "1") {
+			return bits.Uint128{}, fmt.Errorf("conformance: malformed value %q", s)
+		}
+
+		digits, e, ok := strings.Cut(frac, "P")
+		if !ok {
+			return bits.Uint128{}, fmt.Errorf("conformance: malformed value %q", s)
+		}
+
+		m, err := parseHex(digits)
+		if err != nil {
+			return bits.Uint128{}, err
+		}
+
+		unbiased, err := strconv.Atoi(e)
+		if err != nil {
+			return bits.Uint128{}, fmt.Errorf("conformance: malformed value %q", s)
+		}
+
+		exp = unbiased + f.bias()
+		if lead == "0" {
+			if exp != 1 {
+				return bits.Uint128{}, fmt.Errorf("conformance: subnormal value %q has the wrong exponent", s)
+			}
+			exp = 0
+		}
+
+		if (lead == "1" && exp < 1) || exp >= expMax || b.Shr(m, f.mantWidth()) != (bits.Uint128{}) {
+			return bits.Uint128{}, fmt.Errorf("conformance: value %q out of range for %v", s, f)
+		}
+
+		mant = m
+	}
+
+	u := b.Or(mant, b.Shl(bits.Uint128{Lo: uint64(exp)}, f.mantWidth()))
+	if neg {
+		u = b.Or(u, b.Pow2(f.mantWidth()+f.expWidth()))
+	}
+
+	return u, nil
+}
@@ -0,0 +1,170 @@
+package conformance
+
+import (
+	"fmt"
+
+	"github.com/puellanivis/math/bits"
+	"github.com/puellanivis/math/floats"
+)
+
+// float is the set of methods of the floats types that test vectors exercise.
+type float[T any, RND floats.RoundingMode] interface {
+	Add(y T) T
+	Sub(y T) T
+	Mul(y T) T
+	Div(y T) T
+	FMA(y, z T) T
+	Remainder(y T) T
+	Sqrt() T
+
+	Round() T
+	RoundToEven() T
+	Trunc() T
+	Floor() T
+	Ceil() T
+
+	Cmp(y T) (int, bool)
+
+	Float16() floats.Float16WithRound[RND]
+	BFloat16() floats.BFloat16WithRound[RND]
+	Float32() floats.Float32WithRound[RND]
+	Float64() floats.Float64WithRound[RND]
+	Float128() floats.Float128WithRound[RND]
+}
+
+// run returns the encoding of the result of the test vector c.
+func run(c *Case) (bits.Uint128, error) {
+	switch c.Rounding {
+	case TiesToEven:
+		return runFormat[floats.RoundTiesToEven](c)
+	case TiesToAway:
+		return runFormat[floats.RoundTiesToAway](c)
+	case TowardZero:
+		return runFormat[floats.RoundTowardZero](c)
+	case TowardNegative:
+		return runFormat[floats.RoundTowardNegative](c)
+	case TowardPositive:
+		return runFormat[floats.RoundTowardPositive](c)
+	}
+
+	return bits.Uint128{}, fmt.Errorf("%w: rounding %v", ErrUnsupported, c.Rounding)
+}
+
+func runFormat[RND floats.RoundingMode](c *Case) (bits.Uint128, error) {
+	switch c.Format {
+	case Binary16:
+		return eval[floats.Float16WithRound[RND]](c, func(u bits.Uint128) floats.Float16WithRound[RND] {
+			return floats.Float16WithRoundFromBits[RND](uint16(u.Lo))
+		})
+
+	case BFloat16:
+		return eval[floats.BFloat16WithRound[RND]](c, func(u bits.Uint128) floats.BFloat16WithRound[RND] {
+			return floats.BFloat16WithRoundFromBits[RND](uint16(u.Lo))
+		})
+
+	case Binary32:
+		return eval[floats.Float32WithRound[RND]](c, func(u bits.Uint128) floats.Float32WithRound[RND] {
+			return floats.Float32WithRoundFromBits[RND](uint32(u.Lo))
+		})
+
+	case Binary64:
+		return eval[floats.Float64WithRound[RND]](c, func(u bits.Uint128) floats.Float64WithRound[RND] {
+			return floats.Float64WithRoundFromBits[RND](u.Lo)
+		})
+
+	case Binary128:
+		return eval[floats.Float128WithRound[RND]](c, floats.Float128WithRoundFromBits[RND])
+	}
+
+	return bits.Uint128{}, fmt.Errorf("%w: format %v", ErrUnsupported, c.Format)
+}
+
+func eval[T float[T, RND], RND floats.RoundingMode](c *Case, decode func(bits.Uint128) T) (bits.Uint128, error) {
+	x := decode(c.Inputs[0])
+
+	var y, z T
+	if len(c.Inputs) > 1 {
+		y = decode(c.Inputs[1])
+	}
+	if len(c.Inputs) > 2 {
+		z = decode(c.Inputs[2])
+	}
+
+	var result any
+
+	switch c.Op {
+	case OpAdd:
+		result = x.Add(y)
+	case OpSub:
+		result = x.Sub(y)
+	case OpMul:
+		result = x.Mul(y)
+	case OpDiv:
+		result = x.Div(y)
+	case OpFMA:
+		result = x.FMA(y, z)
+	case OpRem:
+		result = x.Remainder(y)
+	case OpSqrt:
+		result = x.Sqrt()
+
+	case OpRoundToInt:
+		switch c.Rounding {
+		case TiesToEven:
+			result = x.RoundToEven()
+		case TiesToAway:
+			result = x.Round()
+		case TowardZero:
+			result = x.Trunc()
+		case TowardNegative:
+			result = x.Floor()
+		case TowardPositive:
+			result = x.Ceil()
+		}
+
+	case OpConvert:
+		switch c.ResultFormat {
+		case Binary16:
+			result = x.Float16()
+		case BFloat16:
+			result = x.BFloat16()
+		case Binary32:
+			result = x.Float32()
+		case Binary64:
+			result = x.Float64()
+		case Binary128:
+			result = x.Float128()
+		}
+
+	case OpEq, OpEqSignaling:
+		order, ordered := x.Cmp(y)
+		result = ordered && order == 0
+	case OpLe, OpLeQuiet:
+		order, ordered := x.Cmp(y)
+		result = ordered && order <= 0
+	case OpLt, OpLtQuiet:
+		order, ordered := x.Cmp(y)
+		result = ordered && order < 0
+	}
+
+	switch result := result.(type) {
+	case bool:
+		if result {
+			return bits.Uint128{Lo: 1}, nil
+		}
+		return bits.Uint128{}, nil
+
+	case floats.Float16WithRound[RND]:
+		return bits.Uint128{Lo: uint64(result.Bits())}, nil
+	case floats.BFloat16WithRound[RND]:
+		return bits.Uint128{Lo: uint64(result.Bits())}, nil
+	case floats.Float32WithRound[RND]:
+		return bits.Uint128{Lo: uint64(result.Bits())}, nil
+	case floats.Float64WithRound[RND]:
+		return bits.Uint128{Lo: result.Bits()}, nil
+	case floats.Float128WithRound[RND]:
+		return result.Bits(), nil
+	}
+
+	return bits.Uint128{}, fmt.Errorf("%w: %v of %v to %v", ErrUnsupported, c.Op, c.Format, c.ResultFormat)
+}
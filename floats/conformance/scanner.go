@@ -0,0 +1,71 @@
+package conformance
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Scanner reads test vectors from a source, one per line.
+//
+// Blank lines, and lines that begin with a comment character, are skipped.
+// A line that cannot be parsed is still returned as a Case, with its Err set,
+// so that a caller may report it, and carry on.
+type Scanner struct {
+	s     *bufio.Scanner
+	parse func(c *Case, fields []string) error
+
+	comment string
+
+	c Case
+}
+
+func newScanner(r io.Reader, comment string, parse func(c *Case, fields []string) error) *Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+
+	return &Scanner{
+		s:       s,
+		parse:   parse,
+		comment: comment,
+	}
+}
+
+// Scan advances the Scanner to the next test vector, which is then available through the Case method.
+// It returns false when there are no more vectors, either by reaching the end of the source, or an error.
+func (s *Scanner) Scan() bool {
+	line := s.c.Line
+
+	for s.s.Scan() {
+		line++
+
+		text := s.s.Text()
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], s.comment) {
+			continue
+		}
+
+		s.c = Case{
+			Line: line,
+			Text: text,
+		}
+
+		s.c.Err = s.parse(&s.c, fields)
+		return true
+	}
+
+	return false
+}
+
+// Case returns the most recent test vector read by a call to Scan.
+//
+// The Case is overwritten by the next call to Scan.
+func (s *Scanner) Case() *Case {
+	return &s.c
+}
+
+// Err returns the first error reading from the source, other than io.EOF.
+func (s *Scanner) Err() error {
+	return s.s.Err()
+}
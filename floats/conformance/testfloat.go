@@ -0,0 +1,161 @@
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/puellanivis/math/bits"
+)
+
+var testFloatFormats = map[string]Format{
+	"f16":  Binary16,
+	"bf16": BFloat16,
+	"f32":  Binary32,
+	"f64":  Binary64,
+	"f128": Binary128,
+}
+
+var testFloatRoundings = map[string]Rounding{
+	"rnear_even":   TiesToEven,
+	"rnear_maxMag": TiesToAway,
+	"rminMag":      TowardZero,
+	"rmin":         TowardNegative,
+	"rmax":         TowardPositive,
+}
+
+// NewTestFloatScanner returns a Scanner that reads test vectors in the format of Berkeley TestFloat from r.
+//
+// Each line is the name of the function, the rounding mode, the operands, the result, and the flags,
+// with the operands, result, and flags in hexadecimal, as in:
+//
+//	f16_add rnear_even 3C00 4000 4200 00
+//
+// The output of testfloat_gen holds only the operands, the result, and the flags,
+// so for it, function and rounding give the name of the function, and the rounding mode,
+// that the vectors were generated with, such as "f16_add" and "rnear_even".
+// Otherwise, both should be empty.
+//
+// The rounding modes are named as in the options of testfloat_gen, with or without the leading "-".
+// Functions are named as in testfloat_gen: add, sub, mul, mulAdd, div, rem, sqrt, roundToInt,
+// eq, le, lt, eq_signaling, le_quiet, lt_quiet, and conversions such as f16_to_f32,
+// of any of the formats f16, f32, f64, f128, and also bf16.
+func NewTestFloatScanner(r io.Reader, function, rounding string) *Scanner {
+	return newScanner(r, "#", func(c *Case, fields []string) error {
+		if function == "" {
+			if len(fields) < 2 {
+				return errors.New("conformance: missing function or rounding mode")
+			}
+
+			return parseTestFloat(c, fields[0], fields[1], fields[2:])
+		}
+
+		if rounding == "" {
+			rounding = "rnear_even"
+		}
+
+		return parseTestFloat(c, function, rounding, fields)
+	})
+}
+
+func parseTestFloat(c *Case, function, rounding string, fields []string) error {
+	if err := parseTestFloatFunction(c, function); err != nil {
+		return err
+	}
+
+	rnd, ok := testFloatRoundings[strings.TrimPrefix(rounding, "-")]
+	if !ok {
+		return fmt.Errorf("%w: rounding mode %q", ErrUnsupported, rounding)
+	}
+	c.Rounding = rnd
+
+	n := c.Op.operands()
+	if len(fields) != n+2 {
+		return fmt.Errorf("conformance: %s takes %d operands, a result, and flags, but found %d fields", function, n, len(fields))
+	}
+
+	for _, field := range fields[:n] {
+		in, err := parseHex(field)
+		if err != nil {
+			return err
+		}
+
+		c.Inputs = append(c.Inputs, in)
+	}
+
+	result, err := parseHex(fields[n])
+	if err != nil {
+		return err
+	}
+	c.Result = result
+
+	flags, err := strconv.ParseUint(fields[n+1], 16, 8)
+	if err != nil {
+		return fmt.Errorf("conformance: flags: %w", err)
+	}
+	c.Flags = Flags(flags)
+
+	return nil
+}
+
+// parseTestFloatFunction sets the operation and formats of c from a TestFloat function name.
+func parseTestFloatFunction(c *Case, function string) error {
+	prefix, name, ok := strings.Cut(function, "_")
+	if !ok {
+		return fmt.Errorf("conformance: malformed function name %q", function)
+	}
+
+	f, ok := testFloatFormats[prefix]
+	if !ok {
+		return fmt.Errorf("%w: function %q", ErrUnsupported, function)
+	}
+	c.Format, c.ResultFormat = f, f
+
+	if to, ok := strings.CutPrefix(name, "to_"); ok {
+		f, ok := testFloatFormats[to]
+		if !ok {
+			return fmt.Errorf("%w: function %q", ErrUnsupported, function)
+		}
+
+		c.Op, c.ResultFormat = OpConvert, f
+		return nil
+	}
+
+	for op, desc := range ops {
+		if desc.name == name && Op(op) != OpConvert {
+			c.Op = Op(op)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: function %q", ErrUnsupported, function)
+}
+
+// parseHex parses a hexadecimal encoding of up to 128 bits.
+func parseHex(s string) (bits.Uint128, error) {
+	if len(s) == 0 || len(s) > 32 {
+		return bits.Uint128{}, fmt.Errorf("conformance: malformed hexadecimal %q", s)
+	}
+
+	var u bits.Uint128
+
+	if len(s) > 16 {
+		hi, err := strconv.ParseUint(s[:len(s)-16], 16, 64)
+		if err != nil {
+			return bits.Uint128{}, fmt.Errorf("conformance: malformed hexadecimal %q", s)
+		}
+
+		u.Hi = hi
+		s = s[len(s)-16:]
+	}
+
+	lo, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return bits.Uint128{}, fmt.Errorf("conformance: malformed hexadecimal %q", s)
+	}
+	u.Lo = lo
+
+	return u, nil
+}
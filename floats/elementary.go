@@ -0,0 +1,480 @@
+package floats
+
+import (
+	"math/big"
+	"sync"
+)
+
+// The elementary functions here are evaluated with big.Float at well over twice the precision of the format,
+// and then rounded only once, according to the rounding mode.
+// This makes them correctly rounded, except for results that are exceedingly close to a rounding boundary.
+
+// workPrec returns the precision used to evaluate elementary functions for the format SPEC.
+func workPrec[SPEC spec[D], D datum]() uint {
+	var spec SPEC
+
+	return uint(2*spec.width() + 64)
+}
+
+// bigConst caches a constant computed to the greatest precision asked for so far.
+type bigConst struct {
+	mu      sync.Mutex
+	val     *big.Float
+	compute func(prec uint) *big.Float
+}
+
+// get returns the constant rounded to prec bits.
+func (c *bigConst) get(prec uint) *big.Float {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.val == nil || c.val.Prec() < prec+64 {
+		c.val = c.compute(prec + 64)
+	}
+
+	return new(big.Float).SetPrec(prec).Set(c.val)
+}
+
+var (
+	bigPi   = &bigConst{compute: computePi}
+	bigLn2  = &bigConst{compute: computeLn2}
+	bigLn10 = &bigConst{compute: computeLn10}
+)
+
+// computePi returns π using Machin’s formula, π = 16 atan(1/5) - 4 atan(1/239).
+func computePi(prec uint) *big.Float {
+	a := atanInv(5, prec)
+	b := atanInv(239, prec)
+
+	a.Mul(a, big.NewFloat(16))
+	b.Mul(b, big.NewFloat(4))
+
+	return a.Sub(a, b)
+}
+
+// atanInv returns atan(1/n), using its Taylor series.
+func atanInv(n int64, prec uint) *big.Float {
+	nn := new(big.Float).SetPrec(prec).SetInt64(n * n)
+
+	term := new(big.Float).SetPrec(prec).SetInt64(n)
+	term.Quo(big.NewFloat(1), term)
+
+	sum := new(big.Float).SetPrec(prec).Set(term)
+	t := new(big.Float).SetPrec(prec)
+
+	for k := int64(1); ; k++ {
+		term.Quo(term, nn)
+
+		t.Quo(term, t.SetInt64(2*k+1))
+		if t.Sign() == 0 || t.MantExp(nil)-sum.MantExp(nil) < -int(prec) {
+			return sum
+		}
+
+		if k%2 == 1 {
+			sum.Sub(sum, t)
+		} else {
+			sum.Add(sum, t)
+		}
+	}
+}
+
+// computeLn2 returns ln(2) = 2 atanh(1/3).
+func computeLn2(prec uint) *big.Float {
+	third := new(big.Float).SetPrec(prec).SetInt64(3)
+	third.Quo(big.NewFloat(1), third)
+
+	r := atanhSeries(third, prec)
+	return r.Mul(r, big.NewFloat(2))
+}
+
+// computeLn10 returns ln(10) = 3 ln(2) + ln(5/4) = 3 ln(2) + 2 atanh(1/9).
+func computeLn10(prec uint) *big.Float {
+	ninth := new(big.Float).SetPrec(prec).SetInt64(9)
+	ninth.Quo(big.NewFloat(1), ninth)
+
+	r := atanhSeries(ninth, prec)
+	r.Mul(r, big.NewFloat(2))
+
+	ln2 := computeLn2(prec)
+	ln2.Mul(ln2, big.NewFloat(3))
+
+	return r.Add(r, ln2)
+}
+
+// atanhSeries returns atanh(t), using its Taylor series, which converges quickly only for small |t|.
+func atanhSeries(t *big.Float, prec uint) *big.Float {
+	t2 := new(big.Float).SetPrec(prec).Mul(t, t)
+
+	pow := new(big.Float).SetPrec(prec).Set(t)
+	sum := new(big.Float).SetPrec(prec).Set(t)
+	term := new(big.Float).SetPrec(prec)
+
+	for k := int64(1); ; k++ {
+		pow.Mul(pow, t2)
+
+		term.Quo(pow, term.SetInt64(2*k+1))
+		if term.Sign() == 0 || term.MantExp(nil)-sum.MantExp(nil) < -int(prec) {
+			return sum
+		}
+
+		sum.Add(sum, term)
+	}
+}
+
+// bigLog returns the natural logarithm of the positive finite x.
+func bigLog(x *big.Float, prec uint) *big.Float {
+	// x = m × 2**e, with m in [½, 1), then moved into [√½, √2).
+	m := new(big.Float).SetPrec(prec)
+	e := x.MantExp(m)
+
+	if m.Cmp(big.NewFloat(0.7071067811865476)) < 0 {
+		m.SetMantExp(m, 1)
+		e--
+	}
+
+	// log(m) = 2 atanh((m-1)/(m+1)), where |(m-1)/(m+1)| < 0.172.
+	num := new(big.Float).SetPrec(prec).Sub(m, big.NewFloat(1))
+	den := new(big.Float).SetPrec(prec).Add(m, big.NewFloat(1))
+	r := atanhSeries(num.Quo(num, den), prec)
+	r.Mul(r, big.NewFloat(2))
+
+	ln2 := bigLn2.get(prec)
+	ln2.Mul(ln2, new(big.Float).SetInt64(int64(e)))
+
+	return r.Add(r, ln2)
+}
+
+// bigExp returns e**t, for a t small enough in magnitude that the result is within the exponent range of big.Float.
+func bigExp(t *big.Float, prec uint) *big.Float {
+	// Squaring the result of the halved argument loses a bit of precision each time.
+	const halvings = 16
+
+	wp := prec + 2*halvings
+
+	// Reduce t by multiples of ln 2, so that t = r + k×ln 2, with |r| ≤ ½ ln 2.
+	rprec := wp
+	if e := t.MantExp(nil); e > 0 {
+		rprec += uint(e)
+	}
+
+	ln2 := bigLn2.get(rprec)
+
+	kf := new(big.Float).SetPrec(rprec).Quo(t, ln2)
+	k, _ := roundBig(kf)
+
+	r := new(big.Float).SetPrec(rprec).SetInt(k)
+	r.Mul(r, ln2)
+	r.Sub(t, r)
+	r.SetPrec(wp)
+
+	r.SetMantExp(r, -halvings)
+
+	// e**r = 1 + r + r²/2! + r³/3! + …
+	sum := new(big.Float).SetPrec(wp).SetInt64(1)
+	term := new(big.Float).SetPrec(wp).SetInt64(1)
+	n := new(big.Float).SetPrec(wp)
+
+	for i := int64(1); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, n.SetInt64(i))
+
+		if term.Sign() == 0 || term.MantExp(nil) < -int(wp)-2 {
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	for i := 0; i < halvings; i++ {
+		sum.Mul(sum, sum)
+	}
+
+	return sum.SetMantExp(sum, int(k.Int64()))
+}
+
+// bigLog1p returns the natural logarithm of 1+x, for x > -1, accurately even when x is tiny.
+func bigLog1p(x *big.Float, prec uint) *big.Float {
+	if x.MantExp(nil) > -4 {
+		u := new(big.Float).SetPrec(prec).Add(x, big.NewFloat(1))
+		return bigLog(u, prec)
+	}
+
+	// log(1+x) = 2 atanh(x/(2+x)), where the quotient is computed to full relative precision.
+	d := new(big.Float).SetPrec(prec).Add(x, big.NewFloat(2))
+	q := new(big.Float).SetPrec(prec).Quo(x, d)
+
+	r := atanhSeries(q, prec)
+	return r.SetMantExp(r, 1)
+}
+
+// bigSinCos returns the sine and cosine of the finite x.
+func bigSinCos(x *big.Float, prec uint) (sin, cos *big.Float) {
+	// Reduce x by multiples of π/2, with π precise enough to give prec bits past the binary point,
+	// so that x = r + k×π/2, with |r| ≤ π/4.
+	rprec := prec
+	if e := x.MantExp(nil); e > 0 {
+		rprec += uint(e)
+	}
+
+	halfPi := bigPi.get(rprec)
+	halfPi.SetMantExp(halfPi, -1)
+
+	kf := new(big.Float).SetPrec(rprec).Quo(x, halfPi)
+	k, _ := roundBig(kf)
+
+	r := new(big.Float).SetPrec(rprec).SetInt(k)
+	r.Mul(r, halfPi)
+	r.Sub(x, r)
+	r.SetPrec(prec)
+
+	sin, cos = sinCosSeries(r, prec)
+
+	switch new(big.Int).And(k, big.NewInt(3)).Int64() {
+	case 1:
+		sin, cos = cos, sin.Neg(sin)
+	case 2:
+		sin, cos = sin.Neg(sin), cos.Neg(cos)
+	case 3:
+		sin, cos = cos.Neg(cos), sin
+	}
+
+	return sin, cos
+}
+
+// roundBig returns x rounded to the nearest integer, with ties away from zero.
+func roundBig(x *big.Float) (*big.Int, big.Accuracy) {
+	h := new(big.Float).SetPrec(x.Prec() + 1).SetFloat64(0.5)
+	if x.Sign() < 0 {
+		h.Neg(h)
+	}
+
+	h.Add(h, x)
+
+	return h.Int(nil)
+}
+
+// sinCosSeries returns the sine and cosine of the small r, using their Taylor series.
+func sinCosSeries(r *big.Float, prec uint) (sin, cos *big.Float) {
+	r2 := new(big.Float).SetPrec(prec).Mul(r, r)
+
+	sin = new(big.Float).SetPrec(prec).Set(r)
+	cos = new(big.Float).SetPrec(prec).SetInt64(1)
+
+	term := new(big.Float).SetPrec(prec).SetInt64(1)
+	t := new(big.Float).SetPrec(prec)
+
+	for n := int64(1); ; n++ {
+		// term = (-1)**n r**(2n) / (2n)!, which is added to cos, and then r×term/(2n+1) to sin.
+		term.Mul(term, r2)
+		term.Quo(term, t.SetInt64((2*n-1)*(2*n)))
+		term.Neg(term)
+
+		if term.Sign() == 0 || term.MantExp(nil) < -int(prec)-2 {
+			return sin, cos
+		}
+
+		cos.Add(cos, term)
+
+		t.Mul(term, r)
+		t.Quo(t, new(big.Float).SetInt64(2*n+1))
+		sin.Add(sin, t)
+	}
+}
+
+// bigAtan returns the arctangent of the finite x.
+func bigAtan(x *big.Float, prec uint) *big.Float {
+	if x.Sign() == 0 {
+		return new(big.Float).SetPrec(prec)
+	}
+
+	neg := x.Sign() < 0
+	t := new(big.Float).SetPrec(prec).Abs(x)
+
+	// For |x| > 1, atan(x) = π/2 - atan(1/x).
+	invert := t.Cmp(big.NewFloat(1)) > 0
+	if invert {
+		t.Quo(big.NewFloat(1), t)
+	}
+
+	// Halve the argument a few times, with atan(t) = 2 atan(t / (1 + √(1+t²))),
+	// so that the series converges quickly.
+	const halvings = 8
+
+	s := new(big.Float).SetPrec(prec)
+	for i := 0; i < halvings; i++ {
+		s.Mul(t, t)
+		s.Add(s, big.NewFloat(1))
+		s.Sqrt(s)
+		s.Add(s, big.NewFloat(1))
+		t.Quo(t, s)
+	}
+
+	// atan(t) = t - t³/3 + t⁵/5 - …
+	t2 := new(big.Float).SetPrec(prec).Mul(t, t)
+
+	pow := new(big.Float).SetPrec(prec).Set(t)
+	sum := new(big.Float).SetPrec(prec).Set(t)
+	term := new(big.Float).SetPrec(prec)
+
+	for k := int64(1); ; k++ {
+		pow.Mul(pow, t2)
+
+		term.Quo(pow, term.SetInt64(2*k+1))
+		if term.Sign() == 0 || term.MantExp(nil)-sum.MantExp(nil) < -int(prec) {
+			break
+		}
+
+		if k%2 == 1 {
+			sum.Sub(sum, term)
+		} else {
+			sum.Add(sum, term)
+		}
+	}
+
+	sum.SetMantExp(sum, halvings)
+
+	if invert {
+		halfPi := bigPi.get(prec)
+		halfPi.SetMantExp(halfPi, -1)
+		sum.Sub(halfPi, sum)
+	}
+
+	if neg {
+		sum.Neg(sum)
+	}
+
+	return sum
+}
+
+// piMul returns π×n/d, rounded to the format SPEC.
+func piMul[SPEC spec[D], D datum](n, d int64, sign bool, rounding RoundingMode) D {
+	prec := workPrec[SPEC]()
+
+	v := bigPi.get(prec)
+	v.Mul(v, new(big.Float).SetInt64(n))
+	v.Quo(v, new(big.Float).SetInt64(d))
+
+	if sign {
+		v.Neg(v)
+	}
+
+	return fromBigFloat[SPEC](v, rounding)
+}
+
+// logHypot returns the natural logarithm of √(x² + y²), without intermediate rounding.
+func logHypot[SPEC spec[D], D datum](x, y D, rounding RoundingMode) D {
+	_, xm := mag[SPEC](x)
+	_, ym := mag[SPEC](y)
+
+	var spec SPEC
+
+	magInf := magInf[SPEC]()
+
+	switch {
+	case spec.Eq(xm, magInf) || spec.Eq(ym, magInf):
+		return magInf
+	case spec.Gt(xm, magInf):
+		return x
+	case spec.Gt(ym, magInf):
+		return y
+	case spec.IsZero(xm) && spec.IsZero(ym):
+		// EXCEPTION: divide by zero
+		return inf[SPEC](true)
+	}
+
+	prec := workPrec[SPEC]()
+
+	bx := new(big.Float).SetPrec(prec).Set(toBigFloat[SPEC](x))
+	by := new(big.Float).SetPrec(prec).Set(toBigFloat[SPEC](y))
+
+	bx.Mul(bx, bx)
+	by.Mul(by, by)
+	bx.Add(bx, by)
+
+	r := bigLog(bx, prec)
+	r.SetMantExp(r, -1)
+
+	return fromBigFloat[SPEC](r, rounding)
+}
+
+// sinCos returns the sine and cosine of x.
+func sinCos[SPEC spec[D], D datum](x D, rounding RoundingMode) (sin, cos D) {
+	_, m := mag[SPEC](x)
+
+	var spec SPEC
+
+	switch {
+	case spec.Gte(m, magInf[SPEC]()):
+		// EXCEPTION: invalid operation, unless x is already NaN.
+		return nan[SPEC](), nan[SPEC]()
+	case spec.IsZero(m):
+		return x, one[SPEC]()
+	}
+
+	prec := workPrec[SPEC]()
+
+	bs, bc := bigSinCos(toBigFloat[SPEC](x), prec)
+
+	return fromBigFloat[SPEC](bs, rounding), fromBigFloat[SPEC](bc, rounding)
+}
+
+// atan2 returns the arctangent of y/x, using the signs of both to determine the quadrant of the result.
+func atan2[SPEC spec[D], D datum](y, x D, rounding RoundingMode) D {
+	ys, ym := mag[SPEC](y)
+	xs, xm := mag[SPEC](x)
+
+	var spec SPEC
+
+	magInf := magInf[SPEC]()
+
+	yNeg := !spec.IsZero(ys)
+	xNeg := !spec.IsZero(xs)
+
+	switch {
+	case spec.Gt(ym, magInf):
+		return y
+	case spec.Gt(xm, magInf):
+		return x
+
+	case spec.IsZero(ym):
+		if xNeg {
+			return piMul[SPEC](1, 1, yNeg, rounding)
+		}
+		return y
+
+	case spec.Eq(ym, magInf):
+		switch {
+		case !spec.Eq(xm, magInf):
+			return piMul[SPEC](1, 2, yNeg, rounding)
+		case xNeg:
+			return piMul[SPEC](3, 4, yNeg, rounding)
+		}
+		return piMul[SPEC](1, 4, yNeg, rounding)
+
+	case spec.IsZero(xm):
+		return piMul[SPEC](1, 2, yNeg, rounding)
+
+	case spec.Eq(xm, magInf):
+		if xNeg {
+			return piMul[SPEC](1, 1, yNeg, rounding)
+		}
+		return copySign[SPEC](spec.Xor(y, y), y)
+	}
+
+	prec := workPrec[SPEC]()
+
+	q := new(big.Float).SetPrec(prec).Quo(toBigFloat[SPEC](y), toBigFloat[SPEC](x))
+	r := bigAtan(q, prec)
+
+	if xNeg {
+		// Move the result into the left half-plane.
+		pi := bigPi.get(prec)
+		if yNeg {
+			r.Sub(r, pi)
+		} else {
+			r.Add(r, pi)
+		}
+	}
+
+	return fromBigFloat[SPEC](r, rounding)
+}
@@ -0,0 +1,265 @@
+package floats
+
+import (
+	stdbinary "encoding/binary"
+	"io"
+
+	"github.com/puellanivis/math/bits"
+)
+
+// encodingBufSize is the size of the buffer used to read or write slices in bulk.
+const encodingBufSize = 4096
+
+// byteOrderLittle reports whether the given byte order puts the least-significant byte first.
+func byteOrderLittle(order stdbinary.ByteOrder) bool {
+	var b [2]byte
+	order.PutUint16(b[:], 1)
+	return b[0] == 1
+}
+
+// Reader reads floating-point numbers from an io.Reader in a specified byte order.
+//
+// A Reader never reads more bytes than are needed for the values requested,
+// so the underlying io.Reader may be read from directly between calls.
+//
+// A Float128 is read as a single 128-bit integer in the byte order:
+// in big-endian order, the 8 bytes of Hi are followed by the 8 bytes of Lo;
+// in little-endian order, the 8 bytes of Lo are followed by the 8 bytes of Hi.
+// This is the same layout as a native 128-bit floating-point number in memory.
+type Reader struct {
+	r      io.Reader
+	order  stdbinary.ByteOrder
+	little bool
+
+	buf [encodingBufSize]byte
+}
+
+// NewReader returns a new Reader that reads from r in the specified byte order.
+func NewReader(r io.Reader, order stdbinary.ByteOrder) *Reader {
+	return &Reader{
+		r:      r,
+		order:  order,
+		little: byteOrderLittle(order),
+	}
+}
+
+// read reads n values of size bytes each, passing each value’s bytes to put.
+// It returns io.EOF only if no bytes were read.
+func (r *Reader) read(size, n int, put func(i int, b []byte)) error {
+	per := len(r.buf) / size
+
+	for i := 0; i < n; i += per {
+		k := min(n-i, per)
+		b := r.buf[:k*size]
+
+		if _, err := io.ReadFull(r.r, b); err != nil {
+			if err == io.EOF && i > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+
+		for j := 0; j < k; j++ {
+			put(i+j, b[j*size:])
+		}
+	}
+
+	return nil
+}
+
+func (r *Reader) uint128(b []byte) bits.Uint128 {
+	if r.little {
+		return bits.Uint128{Hi: r.order.Uint64(b[8:]), Lo: r.order.Uint64(b)}
+	}
+
+	return bits.Uint128{Hi: r.order.Uint64(b), Lo: r.order.Uint64(b[8:])}
+}
+
+// Writer writes floating-point numbers to an io.Writer in a specified byte order.
+//
+// A Writer does not hold onto any bytes between calls,
+// so there is nothing to flush, and the underlying io.Writer may be written to directly between calls.
+//
+// A Float128 is written with the same layout as described for the Reader.
+type Writer struct {
+	w      io.Writer
+	order  stdbinary.ByteOrder
+	little bool
+
+	buf [encodingBufSize]byte
+}
+
+// NewWriter returns a new Writer that writes to w in the specified byte order.
+func NewWriter(w io.Writer, order stdbinary.ByteOrder) *Writer {
+	return &Writer{
+		w:      w,
+		order:  order,
+		little: byteOrderLittle(order),
+	}
+}
+
+// write writes n values of size bytes each, having get fill in each value’s bytes.
+func (w *Writer) write(size, n int, get func(i int, b []byte)) error {
+	per := len(w.buf) / size
+
+	for i := 0; i < n; i += per {
+		k := min(n-i, per)
+		b := w.buf[:k*size]
+
+		for j := 0; j < k; j++ {
+			get(i+j, b[j*size:])
+		}
+
+		if _, err := w.w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *Writer) putUint128(b []byte, u bits.Uint128) {
+	if w.little {
+		w.order.PutUint64(b, u.Lo)
+		w.order.PutUint64(b[8:], u.Hi)
+		return
+	}
+
+	w.order.PutUint64(b, u.Hi)
+	w.order.PutUint64(b[8:], u.Lo)
+}
+
+// ReadFloat16 reads an IEEE 754 16-bit floating-point number from r.
+func ReadFloat16[RND RoundingMode](r *Reader) (Float16WithRound[RND], error) {
+	var x [1]Float16WithRound[RND]
+	err := ReadFloat16s(r, x[:])
+	return x[0], err
+}
+
+// ReadFloat16s reads len(dst) IEEE 754 16-bit floating-point numbers from r into dst.
+// If an error is returned, then dst may have been partially filled.
+func ReadFloat16s[RND RoundingMode](r *Reader, dst []Float16WithRound[RND]) error {
+	return r.read(2, len(dst), func(i int, b []byte) {
+		dst[i] = Float16WithRound[RND]{r.order.Uint16(b)}
+	})
+}
+
+// WriteFloat16 writes an IEEE 754 16-bit floating-point number to w.
+func WriteFloat16[RND RoundingMode](w *Writer, x Float16WithRound[RND]) error {
+	return WriteFloat16s(w, []Float16WithRound[RND]{x})
+}
+
+// WriteFloat16s writes the IEEE 754 16-bit floating-point numbers in src to w.
+func WriteFloat16s[RND RoundingMode](w *Writer, src []Float16WithRound[RND]) error {
+	return w.write(2, len(src), func(i int, b []byte) {
+		w.order.PutUint16(b, src[i].bits)
+	})
+}
+
+// ReadBFloat16 reads a Google Brain floating-point number from r.
+func ReadBFloat16[RND RoundingMode](r *Reader) (BFloat16WithRound[RND], error) {
+	var x [1]BFloat16WithRound[RND]
+	err := ReadBFloat16s(r, x[:])
+	return x[0], err
+}
+
+// ReadBFloat16s reads len(dst) Google Brain floating-point numbers from r into dst.
+// If an error is returned, then dst may have been partially filled.
+func ReadBFloat16s[RND RoundingMode](r *Reader, dst []BFloat16WithRound[RND]) error {
+	return r.read(2, len(dst), func(i int, b []byte) {
+		dst[i] = BFloat16WithRound[RND]{r.order.Uint16(b)}
+	})
+}
+
+// WriteBFloat16 writes a Google Brain floating-point number to w.
+func WriteBFloat16[RND RoundingMode](w *Writer, x BFloat16WithRound[RND]) error {
+	return WriteBFloat16s(w, []BFloat16WithRound[RND]{x})
+}
+
+// WriteBFloat16s writes the Google Brain floating-point numbers in src to w.
+func WriteBFloat16s[RND RoundingMode](w *Writer, src []BFloat16WithRound[RND]) error {
+	return w.write(2, len(src), func(i int, b []byte) {
+		w.order.PutUint16(b, src[i].bits)
+	})
+}
+
+// ReadFloat32 reads an IEEE 754 32-bit floating-point number from r.
+func ReadFloat32[RND RoundingMode](r *Reader) (Float32WithRound[RND], error) {
+	var x [1]Float32WithRound[RND]
+	err := ReadFloat32s(r, x[:])
+	return x[0], err
+}
+
+// ReadFloat32s reads len(dst) IEEE 754 32-bit floating-point numbers from r into dst.
+// If an error is returned, then dst may have been partially filled.
+func ReadFloat32s[RND RoundingMode](r *Reader, dst []Float32WithRound[RND]) error {
+	return r.read(4, len(dst), func(i int, b []byte) {
+		dst[i] = Float32WithRound[RND]{r.order.Uint32(b)}
+	})
+}
+
+// WriteFloat32 writes an IEEE 754 32-bit floating-point number to w.
+func WriteFloat32[RND RoundingMode](w *Writer, x Float32WithRound[RND]) error {
+	return WriteFloat32s(w, []Float32WithRound[RND]{x})
+}
+
+// WriteFloat32s writes the IEEE 754 32-bit floating-point numbers in src to w.
+func WriteFloat32s[RND RoundingMode](w *Writer, src []Float32WithRound[RND]) error {
+	return w.write(4, len(src), func(i int, b []byte) {
+		w.order.PutUint32(b, src[i].bits)
+	})
+}
+
+// ReadFloat64 reads an IEEE 754 64-bit floating-point number from r.
+func ReadFloat64[RND RoundingMode](r *Reader) (Float64WithRound[RND], error) {
+	var x [1]Float64WithRound[RND]
+	err := ReadFloat64s(r, x[:])
+	return x[0], err
+}
+
+// ReadFloat64s reads len(dst) IEEE 754 64-bit floating-point numbers from r into dst.
+// If an error is returned, then dst may have been partially filled.
+func ReadFloat64s[RND RoundingMode](r *Reader, dst []Float64WithRound[RND]) error {
+	return r.read(8, len(dst), func(i int, b []byte) {
+		dst[i] = Float64WithRound[RND]{r.order.Uint64(b)}
+	})
+}
+
+// WriteFloat64 writes an IEEE 754 64-bit floating-point number to w.
+func WriteFloat64[RND RoundingMode](w *Writer, x Float64WithRound[RND]) error {
+	return WriteFloat64s(w, []Float64WithRound[RND]{x})
+}
+
+// WriteFloat64s writes the IEEE 754 64-bit floating-point numbers in src to w.
+func WriteFloat64s[RND RoundingMode](w *Writer, src []Float64WithRound[RND]) error {
+	return w.write(8, len(src), func(i int, b []byte) {
+		w.order.PutUint64(b, src[i].bits)
+	})
+}
+
+// ReadFloat128 reads an IEEE 754 128-bit floating-point number from r.
+func ReadFloat128[RND RoundingMode](r *Reader) (Float128WithRound[RND], error) {
+	var x [1]Float128WithRound[RND]
+	err := ReadFloat128s(r, x[:])
+	return x[0], err
+}
+
+// ReadFloat128s reads len(dst) IEEE 754 128-bit floating-point numbers from r into dst.
+// If an error is returned, then dst may have been partially filled.
+func ReadFloat128s[RND RoundingMode](r *Reader, dst []Float128WithRound[RND]) error {
+	return r.read(16, len(dst), func(i int, b []byte) {
+		dst[i] = Float128WithRound[RND]{r.uint128(b)}
+	})
+}
+
+// WriteFloat128 writes an IEEE 754 128-bit floating-point number to w.
+func WriteFloat128[RND RoundingMode](w *Writer, x Float128WithRound[RND]) error {
+	return WriteFloat128s(w, []Float128WithRound[RND]{x})
+}
+
+// WriteFloat128s writes the IEEE 754 128-bit floating-point numbers in src to w.
+func WriteFloat128s[RND RoundingMode](w *Writer, src []Float128WithRound[RND]) error {
+	return w.write(16, len(src), func(i int, b []byte) {
+		w.putUint128(b, src[i].bits)
+	})
+}
@@ -0,0 +1,126 @@
+package floats
+
+import (
+	"bytes"
+	stdbinary "encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/puellanivis/math/bits"
+)
+
+func TestEncodingLayout(t *testing.T) {
+	type test struct {
+		name  string
+		order stdbinary.ByteOrder
+		write func(w *Writer) error
+
+		expect []byte
+	}
+
+	u128 := bits.Uint128{Hi: 0x0001020304050607, Lo: 0x08090a0b0c0d0e0f}
+
+	tests := []test{
+		{"float16 little", stdbinary.LittleEndian, func(w *Writer) error { return WriteFloat16(w, Float16FromBits(0x3c01)) }, []byte{0x01, 0x3c}},
+		{"float16 big", stdbinary.BigEndian, func(w *Writer) error { return WriteFloat16(w, Float16FromBits(0x3c01)) }, []byte{0x3c, 0x01}},
+		{"bfloat16 little", stdbinary.LittleEndian, func(w *Writer) error { return WriteBFloat16(w, BFloat16FromBits(0x3f81)) }, []byte{0x81, 0x3f}},
+		{"float32 big", stdbinary.BigEndian, func(w *Writer) error { return WriteFloat32(w, Float32FromBits(0x3f800001)) }, []byte{0x3f, 0x80, 0x00, 0x01}},
+		{"float64 little", stdbinary.LittleEndian, func(w *Writer) error { return WriteFloat64(w, Float64FromBits(0x3ff0000000000001)) }, []byte{0x01, 0, 0, 0, 0, 0, 0xf0, 0x3f}},
+		{
+			"float128 little", stdbinary.LittleEndian,
+			func(w *Writer) error { return WriteFloat128(w, Float128WithRoundFromBits[RoundTiesToEven](u128)) },
+			[]byte{0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00},
+		},
+		{
+			"float128 big", stdbinary.BigEndian,
+			func(w *Writer) error { return WriteFloat128(w, Float128WithRoundFromBits[RoundTiesToEven](u128)) },
+			[]byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			if err := tt.write(NewWriter(&buf, tt.order)); err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			if got := buf.Bytes(); !bytes.Equal(got, tt.expect) {
+				t.Errorf("wrote % x, but expected % x", got, tt.expect)
+			}
+		})
+	}
+}
+
+func TestEncodingRoundTrip(t *testing.T) {
+	// Enough values to need more than one pass through the buffers.
+	const n = 3*encodingBufSize/16 + 5
+
+	f16s := make([]Float16, n)
+	f128s := make([]Float128, n)
+	for i := range f16s {
+		f16s[i] = Float16FromBits(uint16(i * 7))
+		f128s[i] = Float128WithRoundFromBits[RoundTiesToEven](bits.Uint128{Hi: uint64(i) << 48, Lo: uint64(i)})
+	}
+
+	for _, order := range []stdbinary.ByteOrder{stdbinary.LittleEndian, stdbinary.BigEndian} {
+		var buf bytes.Buffer
+
+		w := NewWriter(&buf, order)
+		if err := WriteFloat16s(w, f16s); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+		if err := WriteFloat128s(w, f128s); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if buf.Len() != n*(2+16) {
+			t.Fatalf("%v: wrote %d bytes, but expected %d", order, buf.Len(), n*(2+16))
+		}
+
+		r := NewReader(&buf, order)
+
+		g16s := make([]Float16, n)
+		if err := ReadFloat16s(r, g16s); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		g128s := make([]Float128, n)
+		if err := ReadFloat128s(r, g128s); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		for i := range f16s {
+			if g16s[i] != f16s[i] {
+				t.Errorf("%v: Float16[%d] = %04x, but expected %04x", order, i, g16s[i].Bits(), f16s[i].Bits())
+			}
+
+			if g128s[i] != f128s[i] {
+				t.Errorf("%v: Float128[%d] = %v, but expected %v", order, i, g128s[i].Bits(), f128s[i].Bits())
+			}
+		}
+
+		if _, err := ReadFloat32[RoundTiesToEven](r); err != io.EOF {
+			t.Errorf("%v: reading past the end gave %v, but expected io.EOF", order, err)
+		}
+	}
+}
+
+func TestEncodingShortRead(t *testing.T) {
+	src := make([]byte, encodingBufSize+3)
+
+	dst := make([]Float64, encodingBufSize/8+1)
+	if err := ReadFloat64s(NewReader(bytes.NewReader(src), stdbinary.LittleEndian), dst); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadFloat64s() = %v, but expected io.ErrUnexpectedEOF", err)
+	}
+
+	// The first full buffer is read successfully, and then the next value is missing entirely.
+	dst = make([]Float64, encodingBufSize/8+1)
+	src = src[:encodingBufSize]
+	if err := ReadFloat64s(NewReader(bytes.NewReader(src), stdbinary.LittleEndian), dst); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadFloat64s() = %v, but expected io.ErrUnexpectedEOF", err)
+	}
+}
@@ -0,0 +1,300 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+)
+
+// bigExpM1 returns e**t - 1, to full relative precision even when t is near zero.
+func bigExpM1(t *big.Float, prec uint) *big.Float {
+	if t.MantExp(nil) > -1 {
+		// |t| ≥ ½, so subtracting 1 cancels at most two bits.
+		v := bigExp(t, prec)
+		return v.Sub(v, big.NewFloat(1))
+	}
+
+	// e**t - 1 = t + t²/2! + t³/3! + …
+	sum := new(big.Float).SetPrec(prec).Set(t)
+	term := new(big.Float).SetPrec(prec).Set(t)
+	n := new(big.Float).SetPrec(prec)
+
+	for i := int64(2); ; i++ {
+		term.Mul(term, t)
+		term.Quo(term, n.SetInt64(i))
+
+		if term.Sign() == 0 || term.MantExp(nil)-sum.MantExp(nil) < -int(prec) {
+			return sum
+		}
+
+		sum.Add(sum, term)
+	}
+}
+
+// expM1Result returns e**t - 1, rounded to the format SPEC.
+func expM1Result[SPEC spec[D], D datum](t *big.Float, prec uint, rounding RoundingMode) D {
+	var spec SPEC
+
+	if t.Sign() > 0 {
+		if r, ok := expRange[SPEC](false, t, rounding); ok {
+			return r
+		}
+	}
+
+	// Once e**t is less than a quarter of the distance from -1 to the next number,
+	// it only decides which way -1 + e**t rounds.
+	limit := float64(spec.mantWidth()+3) * math.Ln2
+
+	if tf, _ := t.Float64(); tf < -limit {
+		tiny := new(big.Float).SetMantExp(big.NewFloat(1), -(spec.mantWidth() + 4))
+		v := new(big.Float).SetPrec(uint(spec.width())).Sub(tiny, big.NewFloat(1))
+
+		return fromBigFloat[SPEC](v, rounding)
+	}
+
+	return fromBigFloat[SPEC](bigExpM1(t, prec), rounding)
+}
+
+// expM1Special returns the result of e**x - 1, 2**x - 1, or 10**x - 1 for special values of x, and true.
+// Otherwise, it returns false.
+func expM1Special[SPEC spec[D], D datum](x, overflowVal D, rounding RoundingMode) (D, bool) {
+	s, m := mag[SPEC](x)
+
+	var spec SPEC
+
+	magInf := magInf[SPEC]()
+
+	switch {
+	case spec.Gt(m, magInf):
+		return x, true
+
+	case spec.Eq(m, magInf):
+		if !spec.IsZero(s) {
+			// e**-∞ - 1 = -1
+			return neg[SPEC](one[SPEC]()), true
+		}
+		return x, true
+
+	case spec.IsZero(m):
+		return x, true
+
+	case spec.IsZero(s) && spec.Gte(m, overflowVal):
+		// EXCEPTION: overflow
+		return overflow[SPEC](false, rounding), true
+	}
+
+	return x, false
+}
+
+func expM1[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	var spec SPEC
+
+	overflowVal, _, _ := spec.expOverUnder()
+
+	if r, ok := expM1Special[SPEC](x, overflowVal, rounding); ok {
+		return r
+	}
+
+	// e**x - 1 is never exact for any non-zero x.
+	return expM1Result[SPEC](toBigFloat[SPEC](x), workPrec[SPEC](), rounding)
+}
+
+func exp2M1[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	var spec SPEC
+
+	overflowVal, _ := spec.exp2OverUnder()
+
+	if r, ok := expM1Special[SPEC](x, overflowVal, rounding); ok {
+		return r
+	}
+
+	bx := toBigFloat[SPEC](x)
+
+	if isInteger[SPEC](x) {
+		// 2**n - 1 is exact, given enough bits.
+		if n, _ := bx.Int64(); n > -exactPowLimit {
+			abs := n
+			if n < 0 {
+				abs = -n
+			}
+
+			p := new(big.Float).SetMantExp(big.NewFloat(1), int(n))
+			v := new(big.Float).SetPrec(uint(abs)+2).Sub(p, big.NewFloat(1))
+
+			return fromBigFloat[SPEC](v, rounding)
+		}
+	}
+
+	prec := workPrec[SPEC]()
+
+	t := new(big.Float).SetPrec(prec).Mul(bx, bigLn2.get(prec))
+
+	return expM1Result[SPEC](t, prec, rounding)
+}
+
+func exp10[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	s, m := mag[SPEC](x)
+
+	var spec SPEC
+	var z D
+
+	overflowVal, underflowVal := spec.exp10OverUnder()
+
+	switch {
+	case spec.Gte(m, magInf[SPEC]()):
+		if spec.Eq(x, inf[SPEC](true)) {
+			// 10**-∞ == 0
+			return z
+		}
+
+		// 10**∞ = ∞, NaN → NaN
+		return x
+
+	case spec.IsZero(m):
+		return one[SPEC]()
+
+	case spec.IsZero(s):
+		if spec.Gte(m, overflowVal) {
+			// EXCEPTION: overflow
+			return overflow[SPEC](false, rounding)
+		}
+
+	default:
+		if spec.Gte(m, underflowVal) {
+			// EXCEPTION: underflow
+			return underflow[SPEC](false, rounding)
+		}
+	}
+
+	bx := toBigFloat[SPEC](x)
+
+	if isInteger[SPEC](x) {
+		// 10**n is exact for non-negative n, given enough bits.
+		n, _ := bx.Int64()
+		return powInt[SPEC](false, big.NewFloat(10), n, rounding)
+	}
+
+	prec := workPrec[SPEC]()
+
+	t := new(big.Float).SetPrec(prec).Mul(bx, bigLn10.get(prec))
+
+	return expResult[SPEC](false, t, prec, rounding)
+}
+
+func exp10M1[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	var spec SPEC
+
+	overflowVal, _ := spec.exp10OverUnder()
+
+	if r, ok := expM1Special[SPEC](x, overflowVal, rounding); ok {
+		return r
+	}
+
+	bx := toBigFloat[SPEC](x)
+
+	if isInteger[SPEC](x) && bx.Sign() > 0 {
+		// 10**n - 1 is exact, given enough bits.
+		n, _ := bx.Uint64()
+
+		if v, ok := bigPowInt(big.NewFloat(10), n); ok {
+			// 10**n - 1 needs n × lg(10) bits, while 10**n only needed n × (lg(10) - 1).
+			v.SetPrec(v.Prec() + uint(n) + 1)
+			v.Sub(v, big.NewFloat(1))
+
+			return fromBigFloat[SPEC](v, rounding)
+		}
+	}
+
+	prec := workPrec[SPEC]()
+
+	t := new(big.Float).SetPrec(prec).Mul(bx, bigLn10.get(prec))
+
+	return expM1Result[SPEC](t, prec, rounding)
+}
+
+// logP1Special returns the result of log(1+x), in any base, for special values of x, and true.
+// Otherwise, it returns false.
+func logP1Special[SPEC spec[D], D datum](x D) (D, bool) {
+	s, m := mag[SPEC](x)
+
+	var spec SPEC
+
+	switch {
+	case spec.Gt(m, magInf[SPEC]()):
+		return x, true
+
+	case spec.IsZero(m):
+		return x, true
+
+	case !spec.IsZero(s):
+		switch spec.Cmp(m, one[SPEC]()) {
+		case 0:
+			// EXCEPTION: divide by zero
+			return inf[SPEC](true), true
+		case 1:
+			// EXCEPTION: invalid operation
+			return nan[SPEC](), true
+		}
+
+	case spec.Eq(m, magInf[SPEC]()):
+		return x, true
+	}
+
+	return x, false
+}
+
+func log1p[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	if r, ok := logP1Special[SPEC](x); ok {
+		return r
+	}
+
+	prec := workPrec[SPEC]()
+
+	// log(1+x) is never exact for any non-zero x.
+	bx := new(big.Float).SetPrec(prec).Set(toBigFloat[SPEC](x))
+
+	return fromBigFloat[SPEC](bigLog1p(bx, prec), rounding)
+}
+
+func log2P1[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	if r, ok := logP1Special[SPEC](x); ok {
+		return r
+	}
+
+	bx := toBigFloat[SPEC](x)
+
+	// lg(1+x) is exact only when 1+x is a power of two.
+	if u, ok := bigOnePlus(bx); ok && u.MinPrec() == 1 {
+		return fromBigFloat[SPEC](big.NewFloat(float64(u.MantExp(nil)-1)), rounding)
+	}
+
+	prec := workPrec[SPEC]()
+
+	t := bigLog1p(new(big.Float).SetPrec(prec).Set(bx), prec)
+	t.Quo(t, bigLn2.get(prec))
+
+	return fromBigFloat[SPEC](t, rounding)
+}
+
+func log10P1[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	if r, ok := logP1Special[SPEC](x); ok {
+		return r
+	}
+
+	bx := toBigFloat[SPEC](x)
+
+	// log10(1+x) is exact only when 1+x is a power of ten, 10**k = 5**k × 2**k.
+	if u, ok := bigOnePlus(bx); ok && u.IsInt() {
+		k := u.MantExp(nil) - int(u.MinPrec())
+
+		if p, ok := bigPowInt(big.NewFloat(10), uint64(k)); ok && p.Cmp(u) == 0 {
+			return fromBigFloat[SPEC](big.NewFloat(float64(k)), rounding)
+		}
+	}
+
+	prec := workPrec[SPEC]()
+
+	t := bigLog1p(new(big.Float).SetPrec(prec).Set(bx), prec)
+	t.Quo(t, bigLn10.get(prec))
+
+	return fromBigFloat[SPEC](t, rounding)
+}
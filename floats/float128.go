@@ -108,6 +108,30 @@ func (x Float128WithRound[RND]) IsNaN() bool {
 	return isNaN[binary128](x.bits)
 }
 
+func (x Float128WithRound[RND]) Class() Class {
+	return class[binary128](x.bits)
+}
+
+func (x Float128WithRound[RND]) IsSignaling() bool {
+	return isSignaling[binary128](x.bits)
+}
+
+func (x Float128WithRound[RND]) IsZero() bool {
+	return isZero[binary128](x.bits)
+}
+
+func (x Float128WithRound[RND]) IsFinite() bool {
+	return isFinite[binary128](x.bits)
+}
+
+func (x Float128WithRound[RND]) IsNormal() bool {
+	return isNormal[binary128](x.bits)
+}
+
+func (x Float128WithRound[RND]) IsSubnormal() bool {
+	return isSubnormal[binary128](x.bits)
+}
+
 func (x Float128WithRound[RND]) Sign() int {
 	return getSign[binary128](x.bits)
 }
@@ -136,6 +160,14 @@ func (x Float128WithRound[RND]) NextDown() Float128WithRound[RND] {
 	return Float128WithRound[RND]{nextDown[binary128](x.bits)}
 }
 
+func (x Float128WithRound[RND]) NextAfter(y Float128WithRound[RND]) Float128WithRound[RND] {
+	return Float128WithRound[RND]{nextAfter[binary128](x.bits, y.bits)}
+}
+
+func (x Float128WithRound[RND]) ULP() Float128WithRound[RND] {
+	return Float128WithRound[RND]{ulp[binary128](x.bits)}
+}
+
 func (x Float128WithRound[RND]) Add(y Float128WithRound[RND]) Float128WithRound[RND] {
 	var rnd RND
 
@@ -160,6 +192,27 @@ func (x Float128WithRound[RND]) Mul(y Float128WithRound[RND]) Float128WithRound[
 	return Float128WithRound[RND]{mul[binary128](x.bits, y.bits, rnd)}
 }
 
+func (x Float128WithRound[RND]) FMA(y, z Float128WithRound[RND]) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{madd[binary128](x.bits, y.bits, z.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) AugmentedAdd(y Float128WithRound[RND]) (sum, err Float128WithRound[RND]) {
+	s, e := augmentedAdd[binary128](x.bits, y.bits)
+	return Float128WithRound[RND]{s}, Float128WithRound[RND]{e}
+}
+
+func (x Float128WithRound[RND]) AugmentedSub(y Float128WithRound[RND]) (diff, err Float128WithRound[RND]) {
+	d, e := augmentedSub[binary128](x.bits, y.bits)
+	return Float128WithRound[RND]{d}, Float128WithRound[RND]{e}
+}
+
+func (x Float128WithRound[RND]) AugmentedMul(y Float128WithRound[RND]) (prod, err Float128WithRound[RND]) {
+	p, e := augmentedMul[binary128](x.bits, y.bits)
+	return Float128WithRound[RND]{p}, Float128WithRound[RND]{e}
+}
+
 func (x Float128WithRound[RND]) Div(y Float128WithRound[RND]) Float128WithRound[RND] {
 	var rnd RND
 
@@ -172,6 +225,10 @@ func (x Float128WithRound[RND]) Mod(y Float128WithRound[RND]) Float128WithRound[
 	return Float128WithRound[RND]{mod[binary128](x.bits, y.bits, rnd)}
 }
 
+func (x Float128WithRound[RND]) Remainder(y Float128WithRound[RND]) Float128WithRound[RND] {
+	return Float128WithRound[RND]{remainder[binary128](x.bits, y.bits)}
+}
+
 func (x Float128WithRound[RND]) ModF() (i, f Float128WithRound[RND]) {
 	q, r := modf[binary128](x.bits)
 	return Float128WithRound[RND]{q}, Float128WithRound[RND]{r}
@@ -186,8 +243,8 @@ func (x Float128WithRound[RND]) Compare(y Float128WithRound[RND]) int {
 }
 
 func (x Float128WithRound[RND]) Equal(y Float128WithRound[RND]) bool {
-	order, _ := fcmp[binary128](x.bits, y.bits)
-	return order == 0
+	order, ordered := fcmp[binary128](x.bits, y.bits)
+	return order == 0 && ordered
 }
 
 func (x Float128WithRound[RND]) Cmp(y Float128WithRound[RND]) (order int, ordered bool) {
@@ -214,6 +271,22 @@ func (x Float128WithRound[RND]) MaxMag(y Float128WithRound[RND]) Float128WithRou
 	return Float128WithRound[RND]{fmaxMag[binary128](x.bits, y.bits)}
 }
 
+func (x Float128WithRound[RND]) Minimum(y Float128WithRound[RND]) Float128WithRound[RND] {
+	return Float128WithRound[RND]{fminimum[binary128](x.bits, y.bits)}
+}
+
+func (x Float128WithRound[RND]) Maximum(y Float128WithRound[RND]) Float128WithRound[RND] {
+	return Float128WithRound[RND]{fmaximum[binary128](x.bits, y.bits)}
+}
+
+func (x Float128WithRound[RND]) MinimumMag(y Float128WithRound[RND]) Float128WithRound[RND] {
+	return Float128WithRound[RND]{fminimumMag[binary128](x.bits, y.bits)}
+}
+
+func (x Float128WithRound[RND]) MaximumMag(y Float128WithRound[RND]) Float128WithRound[RND] {
+	return Float128WithRound[RND]{fmaximumMag[binary128](x.bits, y.bits)}
+}
+
 func (x Float128WithRound[RND]) Round() Float128WithRound[RND] {
 	return Float128WithRound[RND]{round[binary128](x.bits)}
 }
@@ -264,6 +337,133 @@ func (x Float128WithRound[RND]) Exp2() Float128WithRound[RND] {
 	return Float128WithRound[RND]{exp2[binary128](x.bits, rnd)}
 }
 
+func (x Float128WithRound[RND]) Exp10() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{exp10[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) ExpM1() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{expM1[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Exp2M1() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{exp2M1[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Exp10M1() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{exp10M1[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Log1p() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{log1p[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Log2p1() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{log2P1[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Log10p1() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{log10P1[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Pow(y Float128WithRound[RND]) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{pow[binary128](x.bits, y.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Pown(n int) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{pown[binary128](x.bits, n, rnd)}
+}
+
+func (x Float128WithRound[RND]) Powr(y Float128WithRound[RND]) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{powr[binary128](x.bits, y.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Rootn(n int) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{rootn[binary128](x.bits, n, rnd)}
+}
+
+func (x Float128WithRound[RND]) Cbrt() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{rootn[binary128](x.bits, 3, rnd)}
+}
+
+func (x Float128WithRound[RND]) Compound(n int) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{compound[binary128](x.bits, n, rnd)}
+}
+
+func (x Float128WithRound[RND]) Erf() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{erf[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Erfc() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{erfc[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Gamma() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{gamma[binary128](x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) LnGamma() (lngamma Float128WithRound[RND], sign int) {
+	var rnd RND
+
+	l, sign := lnGamma[binary128](x.bits, rnd)
+	return Float128WithRound[RND]{l}, sign
+}
+
+func (x Float128WithRound[RND]) J0() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{besselJ[binary128](0, x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) J1() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{besselJ[binary128](1, x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Y0() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{besselY[binary128](0, x.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) Y1() Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{besselY[binary128](1, x.bits, rnd)}
+}
+
 func (x Float128WithRound[RND]) LogB() Float128WithRound[RND] {
 	return Float128WithRound[RND]{logb[binary128](x.bits)}
 }
@@ -271,3 +471,59 @@ func (x Float128WithRound[RND]) LogB() Float128WithRound[RND] {
 func (x Float128WithRound[RND]) ILogB() (int, bool) {
 	return ilogb[binary128](x.bits)
 }
+
+func (x Float128WithRound[RND]) ScaleB(n int) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{ldexp[binary128](x.bits, n, rnd)}
+}
+
+func (x Float128WithRound[RND]) FrExp() (frac Float128WithRound[RND], exp int) {
+	f, exp := frexp[binary128](x.bits)
+	return Float128WithRound[RND]{f}, exp
+}
+
+func (x Float128WithRound[RND]) LdExp(exp int) Float128WithRound[RND] {
+	return x.ScaleB(exp)
+}
+
+// The following unexported methods provide the reductions needed by Sum and Dot.
+
+func (x Float128WithRound[RND]) sum(xs []Float128WithRound[RND], alg SumAlgorithm) Float128WithRound[RND] {
+	return Float128Sum(xs, alg)
+}
+
+func (x Float128WithRound[RND]) dot(xs, ys []Float128WithRound[RND], alg SumAlgorithm) Float128WithRound[RND] {
+	return Float128Dot(xs, ys, alg)
+}
+
+// The following unexported methods provide the elementary functions needed by Complex.
+
+func (x Float128WithRound[RND]) fromFloat64(v float64) Float128WithRound[RND] {
+	return Float128WithRoundFromFloat[RND](v)
+}
+
+func (x Float128WithRound[RND]) ldexp(exp int) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{ldexp[binary128](x.bits, exp, rnd)}
+}
+
+func (x Float128WithRound[RND]) logHypot(y Float128WithRound[RND]) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{logHypot[binary128](x.bits, y.bits, rnd)}
+}
+
+func (x Float128WithRound[RND]) sinCos() (sin, cos Float128WithRound[RND]) {
+	var rnd RND
+
+	s, c := sinCos[binary128](x.bits, rnd)
+	return Float128WithRound[RND]{s}, Float128WithRound[RND]{c}
+}
+
+func (y Float128WithRound[RND]) atan2(x Float128WithRound[RND]) Float128WithRound[RND] {
+	var rnd RND
+
+	return Float128WithRound[RND]{atan2[binary128](y.bits, x.bits, rnd)}
+}
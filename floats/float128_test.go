@@ -1,6 +1,7 @@
 package floats
 
 import (
+	"fmt"
 	"math"
 	"math/big"
 	"testing"
@@ -202,6 +203,44 @@ func TestFloat128OpMul(t *testing.T) {
 	}
 }
 
+func TestFloat128OpFMA(t *testing.T) {
+	one := Float128FromFloat(1.0)
+	up, down := one.NextUp(), one.NextDown()
+	eps := up.Sub(one)
+	halfEps := eps.LdExp(-1)
+
+	type test struct {
+		name    string
+		x, y, z Float128
+		expect  Float128
+	}
+
+	tests := []test{
+		{"exact", Float128FromFloat(3.0), Float128FromFloat(5.0), Float128FromFloat(-14.0), one},
+		// (1 + ε)(1 - ε/2) - 1 = ε/2 - ε²/2, whose second term is lost if the product is rounded first.
+		{"cancellation", up, one.Sub(halfEps), one.Neg(), halfEps.Mul(one.Sub(eps))},
+		// (1 + ε)(1 - ε/2) = 1 + ε/2 - ε²/2, which rounds to 1, but is a tie if the product is rounded first.
+		{"double_rounding", up, one.Sub(halfEps), Float128FromFloat(0.0), one},
+		{"overflow_product", MaxFloat128, Float128FromFloat(2.0), MaxFloat128.Neg(), MaxFloat128},
+		{"zero_sum", Float128FromFloat(2.0), Float128FromFloat(3.0), Float128FromFloat(-6.0), Float128FromFloat(0.0)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.x.FMA(tt.y, tt.z); res.Bits() != tt.expect.Bits() {
+				t.Errorf("%v × %v + %v:\n  actual: %v\nexpected: %v", tt.x, tt.y, tt.z, res, tt.expect)
+			}
+		})
+	}
+
+	// Rounded toward zero, (1 + ε)(1 - ε) = 1 - ε² is below 1.
+	x := Float128WithRound[RoundTowardZero](up)
+	if res, expect := x.FMA(Float128WithRound[RoundTowardZero](one.Sub(eps)), Float128WithRound[RoundTowardZero]{}), Float128WithRound[RoundTowardZero](down); res != expect {
+		t.Errorf("(1 + ε)(1 - ε) rounded toward zero = %v, expected %v", res, expect)
+	}
+}
+
 func TestFloat128OpDiv(t *testing.T) {
 	type test struct {
 		name string
@@ -327,3 +366,182 @@ func TestFloat128OpSqrt(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat128OpScaleB(t *testing.T) {
+	two := Float128FromFloat(2.0)
+
+	if res := two.ScaleB(math.MaxInt); !res.IsInf(1) {
+		t.Errorf("2.ScaleB(MaxInt) = %v, but expected +Inf", res)
+	}
+
+	if res := two.Neg().ScaleB(math.MaxInt); !res.IsInf(-1) {
+		t.Errorf("-2.ScaleB(MaxInt) = %v, but expected -Inf", res)
+	}
+
+	if res := two.ScaleB(math.MinInt); !res.IsZero() || res.SignBit() {
+		t.Errorf("2.ScaleB(MinInt) = %v, but expected +0", res)
+	}
+
+	if res := two.ScaleB(-16495); res.Bits() != (bits.Uint128{Lo: 1}) {
+		t.Errorf("2.ScaleB(-16495) = %032x, but expected the smallest subnormal", res.Bits())
+	}
+}
+
+func TestFloat128OpExpM1(t *testing.T) {
+	type test struct {
+		name   string
+		op     func(Float128) Float128
+		x      float64
+		expect Float128
+	}
+
+	type Z = Float128WithRound[RoundTowardZero]
+
+	tests := []test{
+		{"10^3", Float128.Exp10, 3, Float128FromFloat(1000.0)},
+		{"10^2 - 1", Float128.Exp10M1, 2, Float128FromFloat(99.0)},
+		{"2^-3 - 1", Float128.Exp2M1, -3, Float128FromFloat(-0.875)},
+		{"2^120 - 1", Float128.Exp2M1, 120, Float128FromFloat(0x1p120)},
+		{"2^120 - 1 toward zero", func(x Float128) Float128 { return Float128(Z(x).Exp2M1()) }, 120, Float128FromFloat(0x1p120).NextDown()},
+		{"e^-20000 - 1", Float128.ExpM1, -20000, Float128FromFloat(-1.0)},
+		{"e^-20000 - 1 toward zero", func(x Float128) Float128 { return Float128(Z(x).ExpM1()) }, -20000, Float128FromFloat(-1.0).NextUp()},
+		{"ln(1+1)", Float128.Log1p, 1, Ln2},
+		{"lg(1+7)", Float128.Log2p1, 7, Float128FromFloat(3.0)},
+		{"log10(1+99)", Float128.Log10p1, 99, Float128FromFloat(2.0)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.op(Float128FromFloat(tt.x))
+
+			if res.Bits() != tt.expect.Bits() {
+				t.Errorf("%s\n  actual: %032x\nexpected: %032x", tt.name, res.Bits(), tt.expect.Bits())
+			}
+		})
+	}
+}
+
+func TestFloat128FormatNonFinite(t *testing.T) {
+	for _, tt := range []struct {
+		x      Float128
+		expect string
+	}{
+		{Inf128(false), "+Inf"},
+		{Inf128(true), "-Inf"},
+		{NaN128(), "NaN"},
+	} {
+		if got := fmt.Sprintf("%v", tt.x); got != tt.expect {
+			t.Errorf("Float128(%032x) formatted as %q, expected %q", tt.x.Bits(), got, tt.expect)
+		}
+	}
+}
+
+func TestFloat128OpSpecial(t *testing.T) {
+	type test struct {
+		name   string
+		op     func(Float128) Float128
+		x      float64
+		expect string
+	}
+
+	lnGamma := func(x Float128) Float128 {
+		l, _ := x.LnGamma()
+		return l
+	}
+
+	tests := []test{
+		{"erf(1)", Float128.Erf, 1, "0.84270079294971486934122063508260925929606699796630290845993738"},
+		{"erfc(10)", Float128.Erfc, 10, "2.0884875837625447570007862949577886115608181193211634e-45"},
+		{"Γ(½)", Float128.Gamma, 0.5, "1.7724538509055160272981674833411451827975494561223871282138077"},
+		{"Γ(-½)", Float128.Gamma, -0.5, "-3.5449077018110320545963349666822903655950989122447742564276154"},
+		{"ln(Γ(½))", lnGamma, 0.5, "0.57236494292470008707171367567652935582364740645765578575681153"},
+		{"J0(1)", Float128.J0, 1, "0.76519768655796655144971752610266322090927428975532537960853087"},
+		{"J1(1)", Float128.J1, 1, "0.44005058574493351595968220371891491312216578382049135040966062"},
+		{"Y0(1)", Float128.Y0, 1, "0.088256964215676957982926766023515162827817523090675546711043735"},
+		{"Y1(1)", Float128.Y1, 1, "-0.78121282130028871654715000004796482054990639071644460784383"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			v, _, err := big.ParseFloat(tt.expect, 10, 256, big.ToNearestEven)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expect := Float128FromFloat(v)
+
+			if res := tt.op(Float128FromFloat(tt.x)); res.Bits() != expect.Bits() {
+				t.Errorf("%s\n  actual: %032x\nexpected: %032x", tt.name, res.Bits(), expect.Bits())
+			}
+		})
+	}
+
+	// Γ(n) = (n-1)! is exact.
+	f := new(big.Int).MulRange(1, 1754)
+	if res, expect := Float128FromFloat(1755.0).Gamma(), Float128FromFloat(new(big.Float).SetInt(f)); res.Bits() != expect.Bits() {
+		t.Errorf("Γ(1755)\n  actual: %032x\nexpected: %032x", res.Bits(), expect.Bits())
+	}
+
+	if res := Float128FromFloat(1756.0).Gamma(); !res.IsInf(1) {
+		t.Errorf("Γ(1756) = %v, but expected +Inf", res)
+	}
+}
+
+func TestFloat128Allocs(t *testing.T) {
+	x, y := Float128FromFloat(1.5), Float128FromFloat(0.75)
+
+	tests := []struct {
+		name string
+		op   func() Float128
+	}{
+		{"Add", func() Float128 { return x.Add(y) }},
+		{"Mul", func() Float128 { return x.Mul(y) }},
+		{"Div", func() Float128 { return x.Div(y) }},
+		{"Sqrt", func() Float128 { return x.Sqrt() }},
+		{"Exp", func() Float128 { return x.Exp() }},
+	}
+
+	for _, tt := range tests {
+		if allocs := testing.AllocsPerRun(100, func() { _ = tt.op() }); allocs != 0 {
+			t.Errorf("%s: %v allocs per op, expected none", tt.name, allocs)
+		}
+	}
+}
+
+func benchmarkFloat128Op(b *testing.B, op func(x, y Float128) Float128) {
+	xs := make([]Float128, 1<<12)
+	for i := range xs {
+		xs[i] = Float128FromFloat(1 + math.Sin(float64(i))/2)
+	}
+	dst := make([]Float128, len(xs))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, x := range xs {
+			dst[j] = op(x, xs[len(xs)-1-j])
+		}
+	}
+}
+
+func BenchmarkFloat128Add(b *testing.B) {
+	benchmarkFloat128Op(b, Float128.Add)
+}
+
+func BenchmarkFloat128Mul(b *testing.B) {
+	benchmarkFloat128Op(b, Float128.Mul)
+}
+
+func BenchmarkFloat128Div(b *testing.B) {
+	benchmarkFloat128Op(b, Float128.Div)
+}
+
+func BenchmarkFloat128Sqrt(b *testing.B) {
+	benchmarkFloat128Op(b, func(x, _ Float128) Float128 { return x.Sqrt() })
+}
+
+func BenchmarkFloat128Exp(b *testing.B) {
+	benchmarkFloat128Op(b, func(x, _ Float128) Float128 { return x.Exp() })
+}
@@ -146,6 +146,36 @@ func (x Float16WithRound[RND]) IsNaN() bool {
 	return isNaN[binary16](x.bits)
 }
 
+// Class returns which of the ten IEEE 754 classes the number belongs to.
+func (x Float16WithRound[RND]) Class() Class {
+	return class[binary16](x.bits)
+}
+
+// IsSignaling reports whether the number is an IEEE 754 signaling “not-a-number” value.
+func (x Float16WithRound[RND]) IsSignaling() bool {
+	return isSignaling[binary16](x.bits)
+}
+
+// IsZero reports whether the number is either positive or negative zero.
+func (x Float16WithRound[RND]) IsZero() bool {
+	return isZero[binary16](x.bits)
+}
+
+// IsFinite reports whether the number is neither an infinity nor a “not-a-number” value.
+func (x Float16WithRound[RND]) IsFinite() bool {
+	return isFinite[binary16](x.bits)
+}
+
+// IsNormal reports whether the number is normal, that is finite, non-zero, and not subnormal.
+func (x Float16WithRound[RND]) IsNormal() bool {
+	return isNormal[binary16](x.bits)
+}
+
+// IsSubnormal reports whether the number is subnormal, that is non-zero, and smaller in magnitude than any normal number.
+func (x Float16WithRound[RND]) IsSubnormal() bool {
+	return isSubnormal[binary16](x.bits)
+}
+
 // Sign returns the sign of the number.
 // If x > 0, then it returns 1.
 // If x < 0, then it returns -1.
@@ -207,6 +237,31 @@ func (x Float16WithRound[RND]) NextDown() Float16WithRound[RND] {
 	return Float16WithRound[RND]{nextDown[binary16](x.bits)}
 }
 
+// NextAfter returns the next IEEE 754 floating-point value after x towards y.
+//
+// Special cases are:
+//
+//	x.NextAfter(x) = x
+//	NaN.NextAfter(y) = NaN
+//	x.NextAfter(NaN) = NaN
+//	±0.NextAfter(∓0) = ∓0
+func (x Float16WithRound[RND]) NextAfter(y Float16WithRound[RND]) Float16WithRound[RND] {
+	return Float16WithRound[RND]{nextAfter[binary16](x.bits, y.bits)}
+}
+
+// ULP returns the unit in the last place of x,
+// which is the distance between the magnitude of x and the next larger floating-point value,
+// or for MaxFloat, the distance to the next smaller floating-point value.
+//
+// Special cases are:
+//
+//	NaN.ULP() = NaN
+//	±Inf.ULP() = +Inf
+//	±0.ULP() = SmallestNonzeroFloat
+func (x Float16WithRound[RND]) ULP() Float16WithRound[RND] {
+	return Float16WithRound[RND]{ulp[binary16](x.bits)}
+}
+
 // Add returns the sum of x+y.
 //
 // Special cases are:
@@ -264,6 +319,79 @@ func (x Float16WithRound[RND]) Mul(y Float16WithRound[RND]) Float16WithRound[RND
 	return Float16WithRound[RND]{mul[binary16](x.bits, y.bits, rnd)}
 }
 
+// FMA returns x*y+z, computed with only one rounding.
+//
+// Special cases are:
+//
+//	FMA(x, y, NaN) = FMA(x, NaN, z) = FMA(NaN, y, z) = NaN
+//	FMA(±Inf, 0, z) = FMA(0, ±Inf, z) = NaN
+//	FMA(x, y, z) = NaN, if x*y is infinite, and z is the infinity of the opposite sign
+func (x Float16WithRound[RND]) FMA(y, z Float16WithRound[RND]) Float16WithRound[RND] {
+	var rnd RND
+
+	var f [3]float64
+	decodeFloat16s64(f[:], []uint16{x.bits, y.bits, z.bits})
+
+	if r := fmaOdd(f[0], f[1], f[2], rnd); r == r {
+		return Float16WithRoundFromFloat[RND](r)
+	}
+
+	return Float16WithRound[RND]{madd[binary16](x.bits, y.bits, z.bits, rnd)}
+}
+
+// AugmentedAdd returns the sum of x+y, rounded to nearest with ties toward zero,
+// and the error of that rounding, such that sum + err == x + y exactly.
+//
+// Special cases are:
+//
+//	x.AugmentedAdd(NaN) = NaN.AugmentedAdd(y) = NaN, NaN
+//	±Inf.AugmentedAdd(∓Inf) = NaN, NaN
+//	±Inf.AugmentedAdd(y) = x.AugmentedAdd(±Inf) = ±Inf, ±Inf
+//	x.AugmentedAdd(y) = ±Inf, ±Inf, if x + y overflows
+//
+// A zero err has the same sign as sum.
+// This is the IEEE 754-2019 augmentedAddition operation.
+func (x Float16WithRound[RND]) AugmentedAdd(y Float16WithRound[RND]) (sum, err Float16WithRound[RND]) {
+	s, e := augmentedAdd[binary16](x.bits, y.bits)
+	return Float16WithRound[RND]{s}, Float16WithRound[RND]{e}
+}
+
+// AugmentedSub returns the difference of x-y, rounded to nearest with ties toward zero,
+// and the error of that rounding, such that diff + err == x - y exactly.
+//
+// Special cases are:
+//
+//	x.AugmentedSub(NaN) = NaN.AugmentedSub(y) = NaN, NaN
+//	±Inf.AugmentedSub(±Inf) = NaN, NaN
+//	±Inf.AugmentedSub(y) = ±Inf, ±Inf
+//	x.AugmentedSub(±Inf) = ∓Inf, ∓Inf
+//	x.AugmentedSub(y) = ±Inf, ±Inf, if x - y overflows
+//
+// A zero err has the same sign as diff.
+// This is the IEEE 754-2019 augmentedSubtraction operation.
+func (x Float16WithRound[RND]) AugmentedSub(y Float16WithRound[RND]) (diff, err Float16WithRound[RND]) {
+	d, e := augmentedSub[binary16](x.bits, y.bits)
+	return Float16WithRound[RND]{d}, Float16WithRound[RND]{e}
+}
+
+// AugmentedMul returns the product of x*y, rounded to nearest with ties toward zero,
+// and the error of that rounding, such that prod + err == x * y,
+// exactly unless err underflows.
+//
+// Special cases are:
+//
+//	x.AugmentedMul(NaN) = NaN.AugmentedMul(y) = NaN, NaN
+//	±Inf.AugmentedMul(0) = 0.AugmentedMul(±Inf) = NaN, NaN
+//	x.AugmentedMul(y) = ±Inf, ±Inf, if x * y overflows
+//	x.AugmentedMul(y) = ±0, ±0, if x * y rounds to zero
+//
+// A zero err has the same sign as prod.
+// This is the IEEE 754-2019 augmentedMultiplication operation.
+func (x Float16WithRound[RND]) AugmentedMul(y Float16WithRound[RND]) (prod, err Float16WithRound[RND]) {
+	p, e := augmentedMul[binary16](x.bits, y.bits)
+	return Float16WithRound[RND]{p}, Float16WithRound[RND]{e}
+}
+
 // Div returns the quotient of x/y.
 //
 // Special cases are:
@@ -296,6 +424,20 @@ func (x Float16WithRound[RND]) Mod(y Float16WithRound[RND]) Float16WithRound[RND
 	return Float16WithRound[RND]{mod[binary16](x.bits, y.bits, rnd)}
 }
 
+// Remainder returns the IEEE 754 remainder of x/y, which is x - n×y, where n is the integer nearest to x/y,
+// with ties going to the even integer.
+// The magnitude of the result is at most half of y, and it is always exact.
+//
+// Special cases are:
+//
+//	x.Remainder(NaN) = NaN.Remainder(y) = NaN
+//	±Inf.Remainder(y) = NaN
+//	x.Remainder(±Inf) = x
+//	x.Remainder(0) = NaN
+func (x Float16WithRound[RND]) Remainder(y Float16WithRound[RND]) Float16WithRound[RND] {
+	return Float16WithRound[RND]{remainder[binary16](x.bits, y.bits)}
+}
+
 // Modf returns integer and fractional floating-point numbers that sum to x.
 // Both values have the same sign as x.
 //
@@ -362,7 +504,7 @@ func (x Float16WithRound[RND]) Cmp(y Float16WithRound[RND]) (order int, ordered
 //	-0.Min(±0) = ±0.Min(-0) = -0
 //
 // This differs from math.Min in that it returns the number rather than the NaN, if one of them is NaN.
-// The IEEE 754 standard says it should return the canonical number.
+// This is the IEEE 754-2019 minimumNumber operation.
 func (x Float16WithRound[RND]) Min(y Float16WithRound[RND]) Float16WithRound[RND] {
 	return Float16WithRound[RND]{fmin[binary16](x.bits, y.bits)}
 }
@@ -377,7 +519,7 @@ func (x Float16WithRound[RND]) Min(y Float16WithRound[RND]) Float16WithRound[RND
 //	-0.Max(-0) = -0
 //
 // This differs from math.Max in that it returns the number rather than the NaN, if one of them is NaN.
-// The IEEE 754 standard says it should return the canonical number.
+// This is the IEEE 754-2019 maximumNumber operation.
 func (x Float16WithRound[RND]) Max(y Float16WithRound[RND]) Float16WithRound[RND] {
 	return Float16WithRound[RND]{fmax[binary16](x.bits, y.bits)}
 }
@@ -393,29 +535,92 @@ func (x Float16WithRound[RND]) CmpMag(y Float16WithRound[RND]) (order int, order
 }
 
 // MinMag returns the smaller of magnitude of x or y.
+// If the magnitudes are equal, then it returns x.Min(y).
 //
 // Special cases are:
 //
 //	x.MinMag(NaN) = NaN.MinMag(x) = x
-//	x.MinMag(±Inf) = ±Inf.MinMag(x) = ±Inf
-//	±Inf.MinMag(±Inf) = ±Inf.MinMag(∓Inf) = ±Inf
-//	±0.MinMag(±0) = ±0.MinMag(∓0) = ±0
+//	x.MinMag(±Inf) = ±Inf.MinMag(x) = x
+//	±Inf.MinMag(∓Inf) = -Inf
+//	±0.MinMag(∓0) = -0
+//
+// This is the IEEE 754-2019 minimumMagnitudeNumber operation.
 func (x Float16WithRound[RND]) MinMag(y Float16WithRound[RND]) Float16WithRound[RND] {
 	return Float16WithRound[RND]{fminMag[binary16](x.bits, y.bits)}
 }
 
 // MaxMag returns the larger of magnitude of x or y.
+// If the magnitudes are equal, then it returns x.Max(y).
 //
 // Special cases are:
 //
 //	x.MaxMag(NaN) = NaN.MaxMag(x) = x
 //	x.MaxMag(±Inf) = ±Inf.MaxMag(x) = ±Inf
-//	±Inf.MaxMag(±Inf) = ±Inf.MaxMag(∓Inf) = ±Inf
-//	±0.MaxMag(±0) = ±0.MaxMag(∓0) = ±0
+//	±Inf.MaxMag(∓Inf) = +Inf
+//	±0.MaxMag(∓0) = +0
+//
+// This is the IEEE 754-2019 maximumMagnitudeNumber operation.
 func (x Float16WithRound[RND]) MaxMag(y Float16WithRound[RND]) Float16WithRound[RND] {
 	return Float16WithRound[RND]{fmaxMag[binary16](x.bits, y.bits)}
 }
 
+// Minimum returns the smaller of x or y.
+//
+// Special cases are:
+//
+//	x.Minimum(NaN) = NaN.Minimum(y) = NaN
+//	x.Minimum(-Inf) = -Inf.Minimum(x) = -Inf
+//	-0.Minimum(±0) = ±0.Minimum(-0) = -0
+//
+// This differs from Min in that it returns the NaN rather than the number, if one of them is NaN.
+// This is the IEEE 754-2019 minimum operation.
+func (x Float16WithRound[RND]) Minimum(y Float16WithRound[RND]) Float16WithRound[RND] {
+	return Float16WithRound[RND]{fminimum[binary16](x.bits, y.bits)}
+}
+
+// Maximum returns the larger of x or y.
+//
+// Special cases are:
+//
+//	x.Maximum(NaN) = NaN.Maximum(y) = NaN
+//	x.Maximum(+Inf) = +Inf.Maximum(x) = +Inf
+//	+0.Maximum(±0) = ±0.Maximum(+0) = +0
+//	-0.Maximum(-0) = -0
+//
+// This differs from Max in that it returns the NaN rather than the number, if one of them is NaN.
+// This is the IEEE 754-2019 maximum operation.
+func (x Float16WithRound[RND]) Maximum(y Float16WithRound[RND]) Float16WithRound[RND] {
+	return Float16WithRound[RND]{fmaximum[binary16](x.bits, y.bits)}
+}
+
+// MinimumMag returns the smaller of magnitude of x or y.
+// If the magnitudes are equal, then it returns x.Minimum(y).
+//
+// Special cases are:
+//
+//	x.MinimumMag(NaN) = NaN.MinimumMag(y) = NaN
+//	±Inf.MinimumMag(∓Inf) = -Inf
+//	±0.MinimumMag(∓0) = -0
+//
+// This is the IEEE 754-2019 minimumMagnitude operation.
+func (x Float16WithRound[RND]) MinimumMag(y Float16WithRound[RND]) Float16WithRound[RND] {
+	return Float16WithRound[RND]{fminimumMag[binary16](x.bits, y.bits)}
+}
+
+// MaximumMag returns the larger of magnitude of x or y.
+// If the magnitudes are equal, then it returns x.Maximum(y).
+//
+// Special cases are:
+//
+//	x.MaximumMag(NaN) = NaN.MaximumMag(y) = NaN
+//	±Inf.MaximumMag(∓Inf) = +Inf
+//	±0.MaximumMag(∓0) = +0
+//
+// This is the IEEE 754-2019 maximumMagnitude operation.
+func (x Float16WithRound[RND]) MaximumMag(y Float16WithRound[RND]) Float16WithRound[RND] {
+	return Float16WithRound[RND]{fmaximumMag[binary16](x.bits, y.bits)}
+}
+
 // Round returns the nearest integer, rounding ties away from zero.
 //
 // Special cases are:
@@ -535,6 +740,317 @@ func (x Float16WithRound[RND]) Exp2() Float16WithRound[RND] {
 	return Float16WithRound[RND]{exp2[binary16](x.bits, rnd)}
 }
 
+// Exp10 returns 10**x, the base-10 exponential of x.
+//
+// Special cases are the same as [Exp].
+func (x Float16WithRound[RND]) Exp10() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{exp10[binary16](x.bits, rnd)}
+}
+
+// ExpM1 returns e**x - 1, the base-e exponential of x minus 1.
+// It is more accurate than x.Exp() - 1 when x is near zero.
+//
+// Special cases are:
+//
+//	+Inf.ExpM1() = +Inf
+//	-Inf.ExpM1() = -1
+//	NaN.ExpM1() = NaN
+//
+// Very large values overflow to -1 or +Inf.
+func (x Float16WithRound[RND]) ExpM1() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{expM1[binary16](x.bits, rnd)}
+}
+
+// Exp2M1 returns 2**x - 1, the base-2 exponential of x minus 1.
+// It is more accurate than x.Exp2() - 1 when x is near zero.
+//
+// Special cases are the same as [ExpM1].
+func (x Float16WithRound[RND]) Exp2M1() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{exp2M1[binary16](x.bits, rnd)}
+}
+
+// Exp10M1 returns 10**x - 1, the base-10 exponential of x minus 1.
+// It is more accurate than x.Exp10() - 1 when x is near zero.
+//
+// Special cases are the same as [ExpM1].
+func (x Float16WithRound[RND]) Exp10M1() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{exp10M1[binary16](x.bits, rnd)}
+}
+
+// Log1p returns the natural logarithm of 1 plus x.
+// It is more accurate than the logarithm of 1 + x when x is near zero.
+//
+// Special cases are:
+//
+//	+Inf.Log1p() = +Inf
+//	±0.Log1p() = ±0
+//	-1.Log1p() = -Inf
+//	x.Log1p() = NaN if x < -1
+//	NaN.Log1p() = NaN
+func (x Float16WithRound[RND]) Log1p() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{log1p[binary16](x.bits, rnd)}
+}
+
+// Log2p1 returns the binary logarithm of 1 plus x.
+// It is more accurate than the binary logarithm of 1 + x when x is near zero.
+//
+// Special cases are the same as [Log1p].
+func (x Float16WithRound[RND]) Log2p1() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{log2P1[binary16](x.bits, rnd)}
+}
+
+// Log10p1 returns the decimal logarithm of 1 plus x.
+// It is more accurate than the decimal logarithm of 1 + x when x is near zero.
+//
+// Special cases are the same as [Log1p].
+func (x Float16WithRound[RND]) Log10p1() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{log10P1[binary16](x.bits, rnd)}
+}
+
+// Pow returns x**y, the base-x exponential of y.
+//
+// Special cases are (in order):
+//
+//	x.Pow(±0) = 1 for any x
+//	1.Pow(y) = 1 for any y
+//	x.Pow(1) = x for any x
+//	NaN.Pow(y) = NaN
+//	x.Pow(NaN) = NaN
+//	±0.Pow(y) = ±Inf for y an odd integer < 0
+//	±0.Pow(-Inf) = +Inf
+//	±0.Pow(+Inf) = +0
+//	±0.Pow(y) = +Inf for finite y < 0 and not an odd integer
+//	±0.Pow(y) = ±0 for y an odd integer > 0
+//	±0.Pow(y) = +0 for finite y > 0 and not an odd integer
+//	-1.Pow(±Inf) = 1
+//	x.Pow(+Inf) = +Inf for |x| > 1
+//	x.Pow(-Inf) = +0 for |x| > 1
+//	x.Pow(+Inf) = +0 for |x| < 1
+//	x.Pow(-Inf) = +Inf for |x| < 1
+//	+Inf.Pow(y) = +Inf for y > 0
+//	+Inf.Pow(y) = +0 for y < 0
+//	-Inf.Pow(y) = -0.Pow(-y)
+//	x.Pow(y) = NaN for finite x < 0 and finite non-integer y
+func (x Float16WithRound[RND]) Pow(y Float16WithRound[RND]) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{pow[binary16](x.bits, y.bits, rnd)}
+}
+
+// Pown returns x**n, for an integer n.
+//
+// Special cases are:
+//
+//	x.Pown(0) = 1 for any x, even NaN
+//	±0.Pown(n) = ±Inf for odd n < 0
+//	±0.Pown(n) = +Inf for even n < 0
+//	±0.Pown(n) = ±0 for odd n > 0
+//	±0.Pown(n) = +0 for even n > 0
+//	±Inf.Pown(n) is the same as ±0.Pown(-n)
+//	NaN.Pown(n) = NaN for n ≠ 0
+func (x Float16WithRound[RND]) Pown(n int) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{pown[binary16](x.bits, n, rnd)}
+}
+
+// Powr returns x**y, computed as e**(y × log(x)), and so defined only for x ≥ 0.
+//
+// Special cases are:
+//
+//	x.Powr(±0) = 1 for finite x > 0
+//	±0.Powr(y) = +Inf for y < 0, including -Inf
+//	±0.Powr(y) = +0 for y > 0
+//	1.Powr(y) = 1 for finite y
+//	x.Powr(+Inf) = +0 for 0 < x < 1, and +Inf for x > 1
+//	x.Powr(-Inf) = +Inf for 0 < x < 1, and +0 for x > 1
+//	+Inf.Powr(y) = +0 for y < 0, and +Inf for y > 0
+//	x.Powr(y) = NaN for x < 0
+//	±0.Powr(±0) = +Inf.Powr(±0) = 1.Powr(±Inf) = NaN
+//	NaN.Powr(y) = x.Powr(NaN) = NaN
+func (x Float16WithRound[RND]) Powr(y Float16WithRound[RND]) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{powr[binary16](x.bits, y.bits, rnd)}
+}
+
+// Rootn returns the principal n-th root of x.
+//
+// Special cases are:
+//
+//	x.Rootn(0) = NaN
+//	±0.Rootn(n) = ±Inf for odd n < 0
+//	±0.Rootn(n) = +Inf for even n < 0
+//	±0.Rootn(n) = ±0 for odd n > 0
+//	±0.Rootn(n) = +0 for even n > 0
+//	±Inf.Rootn(n) = ±Inf for odd n > 0
+//	±Inf.Rootn(n) = ±0 for odd n < 0
+//	+Inf.Rootn(n) = +Inf for even n > 0
+//	+Inf.Rootn(n) = +0 for even n < 0
+//	x.Rootn(n) = NaN for x < 0 and even n
+//	NaN.Rootn(n) = NaN
+func (x Float16WithRound[RND]) Rootn(n int) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{rootn[binary16](x.bits, n, rnd)}
+}
+
+// Cbrt returns the cube root of x.
+//
+// Special cases are:
+//
+//	±0.Cbrt() = ±0
+//	±Inf.Cbrt() = ±Inf
+//	NaN.Cbrt() = NaN
+func (x Float16WithRound[RND]) Cbrt() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{rootn[binary16](x.bits, 3, rnd)}
+}
+
+// Compound returns (1+x)**n, the compound interest of the rate x over n periods.
+//
+// Special cases are:
+//
+//	x.Compound(0) = 1 for x ≥ -1, +Inf, and NaN
+//	-1.Compound(n) = +Inf for n < 0
+//	-1.Compound(n) = +0 for n > 0
+//	+Inf.Compound(n) = +Inf for n > 0
+//	+Inf.Compound(n) = +0 for n < 0
+//	x.Compound(n) = NaN for x < -1
+//	NaN.Compound(n) = NaN for n ≠ 0
+func (x Float16WithRound[RND]) Compound(n int) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{compound[binary16](x.bits, n, rnd)}
+}
+
+// Erf returns the error function of x.
+//
+// Special cases are:
+//
+//	+Inf.Erf() = 1
+//	-Inf.Erf() = -1
+//	NaN.Erf() = NaN
+func (x Float16WithRound[RND]) Erf() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{erf[binary16](x.bits, rnd)}
+}
+
+// Erfc returns the complementary error function of x.
+// It is more accurate than 1 - x.Erf() when x is large.
+//
+// Special cases are:
+//
+//	+Inf.Erfc() = 0
+//	-Inf.Erfc() = 2
+//	NaN.Erfc() = NaN
+func (x Float16WithRound[RND]) Erfc() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{erfc[binary16](x.bits, rnd)}
+}
+
+// Gamma returns the Gamma function of x.
+//
+// Special cases are:
+//
+//	+Inf.Gamma() = +Inf
+//	+0.Gamma() = +Inf
+//	-0.Gamma() = -Inf
+//	x.Gamma() = NaN for integer x < 0
+//	-Inf.Gamma() = NaN
+//	NaN.Gamma() = NaN
+func (x Float16WithRound[RND]) Gamma() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{gamma[binary16](x.bits, rnd)}
+}
+
+// LnGamma returns the natural logarithm and sign (-1 or +1) of x.Gamma().
+//
+// Special cases are:
+//
+//	+Inf.LnGamma() = +Inf
+//	0.LnGamma() = +Inf
+//	x.LnGamma() = +Inf for integer x < 0
+//	-Inf.LnGamma() = -Inf
+//	NaN.LnGamma() = NaN
+func (x Float16WithRound[RND]) LnGamma() (lngamma Float16WithRound[RND], sign int) {
+	var rnd RND
+
+	l, sign := lnGamma[binary16](x.bits, rnd)
+	return Float16WithRound[RND]{l}, sign
+}
+
+// J0 returns the order-zero Bessel function of the first kind.
+//
+// Special cases are:
+//
+//	±Inf.J0() = 0
+//	0.J0() = 1
+//	NaN.J0() = NaN
+func (x Float16WithRound[RND]) J0() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{besselJ[binary16](0, x.bits, rnd)}
+}
+
+// J1 returns the order-one Bessel function of the first kind.
+//
+// Special cases are:
+//
+//	±Inf.J1() = 0
+//	NaN.J1() = NaN
+func (x Float16WithRound[RND]) J1() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{besselJ[binary16](1, x.bits, rnd)}
+}
+
+// Y0 returns the order-zero Bessel function of the second kind.
+//
+// Special cases are:
+//
+//	+Inf.Y0() = 0
+//	0.Y0() = -Inf
+//	x.Y0() = NaN if x < 0
+//	NaN.Y0() = NaN
+func (x Float16WithRound[RND]) Y0() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{besselY[binary16](0, x.bits, rnd)}
+}
+
+// Y1 returns the order-one Bessel function of the second kind.
+//
+// Special cases are:
+//
+//	+Inf.Y1() = 0
+//	0.Y1() = -Inf
+//	x.Y1() = NaN if x < 0
+//	NaN.Y1() = NaN
+func (x Float16WithRound[RND]) Y1() Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{besselY[binary16](1, x.bits, rnd)}
+}
+
 // LogB returns the binary exponent of x.
 //
 // Special cases are:
@@ -551,11 +1067,87 @@ func (x Float16WithRound[RND]) LogB() Float16WithRound[RND] {
 //
 // Special cases are:
 //
-//	NaN.ILogB() = [math.MaxInt], false
-//	±Inf.ILogB() = [math.MaxInt], false
-//	0.ILogB() = [math.MinInt], false
+//	NaN.ILogB() = [math.MaxInt32], false
+//	±Inf.ILogB() = [math.MaxInt32], false
+//	0.ILogB() = [math.MinInt32], false
 //
 // N.B.: This returns MaxInt32 and MinInt32 regardless of the size of int.
 func (x Float16WithRound[RND]) ILogB() (int, bool) {
 	return ilogb[binary16](x.bits)
 }
+
+// ScaleB returns x × 2**n, rounded if the result is subnormal.
+//
+// Special cases are:
+//
+//	±0.ScaleB(n) = ±0
+//	±Inf.ScaleB(n) = ±Inf
+//	NaN.ScaleB(n) = NaN
+//
+// Very large results overflow to ±Inf, or ±MaxFloat, according to the rounding mode.
+func (x Float16WithRound[RND]) ScaleB(n int) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{ldexp[binary16](x.bits, n, rnd)}
+}
+
+// FrExp breaks x into a normalized fraction and an integral power of two.
+// It returns frac and exp satisfying x == frac × 2**exp,
+// with the absolute value of frac in the interval [½, 1).
+//
+// Special cases are:
+//
+//	±0.FrExp() = ±0, 0
+//	±Inf.FrExp() = ±Inf, 0
+//	NaN.FrExp() = NaN, 0
+func (x Float16WithRound[RND]) FrExp() (frac Float16WithRound[RND], exp int) {
+	f, exp := frexp[binary16](x.bits)
+	return Float16WithRound[RND]{f}, exp
+}
+
+// LdExp is the inverse of [FrExp].
+// It returns x × 2**exp, and is the same as [ScaleB].
+func (x Float16WithRound[RND]) LdExp(exp int) Float16WithRound[RND] {
+	return x.ScaleB(exp)
+}
+
+// The following unexported methods provide the reductions needed by Sum and Dot.
+
+func (x Float16WithRound[RND]) sum(xs []Float16WithRound[RND], alg SumAlgorithm) Float16WithRound[RND] {
+	return Float16Sum(xs, alg)
+}
+
+func (x Float16WithRound[RND]) dot(xs, ys []Float16WithRound[RND], alg SumAlgorithm) Float16WithRound[RND] {
+	return Float16Dot(xs, ys, alg)
+}
+
+// The following unexported methods provide the elementary functions needed by Complex.
+
+func (x Float16WithRound[RND]) fromFloat64(v float64) Float16WithRound[RND] {
+	return Float16WithRoundFromFloat[RND](v)
+}
+
+func (x Float16WithRound[RND]) ldexp(exp int) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{ldexp[binary16](x.bits, exp, rnd)}
+}
+
+func (x Float16WithRound[RND]) logHypot(y Float16WithRound[RND]) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{logHypot[binary16](x.bits, y.bits, rnd)}
+}
+
+func (x Float16WithRound[RND]) sinCos() (sin, cos Float16WithRound[RND]) {
+	var rnd RND
+
+	s, c := sinCos[binary16](x.bits, rnd)
+	return Float16WithRound[RND]{s}, Float16WithRound[RND]{c}
+}
+
+func (y Float16WithRound[RND]) atan2(x Float16WithRound[RND]) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{atan2[binary16](y.bits, x.bits, rnd)}
+}
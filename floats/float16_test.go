@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"math/rand/v2"
 	"testing"
 )
 
@@ -34,6 +35,7 @@ func TestFloat16NumConstants(t *testing.T) {
 
 	tests := []test{
 		{"zero", "0", 0x0000},
+		{"negative zero", "-0", 0x8000},
 		{"one", "1", 0x3c00},
 		{"e", "2.71828182845904523536028747135266249775724709369995957496696763", E.Float16().bits},
 		{"π", "0x1.921fb54442d18469898cc51701b8p+01", Pi.Float16().bits},
@@ -390,6 +392,154 @@ func testRounding(t *testing.T, in uint16, nearTieAway, nearTieEven, toNeg, toZe
 	}
 }
 
+func TestFloat16OpSticky(t *testing.T) {
+	type test struct {
+		name   string
+		x, y   uint16
+		op     func(x, y Float16) Float16
+		expect uint16
+	}
+
+	tests := []test{
+		// 1 + (2⁻¹¹ + 2⁻²⁰) is above the tie, but only the bits shifted out in alignment say so.
+		{"shr_sticky", 0x3c00, 0x1002, Float16.Add, 0x3c01},
+		// (2 - 2⁻¹⁰) + (2⁻⁹ + 2⁻¹⁵) carries, and only the bit shifted out by the carry breaks the tie.
+		{"add_carry", 0x3fff, 0x1810, Float16.Add, 0x4001},
+		{"mul_to_subnormal", 0x1400, 0x1400, Float16.Mul, 0x0010},
+		// 3 × 2⁻²⁴ × (2¹² - 2) loses its low bits if the subnormal is not normalized first.
+		{"mul_subnormal", 0x0003, 0x6bff, Float16.Mul, 0x11ff},
+		{"div_to_subnormal", 0x0010, 0x4000, Float16.Div, 0x0008},
+		{"div_to_min_subnormal", 0x0400, 0x6400, Float16.Div, 0x0001},
+		{"div_subnormal", 0x0003, 0x0c01, Float16.Div, 0x11ff},
+	}
+
+	for _, tt := range tests {
+		if got := tt.op(Float16{tt.x}, Float16{tt.y}).Bits(); got != tt.expect {
+			t.Errorf("%s: %04x op %04x = %04x, expected %04x", tt.name, tt.x, tt.y, got, tt.expect)
+		}
+	}
+}
+
+func testFloat16DirectedOverflow[RND RoundingMode](pos, neg uint16) func(t *testing.T) {
+	type test struct {
+		name   string
+		x, y   uint16
+		op     func(x, y Float16WithRound[RND]) Float16WithRound[RND]
+		expect uint16
+	}
+
+	tests := []test{
+		{"add", 0x7bff, 0x7bff, Float16WithRound[RND].Add, pos},
+		{"add_neg", 0xfbff, 0xfbff, Float16WithRound[RND].Add, neg},
+		{"mul", 0x6b07, 0x6b07, Float16WithRound[RND].Mul, pos},
+		{"mul_neg", 0xef3a, 0x6b07, Float16WithRound[RND].Mul, neg},
+		{"div", 0x7bff, 0x1400, Float16WithRound[RND].Div, pos},
+		{"div_neg", 0xfbff, 0x1400, Float16WithRound[RND].Div, neg},
+	}
+
+	return func(t *testing.T) {
+		for _, tt := range tests {
+			x, y := Float16WithRoundFromBits[RND](tt.x), Float16WithRoundFromBits[RND](tt.y)
+			if got := tt.op(x, y).Bits(); got != tt.expect {
+				t.Errorf("%s: %04x op %04x = %04x, expected %04x", tt.name, tt.x, tt.y, got, tt.expect)
+			}
+		}
+
+		if got := Float16WithRoundFromFloat[RND](float32(1e6)).Bits(); got != pos {
+			t.Errorf("from 1e6 = %04x, expected %04x", got, pos)
+		}
+		if got := Float16WithRoundFromFloat[RND](float32(-1e6)).Bits(); got != neg {
+			t.Errorf("from -1e6 = %04x, expected %04x", got, neg)
+		}
+	}
+}
+
+// TestFloat16DirectedOverflow checks that an overflow goes to the largest finite value
+// whenever the rounding mode would never round that far.
+func TestFloat16DirectedOverflow(t *testing.T) {
+	t.Run("RoundTiesToEven", testFloat16DirectedOverflow[RoundTiesToEven](0x7c00, 0xfc00))
+	t.Run("RoundTiesToZero", testFloat16DirectedOverflow[RoundTiesToZero](0x7c00, 0xfc00))
+	t.Run("RoundTowardZero", testFloat16DirectedOverflow[RoundTowardZero](0x7bff, 0xfbff))
+	t.Run("RoundTowardPositive", testFloat16DirectedOverflow[RoundTowardPositive](0x7c00, 0xfbff))
+	t.Run("RoundTowardNegative", testFloat16DirectedOverflow[RoundTowardNegative](0x7bff, 0xfc00))
+}
+
+// testFloat16SqrtDirected checks Sqrt of every positive finite value, where above reports whether,
+// of the two values that bracket the true square root, the one above it is expected.
+func testFloat16SqrtDirected[RND RoundingMode](above bool) func(t *testing.T) {
+	return func(t *testing.T) {
+		for u := uint16(1); u < 0x7c00; u++ {
+			x := Float16WithRoundFromBits[RND](u)
+			s := x.Sqrt()
+
+			// Squares of Float16 values are exact in float64.
+			xf, sf := x.Float64().Native(), s.Float64().Native()
+			if sf*sf == xf {
+				continue
+			}
+
+			lo, hi := s, s
+			if above {
+				lo = Float16WithRoundFromBits[RND](nextDown[binary16](s.Bits()))
+			} else {
+				hi = Float16WithRoundFromBits[RND](nextUp[binary16](s.Bits()))
+			}
+
+			l, h := lo.Float64().Native(), hi.Float64().Native()
+			if !(l*l < xf && xf < h*h) {
+				t.Fatalf("Sqrt(%04x) = %04x, expected the other bound of the square root", u, s.Bits())
+			}
+		}
+	}
+}
+
+func TestFloat16SqrtDirected(t *testing.T) {
+	t.Run("RoundTowardZero", testFloat16SqrtDirected[RoundTowardZero](false))
+	t.Run("RoundTowardNegative", testFloat16SqrtDirected[RoundTowardNegative](false))
+	t.Run("RoundTowardPositive", testFloat16SqrtDirected[RoundTowardPositive](true))
+}
+
+func TestFloat16Subnormals(t *testing.T) {
+	if frac, exp := frexp[binary16](0x0001); frac != 0x3800 || exp != -23 {
+		t.Errorf("frexp(%04x) = %04x, %d, expected 3800, -23", 0x0001, frac, exp)
+	}
+
+	if frac, exp := frexp[binary16](0x0300); frac != 0x3a00 || exp != -14 {
+		t.Errorf("frexp(%04x) = %04x, %d, expected 3a00, -14", 0x0300, frac, exp)
+	}
+
+	if got := ldexp[binary16](0x3c00, -20, RoundTiesToEven{}); got != 0x0010 {
+		t.Errorf("ldexp(1, -20) = %04x, expected 0010", got)
+	}
+
+	// 1.5 × 2⁻²⁴ is a tie between the two smallest subnormals.
+	if got := ldexp[binary16](0x3e00, -24, RoundTiesToEven{}); got != 0x0002 {
+		t.Errorf("ldexp(1.5, -24) = %04x, expected 0002", got)
+	}
+
+	if got := Float16FromBits(0x0001).Sqrt().Bits(); got != 0x0c00 {
+		t.Errorf("Sqrt(2⁻²⁴) = %04x, expected 0c00", got)
+	}
+
+	if got := Float16FromBits(0x0004).Sqrt().Bits(); got != 0x1000 {
+		t.Errorf("Sqrt(2⁻²²) = %04x, expected 1000", got)
+	}
+}
+
+func TestFloat16FromBigFloat(t *testing.T) {
+	// 1 + 2⁻¹¹ + 2⁻⁴⁰ is above the tie, but only by bits beyond the width of the mantissa.
+	v := new(big.Float).SetPrec(100).SetMantExp(big.NewFloat(1), -40)
+	v.Add(v, big.NewFloat(1+0x1p-11))
+
+	if got := Float16FromFloat(v).Bits(); got != 0x3c01 {
+		t.Errorf("Float16FromFloat(1 + 2⁻¹¹ + 2⁻⁴⁰) = %04x, expected 3c01", got)
+	}
+
+	if got := Float16FromFloat(big.NewFloat(-1.5)).Bits(); got != 0xbe00 {
+		t.Errorf("Float16FromFloat(-1.5) = %04x, expected be00", got)
+	}
+}
+
 func TestFloat16Rounding(t *testing.T) {
 	type test struct {
 		name string
@@ -619,3 +769,1032 @@ func TestFloat16OpExp(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat16OpMinMax(t *testing.T) {
+	type test struct {
+		name string
+		x, y float32
+
+		min, max, minMag, maxMag                 uint16
+		minimum, maximum, minimumMag, maximumMag uint16
+	}
+
+	negZ := math.Float32frombits(1 << 31)
+	negInf := math.Float32frombits(Inf32(true).Bits())
+	posInf := math.Float32frombits(Inf32(false).Bits())
+	nan := float32(math.NaN())
+
+	tests := []test{
+		{"one and two", 1, 2, 0x3c00, 0x4000, 0x3c00, 0x4000, 0x3c00, 0x4000, 0x3c00, 0x4000},
+		{"two and -one", 2, -1, 0xbc00, 0x4000, 0xbc00, 0x4000, 0xbc00, 0x4000, 0xbc00, 0x4000},
+		{"-one and -two", -1, -2, 0xc000, 0xbc00, 0xbc00, 0xc000, 0xc000, 0xbc00, 0xbc00, 0xc000},
+		{"one and -one", 1, -1, 0xbc00, 0x3c00, 0xbc00, 0x3c00, 0xbc00, 0x3c00, 0xbc00, 0x3c00},
+		{"-one and one", -1, 1, 0xbc00, 0x3c00, 0xbc00, 0x3c00, 0xbc00, 0x3c00, 0xbc00, 0x3c00},
+		{"-one and -two", -1, -2, 0xc000, 0xbc00, 0xbc00, 0xc000, 0xc000, 0xbc00, 0xbc00, 0xc000},
+		{"zero and -zero", 0, negZ, 0x8000, 0x0000, 0x8000, 0x0000, 0x8000, 0x0000, 0x8000, 0x0000},
+		{"-zero and zero", negZ, 0, 0x8000, 0x0000, 0x8000, 0x0000, 0x8000, 0x0000, 0x8000, 0x0000},
+		{"-inf and +inf", negInf, posInf, 0xfc00, 0x7c00, 0xfc00, 0x7c00, 0xfc00, 0x7c00, 0xfc00, 0x7c00},
+		{"-inf and one", negInf, 1, 0xfc00, 0x3c00, 0x3c00, 0xfc00, 0xfc00, 0x3c00, 0x3c00, 0xfc00},
+		{"one and nan", 1, nan, 0x3c00, 0x3c00, 0x3c00, 0x3c00, 0x7e00, 0x7e00, 0x7e00, 0x7e00},
+		{"nan and -one", nan, -1, 0xbc00, 0xbc00, 0xbc00, 0xbc00, 0x7e00, 0x7e00, 0x7e00, 0x7e00},
+		{"nan and nan", nan, nan, 0x7e00, 0x7e00, 0x7e00, 0x7e00, 0x7e00, 0x7e00, 0x7e00, 0x7e00},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			f, g := Float16FromFloat(tt.x), Float16FromFloat(tt.y)
+
+			ops := []struct {
+				name   string
+				fn     func(Float16, Float16) Float16
+				expect uint16
+			}{
+				{"Min", Float16.Min, tt.min},
+				{"Max", Float16.Max, tt.max},
+				{"MinMag", Float16.MinMag, tt.minMag},
+				{"MaxMag", Float16.MaxMag, tt.maxMag},
+				{"Minimum", Float16.Minimum, tt.minimum},
+				{"Maximum", Float16.Maximum, tt.maximum},
+				{"MinimumMag", Float16.MinimumMag, tt.minimumMag},
+				{"MaximumMag", Float16.MaximumMag, tt.maximumMag},
+			}
+
+			for _, op := range ops {
+				if bits := op.fn(f, g).Bits(); bits != op.expect {
+					t.Errorf("Float16(%x).%s(Float16(%x)) = %04x, but expected %04x", tt.x, op.name, tt.y, bits, op.expect)
+				}
+			}
+		})
+	}
+}
+
+func TestFloat16OpAugmented(t *testing.T) {
+	type test struct {
+		name string
+		op   func(Float16, Float16) (Float16, Float16)
+		x, y uint16
+
+		r, err uint16
+	}
+
+	tests := []test{
+		{"one plus zero", Float16.AugmentedAdd, 0x3c00, 0x0000, 0x3c00, 0x0000},
+		{"one plus -one", Float16.AugmentedAdd, 0x3c00, 0xbc00, 0x0000, 0x0000},
+		{"one plus half ulp", Float16.AugmentedAdd, 0x3c00, 0x1000, 0x3c00, 0x1000},
+		{"odd plus half ulp", Float16.AugmentedAdd, 0x3c01, 0x1000, 0x3c01, 0x1000},
+		{"-odd plus -half ulp", Float16.AugmentedAdd, 0xbc01, 0x9000, 0xbc01, 0x9000},
+		{"max plus half ulp", Float16.AugmentedAdd, 0x7bff, 0x4c00, 0x7bff, 0x4c00},
+		{"max plus max", Float16.AugmentedAdd, 0x7bff, 0x7bff, 0x7c00, 0x7c00},
+		{"inf plus one", Float16.AugmentedAdd, 0x7c00, 0x3c00, 0x7c00, 0x7c00},
+		{"inf plus -inf", Float16.AugmentedAdd, 0x7c00, 0xfc00, 0x7e00, 0x7e00},
+		{"-one minus -one", Float16.AugmentedSub, 0xbc00, 0xbc00, 0x0000, 0x0000},
+		{"one minus -half ulp", Float16.AugmentedSub, 0x3c00, 0x9000, 0x3c00, 0x1000},
+		{"odd times odd", Float16.AugmentedMul, 0x3c01, 0x3c01, 0x3c02, 0x0010},
+		{"odd times one and a half", Float16.AugmentedMul, 0x3c01, 0x3e00, 0x3e01, 0x1000},
+		{"-odd times one and a half", Float16.AugmentedMul, 0xbc01, 0x3e00, 0xbe01, 0x9000},
+		{"-one times zero", Float16.AugmentedMul, 0xbc00, 0x0000, 0x8000, 0x8000},
+		{"inf times zero", Float16.AugmentedMul, 0x7c00, 0x0000, 0x7e00, 0x7e00},
+		{"max times two", Float16.AugmentedMul, 0x7bff, 0x4000, 0x7c00, 0x7c00},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			f, g := Float16FromBits(tt.x), Float16FromBits(tt.y)
+
+			r, err := tt.op(f, g)
+			if r.Bits() != tt.r || err.Bits() != tt.err {
+				t.Errorf("%s(%04x, %04x) = %04x, %04x, but expected %04x, %04x", tt.name, tt.x, tt.y, r.Bits(), err.Bits(), tt.r, tt.err)
+			}
+		})
+	}
+}
+
+func TestFloat16Sum(t *testing.T) {
+	type test struct {
+		name string
+		op   func(xs, ys []Float16, alg SumAlgorithm) Float16
+		alg  SumAlgorithm
+		xs   []uint16
+		ys   []uint16
+
+		expect uint16
+	}
+
+	sum := func(xs, _ []Float16, alg SumAlgorithm) Float16 { return Float16Sum(xs, alg) }
+	norm2 := func(xs, _ []Float16, alg SumAlgorithm) Float16 { return Float16Norm2(xs, alg) }
+
+	tests := []test{
+		{"empty sum", sum, SumNaive, nil, nil, 0x0000},
+		{"naive sum of ties", sum, SumNaive, []uint16{0x6800, 0x3c00, 0x3c00}, nil, 0x6800},
+		{"pairwise sum of ties", sum, SumPairwise, []uint16{0x6800, 0x3c00, 0x3c00}, nil, 0x6800},
+		{"neumaier sum of ties", sum, SumNeumaier, []uint16{0x6800, 0x3c00, 0x3c00}, nil, 0x6801},
+		{"exact sum of ties", sum, SumExact, []uint16{0x6800, 0x3c00, 0x3c00}, nil, 0x6801},
+		{"exact sum of cancellation", sum, SumExact, []uint16{0x7bff, 0x3c00, 0xfbff}, nil, 0x3c00},
+		{"exact sum of -0", sum, SumExact, []uint16{0x8000, 0x8000}, nil, 0x8000},
+		{"exact sum of ±0", sum, SumExact, []uint16{0x8000, 0x0000}, nil, 0x0000},
+		{"exact sum to zero", sum, SumExact, []uint16{0xbc00, 0x3c00}, nil, 0x0000},
+		{"exact dot of -0", Float16Dot, SumExact, []uint16{0x8000, 0x3c00}, []uint16{0x3c00, 0x8000}, 0x8000},
+		{"sum of inf", sum, SumExact, []uint16{0x7c00, 0x3c00}, nil, 0x7c00},
+		{"sum of inf and -inf", sum, SumNeumaier, []uint16{0x7c00, 0xfc00}, nil, 0x7e00},
+		{"naive dot of product error", Float16Dot, SumNaive, []uint16{0x3c01, 0xbc02}, []uint16{0x3c01, 0x3c00}, 0x0000},
+		{"neumaier dot of product error", Float16Dot, SumNeumaier, []uint16{0x3c01, 0xbc02}, []uint16{0x3c01, 0x3c00}, 0x0010},
+		{"exact dot of product error", Float16Dot, SumExact, []uint16{0x3c01, 0xbc02}, []uint16{0x3c01, 0x3c00}, 0x0010},
+		{"empty norm", norm2, SumNaive, nil, nil, 0x0000},
+		{"norm of three four", norm2, SumNaive, []uint16{0x4200, 0xc400}, nil, 0x4500},
+		{"norm without overflow", norm2, SumNaive, []uint16{0x7800, 0x7800}, nil, 0x79a8},
+		{"exact norm without overflow", norm2, SumExact, []uint16{0x7800, 0x7800}, nil, 0x79a8},
+		{"norm of nan and inf", norm2, SumNeumaier, []uint16{0x7e00, 0xfc00}, nil, 0x7c00},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			xs := make([]Float16, len(tt.xs))
+			for i, x := range tt.xs {
+				xs[i] = Float16FromBits(x)
+			}
+
+			ys := make([]Float16, len(tt.ys))
+			for i, y := range tt.ys {
+				ys[i] = Float16FromBits(y)
+			}
+
+			got := tt.op(xs, ys, tt.alg)
+			if got.Bits() != tt.expect {
+				t.Errorf("%s(%04x, %04x) = %04x, but expected %04x", tt.name, tt.xs, tt.ys, got.Bits(), tt.expect)
+			}
+		})
+	}
+}
+
+func testFloat16SumDirected[RND RoundingMode](t *testing.T) {
+	t.Helper()
+
+	var rnd RND
+
+	rng := rand.New(rand.NewPCG(16, 3))
+
+	for n := 0; n < 100; n++ {
+		xs := make([]Float16WithRound[RND], 16)
+		ys := make([]Float16WithRound[RND], 16)
+		for i := range xs {
+			xs[i] = Float16WithRoundFromBits[RND](uint16(rng.IntN(0x7800)) | uint16(rng.IntN(2))<<15)
+			ys[i] = Float16WithRoundFromBits[RND](uint16(rng.IntN(0x4800)))
+		}
+
+		// The compensation is not exact under directed rounding, so the sum must be exact instead.
+		if got, expect := Float16Sum(xs, SumNeumaier), Float16Sum(xs, SumExact); got.Bits() != expect.Bits() {
+			t.Fatalf("%T: Float16Sum(%04x, SumNeumaier) = %04x, expected %04x", rnd, xs, got.Bits(), expect.Bits())
+		}
+
+		if got, expect := Float16Dot(xs, ys, SumNeumaier), Float16Dot(xs, ys, SumExact); got.Bits() != expect.Bits() {
+			t.Fatalf("%T: Float16Dot(%04x, %04x, SumNeumaier) = %04x, expected %04x", rnd, xs, ys, got.Bits(), expect.Bits())
+		}
+	}
+}
+
+func TestFloat16SumDirected(t *testing.T) {
+	testFloat16SumDirected[RoundTowardZero](t)
+	testFloat16SumDirected[RoundTowardPositive](t)
+	testFloat16SumDirected[RoundTowardNegative](t)
+
+	// A sum that cancels exactly is -0 when rounding toward negative, as x + -x is.
+	xs := []Float16WithRound[RoundTowardNegative]{Float16WithRoundFromBits[RoundTowardNegative](0x3c00), Float16WithRoundFromBits[RoundTowardNegative](0xbc00)}
+	if got, expect := Float16Sum(xs, SumExact), xs[0].Add(xs[1]); got.Bits() != expect.Bits() {
+		t.Errorf("Float16Sum(%04x, SumExact) = %04x, expected %04x", xs, got.Bits(), expect.Bits())
+	}
+}
+
+func TestFloat16SumUnknown(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Float16Sum with an unknown SumAlgorithm did not panic")
+		}
+	}()
+
+	Float16Sum([]Float16{Float16FromBits(0x3c00)}, SumAlgorithm(-1))
+}
+
+// bulkValues16 returns float32 values around every binary16 value, and the midpoints between them.
+func bulkValues16() []float32 {
+	var xs []float32
+
+	for h := uint16(0); h <= 0x7c00; h++ {
+		u := math.Float32bits(Float16FromBits(h).Float32().Native())
+
+		for _, v := range []uint32{u - 1, u, u + 1, u + 1<<12 - 1, u + 1<<12, u + 1<<12 + 1} {
+			xs = append(xs, math.Float32frombits(v), math.Float32frombits(v|1<<31))
+		}
+	}
+
+	return append(xs, float32(math.NaN()), float32(math.Inf(1)), float32(math.Inf(-1)), 1e-40, 1e10)
+}
+
+func testEncodeFloat16s[RND RoundingMode](t *testing.T, xs []float32) {
+	t.Helper()
+
+	dst := make([]uint16, len(xs))
+
+	if n := EncodeFloat16sWithRound[RND](dst, xs); n != len(xs) {
+		t.Fatalf("EncodeFloat16sWithRound[%T](float32) converted %d values, but expected %d", *new(RND), n, len(xs))
+	}
+
+	for i, x := range xs {
+		if expect := Float16WithRoundFromFloat[RND](x).Bits(); dst[i] != expect {
+			t.Errorf("EncodeFloat16sWithRound[%T](%08x) = %04x, but expected %04x", *new(RND), math.Float32bits(x), dst[i], expect)
+		}
+	}
+
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		// Nudge the values, so that they are not exactly representable as float32.
+		ys[i] = math.Nextafter(float64(x), float64(i%3-1))
+	}
+
+	EncodeFloat16sWithRound[RND](dst, ys)
+
+	for i, y := range ys {
+		if expect := Float16WithRoundFromFloat[RND](y).Bits(); dst[i] != expect {
+			t.Errorf("EncodeFloat16sWithRound[%T](%016x) = %04x, but expected %04x", *new(RND), math.Float64bits(y), dst[i], expect)
+		}
+	}
+}
+
+func TestFloat16Bulk(t *testing.T) {
+	xs := bulkValues16()
+
+	testEncodeFloat16s[RoundTiesToEven](t, xs)
+	testEncodeFloat16s[RoundTiesToAway](t, xs)
+	testEncodeFloat16s[RoundTiesToOdd](t, xs)
+	testEncodeFloat16s[RoundTiesToZero](t, xs)
+	testEncodeFloat16s[RoundTowardZero](t, xs)
+	testEncodeFloat16s[RoundTowardPositive](t, xs)
+	testEncodeFloat16s[RoundTowardNegative](t, xs)
+
+	src := make([]uint16, 1<<16)
+	for i := range src {
+		src[i] = uint16(i)
+	}
+
+	f32s := make([]float32, len(src))
+	f64s := make([]float64, len(src)-1)
+
+	DecodeFloat16s(f32s, src)
+	if n := DecodeFloat16s(f64s, src); n != len(f64s) {
+		t.Fatalf("DecodeFloat16s(float64) converted %d values, but expected %d", n, len(f64s))
+	}
+
+	for i, h := range src[:len(f64s)] {
+		f := Float16FromBits(h)
+
+		if got, expect := math.Float32bits(f32s[i]), f.Float32().Bits(); got != expect {
+			t.Errorf("DecodeFloat16s(%04x) = %08x, but expected %08x", h, got, expect)
+		}
+
+		if got, expect := math.Float64bits(f64s[i]), f.Float64().Bits(); got != expect {
+			t.Errorf("DecodeFloat16s(%04x) = %016x, but expected %016x", h, got, expect)
+		}
+	}
+}
+
+func benchmarkValues(n int) []float32 {
+	xs := make([]float32, n)
+	for i := range xs {
+		xs[i] = float32(math.Sin(float64(i))) * float32(i%1000)
+	}
+	return xs
+}
+
+func BenchmarkFloat16FromFloat(b *testing.B) {
+	xs := benchmarkValues(1 << 12)
+	dst := make([]uint16, len(xs))
+
+	b.SetBytes(int64(len(xs) * 4))
+	for i := 0; i < b.N; i++ {
+		for j, x := range xs {
+			dst[j] = Float16FromFloat(x).Bits()
+		}
+	}
+}
+
+func BenchmarkEncodeFloat16s(b *testing.B) {
+	xs := benchmarkValues(1 << 12)
+	dst := make([]uint16, len(xs))
+
+	b.SetBytes(int64(len(xs) * 4))
+	for i := 0; i < b.N; i++ {
+		EncodeFloat16s(dst, xs)
+	}
+}
+
+func BenchmarkEncodeFloat16sTowardZero(b *testing.B) {
+	xs := benchmarkValues(1 << 12)
+	dst := make([]uint16, len(xs))
+
+	b.SetBytes(int64(len(xs) * 4))
+	for i := 0; i < b.N; i++ {
+		EncodeFloat16sWithRound[RoundTowardZero](dst, xs)
+	}
+}
+
+func BenchmarkFloat16Float32(b *testing.B) {
+	src := make([]uint16, 1<<12)
+	EncodeFloat16s(src, benchmarkValues(len(src)))
+	dst := make([]float32, len(src))
+
+	b.SetBytes(int64(len(src) * 2))
+	for i := 0; i < b.N; i++ {
+		for j, h := range src {
+			dst[j] = Float16FromBits(h).Float32().Native()
+		}
+	}
+}
+
+func BenchmarkDecodeFloat16s(b *testing.B) {
+	src := make([]uint16, 1<<12)
+	EncodeFloat16s(src, benchmarkValues(len(src)))
+	dst := make([]float32, len(src))
+
+	b.SetBytes(int64(len(src) * 2))
+	for i := 0; i < b.N; i++ {
+		DecodeFloat16s(dst, src)
+	}
+}
+
+func TestFloat16Class(t *testing.T) {
+	type test struct {
+		name string
+		x    uint16
+
+		expect    Class
+		finite    bool
+		normal    bool
+		subnormal bool
+	}
+
+	tests := []test{
+		{"snan", 0x7d00, SignalingNaN, false, false, false},
+		{"qnan", 0xfe00, QuietNaN, false, false, false},
+		{"-inf", 0xfc00, NegativeInfinity, false, false, false},
+		{"-max", 0xfbff, NegativeNormal, true, true, false},
+		{"-min normal", 0x8400, NegativeNormal, true, true, false},
+		{"-max subnormal", 0x83ff, NegativeSubnormal, true, false, true},
+		{"-0", 0x8000, NegativeZero, true, false, false},
+		{"+0", 0x0000, PositiveZero, true, false, false},
+		{"+min subnormal", 0x0001, PositiveSubnormal, true, false, true},
+		{"+one", 0x3c00, PositiveNormal, true, true, false},
+		{"+inf", 0x7c00, PositiveInfinity, false, false, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			x := Float16FromBits(tt.x)
+
+			if got := x.Class(); got != tt.expect {
+				t.Errorf("Float16(%04x).Class() = %v, but expected %v", tt.x, got, tt.expect)
+			}
+
+			if got := x.IsSignaling(); got != (tt.expect == SignalingNaN) {
+				t.Errorf("Float16(%04x).IsSignaling() = %t", tt.x, got)
+			}
+
+			if got := x.IsZero(); got != (tt.expect == NegativeZero || tt.expect == PositiveZero) {
+				t.Errorf("Float16(%04x).IsZero() = %t", tt.x, got)
+			}
+
+			if got := x.IsFinite(); got != tt.finite {
+				t.Errorf("Float16(%04x).IsFinite() = %t, but expected %t", tt.x, got, tt.finite)
+			}
+
+			if got := x.IsNormal(); got != tt.normal {
+				t.Errorf("Float16(%04x).IsNormal() = %t, but expected %t", tt.x, got, tt.normal)
+			}
+
+			if got := x.IsSubnormal(); got != tt.subnormal {
+				t.Errorf("Float16(%04x).IsSubnormal() = %t, but expected %t", tt.x, got, tt.subnormal)
+			}
+		})
+	}
+}
+
+func TestFloat16OpRemainder(t *testing.T) {
+	ys := []uint16{0x0003, 0x0401, 0x3c00, 0xbe00, 0x4248, 0x7bff, 0x7c00, 0x0000}
+
+	for _, yb := range ys {
+		y := Float16FromBits(yb)
+
+		for i := 0; i <= 0xffff; i++ {
+			x := Float16FromBits(uint16(i))
+
+			xf, yf := x.Float64().Native(), y.Float64().Native()
+
+			if got, expect := x.Remainder(y).Float64().Native(), math.Remainder(xf, yf); math.Float64bits(got) != math.Float64bits(expect) && !(math.IsNaN(got) && math.IsNaN(expect)) {
+				t.Fatalf("Float16(%04x).Remainder(Float16(%04x)) = %v, but expected %v", i, yb, got, expect)
+			}
+
+			if got, expect := x.Mod(y).Float64().Native(), math.Mod(xf, yf); math.Float64bits(got) != math.Float64bits(expect) && !(math.IsNaN(got) && math.IsNaN(expect)) {
+				t.Fatalf("Float16(%04x).Mod(Float16(%04x)) = %v, but expected %v", i, yb, got, expect)
+			}
+		}
+	}
+}
+
+func TestFloat16OpULP(t *testing.T) {
+	type test struct {
+		name string
+		x, y uint16
+
+		nextAfter, ulp uint16
+	}
+
+	tests := []test{
+		{"one towards two", 0x3c00, 0x4000, 0x3c01, 0x1400},
+		{"one towards zero", 0x3c00, 0x0000, 0x3bff, 0x1400},
+		{"-one towards -inf", 0xbc00, 0xfc00, 0xbc01, 0x1400},
+		{"zero towards -zero", 0x0000, 0x8000, 0x8000, 0x0001},
+		{"zero towards one", 0x0000, 0x3c00, 0x0001, 0x0001},
+		{"subnormal towards itself", 0x0234, 0x0234, 0x0234, 0x0001},
+		{"max towards inf", 0x7bff, 0x7c00, 0x7c00, 0x5000},
+		{"inf towards zero", 0x7c00, 0x0000, 0x7bff, 0x7c00},
+		{"-inf towards nan", 0xfc00, 0x7e00, 0x7e00, 0x7c00},
+		{"nan towards one", 0x7e00, 0x3c00, 0x7e00, 0x7e00},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			x, y := Float16FromBits(tt.x), Float16FromBits(tt.y)
+
+			if got := x.NextAfter(y).Bits(); got != tt.nextAfter {
+				t.Errorf("Float16(%04x).NextAfter(Float16(%04x)) = %04x, but expected %04x", tt.x, tt.y, got, tt.nextAfter)
+			}
+
+			if got := x.ULP().Bits(); got != tt.ulp {
+				t.Errorf("Float16(%04x).ULP() = %04x, but expected %04x", tt.x, got, tt.ulp)
+			}
+		})
+	}
+
+	for i := 0; i <= 0xffff; i++ {
+		x := Float16FromBits(uint16(i))
+		if !x.IsFinite() {
+			continue
+		}
+
+		frac, exp := x.FrExp()
+		if got := frac.LdExp(exp); got.Bits() != x.Bits() {
+			t.Fatalf("Float16(%04x).FrExp() = %04x, %d, which LdExp returns as %04x", i, frac.Bits(), exp, got.Bits())
+		}
+
+		if xf, expect := x.Float64().Native(), Float16FromFloat(math.Ldexp(x.Float64().Native(), -3)); x.ScaleB(-3).Bits() != expect.Bits() {
+			t.Fatalf("Float16(%04x).ScaleB(-3) = %04x, but expected %04x from %v", i, x.ScaleB(-3).Bits(), expect.Bits(), xf)
+		}
+	}
+
+	// The most extreme exponents must not overflow int along the way, and turn the result around.
+	extremes := []struct {
+		name   string
+		got    Float16
+		expect uint16
+	}{
+		{"ScaleB(min, MinInt)", Float16FromBits(0x0001).ScaleB(math.MinInt), 0x0000},
+		{"ScaleB(-max, MinInt)", Float16FromBits(0xfbff).ScaleB(math.MinInt), 0x8000},
+		{"ScaleB(2, MaxInt)", Float16FromFloat(2.0).ScaleB(math.MaxInt), 0x7c00},
+		{"ScaleB(-min, MaxInt)", Float16FromBits(0x8001).ScaleB(math.MaxInt), 0xfc00},
+		{"LdExp(2, MaxInt)", Float16FromFloat(2.0).LdExp(math.MaxInt), 0x7c00},
+		{"LdExp(2, MinInt)", Float16FromFloat(2.0).LdExp(math.MinInt), 0x0000},
+	}
+
+	for _, tt := range extremes {
+		if got := tt.got.Bits(); got != tt.expect {
+			t.Errorf("%s = %04x, but expected %04x", tt.name, got, tt.expect)
+		}
+	}
+}
+
+func TestFloat16OpPow(t *testing.T) {
+	type test struct {
+		name string
+		x, y uint16
+
+		nearTieEven, toZero, toPos uint16
+	}
+
+	tests := []test{
+		{"two to the ten", 0x4000, 0x4900, 0x6400, 0x6400, 0x6400},
+		{"two to the sixteen", 0x4000, 0x4c00, 0x7c00, 0x7bff, 0x7c00},
+		{"two to the -25", 0x4000, 0xce40, 0x0000, 0x0000, 0x0001},
+		{"sixteen to the quarter", 0x4c00, 0x3400, 0x4000, 0x4000, 0x4000},
+		{"square root of two", 0x4000, 0x3800, 0x3da8, 0x3da8, 0x3da9},
+		{"hundred to the three quarters", 0x5640, 0x3a00, 0x4fe8, 0x4fe7, 0x4fe8},
+		{"tie rounds to even", 0x1948, 0x3e00, 0x084a, 0x084a, 0x084b},
+		{"max squared", 0x7bff, 0x4000, 0x7c00, 0x7bff, 0x7c00},
+		{"-one to the inf", 0xbc00, 0x7c00, 0x3c00, 0x3c00, 0x3c00},
+		{"-two to the half", 0xc000, 0x3800, 0x7e00, 0x7e00, 0x7e00},
+		{"-zero to the -three", 0x8000, 0xc200, 0xfc00, 0xfc00, 0xfc00},
+		{"-inf to the three", 0xfc00, 0x4200, 0xfc00, 0xfc00, 0xfc00},
+		{"nan to the zero", 0x7e00, 0x0000, 0x3c00, 0x3c00, 0x3c00},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			x, y := Float16FromBits(tt.x), Float16FromBits(tt.y)
+
+			if got := x.Pow(y).Bits(); got != tt.nearTieEven {
+				t.Errorf("Float16(%04x).Pow(Float16(%04x)) = %04x, but expected %04x", tt.x, tt.y, got, tt.nearTieEven)
+			}
+
+			type Z = Float16WithRound[RoundTowardZero]
+			if got := Z(x).Pow(Z(y)).Bits(); got != tt.toZero {
+				t.Errorf("Float16(%04x).Pow(Float16(%04x)) toward zero = %04x, but expected %04x", tt.x, tt.y, got, tt.toZero)
+			}
+
+			type P = Float16WithRound[RoundTowardPositive]
+			if got := P(x).Pow(P(y)).Bits(); got != tt.toPos {
+				t.Errorf("Float16(%04x).Pow(Float16(%04x)) toward positive = %04x, but expected %04x", tt.x, tt.y, got, tt.toPos)
+			}
+		})
+	}
+
+	roots := []struct {
+		x uint16
+		n int
+
+		expect uint16
+	}{
+		{0xc800, 3, 0xc000},
+		{0x4ec0, 3, 0x4200},
+		{0x4000, 3, 0x3d0a},
+		{0x4c00, 4, 0x4000},
+		{0xcc00, 4, 0x7e00},
+		{0x4c00, -4, 0x3800},
+		{0x8000, -3, 0xfc00},
+		{0x8000, 2, 0x0000},
+		{0x7c00, -2, 0x0000},
+	}
+
+	for _, tt := range roots {
+		if got := Float16FromBits(tt.x).Rootn(tt.n).Bits(); got != tt.expect {
+			t.Errorf("Float16(%04x).Rootn(%d) = %04x, but expected %04x", tt.x, tt.n, got, tt.expect)
+		}
+	}
+
+	for i := 0; i <= 0xffff; i++ {
+		x := Float16FromBits(uint16(i))
+
+		if got, expect := x.Cbrt().Float64().Native(), Float16FromFloat(math.Cbrt(x.Float64().Native())).Float64().Native(); math.Float64bits(got) != math.Float64bits(expect) && !(math.IsNaN(got) && math.IsNaN(expect)) {
+			t.Fatalf("Float16(%04x).Cbrt() = %v, but expected %v", i, got, expect)
+		}
+
+		if got, expect := x.Pown(3).Float64().Native(), Float16FromFloat(math.Pow(x.Float64().Native(), 3)).Float64().Native(); math.Float64bits(got) != math.Float64bits(expect) && !(math.IsNaN(got) && math.IsNaN(expect)) {
+			t.Fatalf("Float16(%04x).Pown(3) = %v, but expected %v", i, got, expect)
+		}
+	}
+
+	ints := []struct {
+		name string
+		x    uint16
+		n    int
+
+		pown, compound uint16
+	}{
+		{"zero", 0x7e00, 0, 0x3c00, 0x3c00},
+		{"-two cubed", 0xc000, 3, 0xc800, 0x7e00},
+		{"-two to the -three", 0xc000, -3, 0xb000, 0x7e00},
+		{"zero to the -one", 0x0000, -1, 0x7c00, 0x3c00},
+		{"-one to the -one", 0xbc00, -1, 0xbc00, 0x7c00},
+		{"half squared", 0x3800, 2, 0x3400, 0x4080},
+		{"small to the 1024", 0x0c00, 1024, 0x0000, 0x3d23},
+		{"-half to the -one", 0xb800, -1, 0xc000, 0x4000},
+	}
+
+	for _, tt := range ints {
+		x := Float16FromBits(tt.x)
+
+		if got := x.Pown(tt.n).Bits(); got != tt.pown {
+			t.Errorf("%s: Float16(%04x).Pown(%d) = %04x, but expected %04x", tt.name, tt.x, tt.n, got, tt.pown)
+		}
+
+		if got := x.Compound(tt.n).Bits(); got != tt.compound {
+			t.Errorf("%s: Float16(%04x).Compound(%d) = %04x, but expected %04x", tt.name, tt.x, tt.n, got, tt.compound)
+		}
+	}
+
+	if got := Float16FromBits(0xc000).Powr(Float16FromBits(0x4000)); !got.IsNaN() {
+		t.Errorf("Float16(-2).Powr(2) = %v, but expected NaN", got)
+	}
+
+	if got := Float16FromBits(0x3c00).Powr(Float16FromBits(0x7c00)); !got.IsNaN() {
+		t.Errorf("Float16(1).Powr(+Inf) = %v, but expected NaN", got)
+	}
+}
+
+func TestFloat16OpExpM1(t *testing.T) {
+	type fn struct {
+		name string
+		op   func(Float16) Float16
+		ref  func(float64) float64
+	}
+
+	fns := []fn{
+		{"ExpM1", Float16.ExpM1, math.Expm1},
+		{"Exp10", Float16.Exp10, func(x float64) float64 { return math.Pow(10, x) }},
+		{"Exp10M1", Float16.Exp10M1, func(x float64) float64 { return math.Expm1(x * math.Ln10) }},
+		{"Log1p", Float16.Log1p, math.Log1p},
+		{"Log2p1", Float16.Log2p1, func(x float64) float64 { return math.Log1p(x) / math.Ln2 }},
+		{"Log10p1", Float16.Log10p1, func(x float64) float64 { return math.Log1p(x) / math.Ln10 }},
+	}
+
+	for _, fn := range fns {
+		for i := 0; i <= 0xffff; i++ {
+			x := Float16FromBits(uint16(i))
+
+			if got, expect := fn.op(x).Float64().Native(), Float16FromFloat(fn.ref(x.Float64().Native())).Float64().Native(); math.Float64bits(got) != math.Float64bits(expect) && !(math.IsNaN(got) && math.IsNaN(expect)) {
+				t.Fatalf("Float16(%04x).%s() = %v, but expected %v", i, fn.name, got, expect)
+			}
+		}
+	}
+
+	type test struct {
+		name string
+		x    uint16
+
+		nearTieEven, toZero, toPos uint16
+	}
+
+	// Exact results, and exact ties, need to be rounded exactly.
+	tests := []test{
+		{"2^12 - 1 is a tie", 0x4a00, 0x6c00, 0x6bff, 0x6c00},
+		{"2^-2 - 1", 0xc000, 0xba00, 0xba00, 0xba00},
+		{"2^-30 - 1", 0xcf80, 0xbc00, 0xbbff, 0xbbff},
+		{"2^-20 - 1", 0xcd00, 0xbc00, 0xbbff, 0xbbff},
+		{"2^16 - 1", 0x4c00, 0x7c00, 0x7bff, 0x7c00},
+		{"2^-2^-20 - 1", 0x8010, 0x800b, 0x800b, 0x800b},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			x := Float16FromBits(tt.x)
+
+			if got := x.Exp2M1().Bits(); got != tt.nearTieEven {
+				t.Errorf("Float16(%04x).Exp2M1() = %04x, but expected %04x", tt.x, got, tt.nearTieEven)
+			}
+
+			type Z = Float16WithRound[RoundTowardZero]
+			if got := Z(x).Exp2M1().Bits(); got != tt.toZero {
+				t.Errorf("Float16(%04x).Exp2M1() toward zero = %04x, but expected %04x", tt.x, got, tt.toZero)
+			}
+
+			type P = Float16WithRound[RoundTowardPositive]
+			if got := P(x).Exp2M1().Bits(); got != tt.toPos {
+				t.Errorf("Float16(%04x).Exp2M1() toward positive = %04x, but expected %04x", tt.x, got, tt.toPos)
+			}
+		})
+	}
+}
+
+func TestFloat16OpSpecial(t *testing.T) {
+	type fn struct {
+		name   string
+		op     func(Float16) Float16
+		ref    func(float64) float64
+		stride int
+	}
+
+	fns := []fn{
+		{"Erf", Float16.Erf, math.Erf, 1},
+		{"Erfc", Float16.Erfc, math.Erfc, 1},
+		{"Gamma", Float16.Gamma, math.Gamma, 7},
+		{"LnGamma", func(x Float16) Float16 { l, _ := x.LnGamma(); return l }, func(x float64) float64 { l, _ := math.Lgamma(x); return l }, 7},
+		{"J0", Float16.J0, math.J0, 1},
+		{"J1", Float16.J1, math.J1, 1},
+		{"Y0", Float16.Y0, math.Y0, 1},
+		{"Y1", Float16.Y1, math.Y1, 1},
+	}
+
+	for _, fn := range fns {
+		for i := 0; i <= 0xffff; i += fn.stride {
+			x := Float16FromBits(uint16(i))
+
+			if fn.name == "J1" && i == 0x8000 {
+				// J1 is odd, and so J1(-0) = -0, while math.J1(-0) = +0.
+				continue
+			}
+
+			got, expect := fn.op(x).Float64().Native(), Float16FromFloat(fn.ref(x.Float64().Native())).Float64().Native()
+
+			if math.Float64bits(got) != math.Float64bits(expect) && !(math.IsNaN(got) && math.IsNaN(expect)) {
+				t.Fatalf("Float16(%04x).%s() = %v, but expected %v", i, fn.name, got, expect)
+			}
+		}
+	}
+
+	for i := 0; i <= 0xffff; i += 7 {
+		x := Float16FromBits(uint16(i))
+
+		_, sign := x.LnGamma()
+		if _, expect := math.Lgamma(x.Float64().Native()); sign != expect {
+			t.Fatalf("Float16(%04x).LnGamma() has sign %d, but expected %d", i, sign, expect)
+		}
+	}
+
+	if got := Float16FromBits(0x8000).J1().Bits(); got != 0x8000 {
+		t.Errorf("Float16(-0).J1() = %04x, but expected 8000", got)
+	}
+}
+
+// exactFMA16 returns x*y+a computed exactly, and rounded once to a Float16, where a nil a adds nothing.
+func exactFMA16[RND RoundingMode](x, y Float16WithRound[RND], a *Float16WithRound[RND]) Float16WithRound[RND] {
+	p := x.Float64().Native() * y.Float64().Native() // exact
+	if a == nil {
+		return Float16WithRoundFromFloat[RND](p)
+	}
+
+	af := a.Float64().Native()
+
+	if math.IsInf(p, 0) || math.IsInf(af, 0) || p != p || af != af {
+		return Float16WithRoundFromFloat[RND](p + af)
+	}
+
+	// Enough precision for any sum of a product and a value in the format.
+	v := new(big.Float).SetPrec(200).SetFloat64(p)
+	if v.Add(v, new(big.Float).SetFloat64(af)).Sign() == 0 {
+		// An exact zero sum is -0 when both terms are -0,
+		// or when rounding toward negative, unless both terms are +0.
+		neg := math.Signbit(p) && math.Signbit(af)
+		if _, ok := any(*new(RND)).(RoundTowardNegative); ok {
+			neg = math.Signbit(p) || math.Signbit(af)
+		}
+
+		if neg {
+			return Float16WithRoundFromFloat[RND](math.Copysign(0, -1))
+		}
+
+		return Float16WithRoundFromFloat[RND](0.0)
+	}
+
+	return Float16WithRoundFromFloat[RND](v)
+}
+
+func testFloat16Vec[RND RoundingMode](t *testing.T) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(16, 2))
+
+	// Not a multiple of the block size, so that the last block is partial.
+	const n = 1000
+
+	x, y, a := make(Float16VecWithRound[RND], n), make(Float16VecWithRound[RND], n), make(Float16VecWithRound[RND], n)
+	for i := range x {
+		x[i] = Float16WithRoundFromBits[RND](uint16(rng.Uint32()))
+		y[i] = Float16WithRoundFromBits[RND](uint16(rng.Uint32()))
+		a[i] = Float16WithRoundFromBits[RND](uint16(rng.Uint32()))
+
+		switch i % 8 {
+		case 0:
+			// Cancel exactly.
+			y[i] = x[i].Neg()
+			a[i] = x[i].Mul(x[i]).Neg()
+		case 1:
+			x[i] = Float16WithRoundFromBits[RND](uint16(i&2) << 14) // ±0
+		case 2:
+			// Overflow, which only some rounding modes take to infinity.
+			x[i], y[i] = Float16WithRoundFromBits[RND](0xef3a), Float16WithRoundFromBits[RND](0x6b07)
+		}
+	}
+
+	var rnd RND
+
+	z := make(Float16VecWithRound[RND], n)
+
+	check := func(op string, i int, got, expect Float16WithRound[RND]) {
+		if got.bits != expect.bits && !(got.IsNaN() && expect.IsNaN()) {
+			t.Errorf("%T: %s[%d] of %#04x, %#04x, %#04x = %#04x, expected %#04x", rnd, op, i, x[i].bits, y[i].bits, a[i].bits, got.bits, expect.bits)
+		}
+	}
+
+	one := Float16WithRoundFromFloat[RND](1.0)
+
+	z.Add(x, y)
+	for i := range z {
+		check("Add", i, z[i], exactFMA16(x[i], one, &y[i]))
+		check("Add scalar", i, z[i], x[i].Add(y[i]))
+	}
+
+	z.Mul(x, y)
+	for i := range z {
+		check("Mul", i, z[i], exactFMA16(x[i], y[i], nil))
+		check("Mul scalar", i, z[i], x[i].Mul(y[i]))
+	}
+
+	z.FMA(x, x, a)
+	for i := range z {
+		check("FMA", i, z[i], exactFMA16(x[i], x[i], &a[i]))
+	}
+
+	z.FMA(x, y, a)
+	for i := range z {
+		check("FMA", i, z[i], exactFMA16(x[i], y[i], &a[i]))
+		check("FMA scalar", i, z[i], x[i].FMA(y[i], a[i]))
+	}
+
+	z.Scale(a[2], x)
+	for i := range z {
+		check("Scale", i, z[i], exactFMA16(a[2], x[i], nil))
+	}
+
+	// The destination may be an operand.
+	copy(z, x)
+	z.Add(z, y)
+	for i := range z {
+		check("Add aliased", i, z[i], exactFMA16(x[i], one, &y[i]))
+	}
+
+	f := make([]float32, n)
+	x.Float32s(f)
+	for i := range f {
+		check("Float32s", i, Float16WithRoundFromFloat[RND](f[i]), x[i])
+	}
+
+	for i := range f {
+		f[i] = rng.Float32()*2 - 1
+	}
+	z.SetFloat32s(f)
+	for i := range z {
+		check("SetFloat32s", i, z[i], Float16WithRoundFromFloat[RND](f[i]))
+	}
+
+	// The reductions, over finite values, where the exact accumulator agrees with the exact sum.
+	xs, ys := []Float16WithRound[RND](x), []Float16WithRound[RND](y)
+	for i := range xs {
+		if !xs[i].IsFinite() {
+			xs[i] = one
+		}
+		if !ys[i].IsFinite() {
+			ys[i] = one
+		}
+	}
+
+	if got, expect := x.Sum(AccumulateExact), Float16Sum(xs, SumExact); got.bits != expect.bits {
+		t.Errorf("%T: Sum(AccumulateExact) = %#04x, expected %#04x", rnd, got.bits, expect.bits)
+	}
+
+	if got, expect := x.Dot(y, AccumulateExact), Float16Dot(xs, ys, SumExact); got.bits != expect.bits {
+		t.Errorf("%T: Dot(AccumulateExact) = %#04x, expected %#04x", rnd, got.bits, expect.bits)
+	}
+
+	var sum32 float32
+	var sum64 float64
+	for i := range x {
+		sum32 += float32(x[i].Float32().Native() * y[i].Float32().Native())
+		sum64 += float64(x[i].Float64().Native() * y[i].Float64().Native())
+	}
+
+	if got, expect := x.Dot(y, AccumulateFloat32), Float16WithRoundFromFloat[RND](sum32); got.bits != expect.bits {
+		t.Errorf("%T: Dot(AccumulateFloat32) = %#04x, expected %#04x", rnd, got.bits, expect.bits)
+	}
+
+	if got, expect := x.Dot(y, AccumulateFloat64), Float16WithRoundFromFloat[RND](sum64); got.bits != expect.bits {
+		t.Errorf("%T: Dot(AccumulateFloat64) = %#04x, expected %#04x", rnd, got.bits, expect.bits)
+	}
+
+	max := x[0]
+	for _, e := range x {
+		max = max.Max(e)
+	}
+
+	if got := x.Max(); got.bits != max.bits {
+		t.Errorf("%T: Max() = %#04x, expected %#04x", rnd, got.bits, max.bits)
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() {
+		z.FMA(x, y, a)
+		x.Dot(y, AccumulateExact)
+	}); allocs != 0 {
+		t.Errorf("%T: %v allocs per run, expected none", rnd, allocs)
+	}
+}
+
+func TestFloat16Vec(t *testing.T) {
+	testFloat16Vec[RoundTiesToEven](t)
+	testFloat16Vec[RoundTiesToAway](t)
+	testFloat16Vec[RoundTiesToOdd](t)
+	testFloat16Vec[RoundTiesToZero](t)
+	testFloat16Vec[RoundTowardZero](t)
+	testFloat16Vec[RoundTowardPositive](t)
+	testFloat16Vec[RoundTowardNegative](t)
+}
+
+func benchmarkFloat16Op[RND RoundingMode](b *testing.B, op func(x, y Float16WithRound[RND]) Float16WithRound[RND]) {
+	xs := make([]Float16WithRound[RND], 1<<12)
+	for i := range xs {
+		xs[i] = Float16WithRoundFromFloat[RND](1 + math.Sin(float64(i))/2)
+	}
+	dst := make([]Float16WithRound[RND], len(xs))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, x := range xs {
+			dst[j] = op(x, xs[len(xs)-1-j])
+		}
+	}
+}
+
+func BenchmarkFloat16Add(b *testing.B) {
+	benchmarkFloat16Op(b, Float16.Add)
+}
+
+// BenchmarkFloat16AddTowardZero is BenchmarkFloat16Add in another rounding mode,
+// as every rounding mode shares the same compiled code.
+func BenchmarkFloat16AddTowardZero(b *testing.B) {
+	benchmarkFloat16Op(b, Float16WithRound[RoundTowardZero].Add)
+}
+
+func BenchmarkFloat16Mul(b *testing.B) {
+	benchmarkFloat16Op(b, Float16.Mul)
+}
+
+func BenchmarkFloat16Div(b *testing.B) {
+	benchmarkFloat16Op(b, Float16.Div)
+}
+
+func BenchmarkFloat16VecFMA(b *testing.B) {
+	x := make(Float16Vec, 1<<12)
+	for i := range x {
+		x[i] = Float16FromFloat(1 + math.Sin(float64(i))/2)
+	}
+	z := make(Float16Vec, len(x))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		z.FMA(x, x, x)
+	}
+}
+
+func BenchmarkFloat16VecDot(b *testing.B) {
+	x := make(Float16Vec, 1<<12)
+	for i := range x {
+		x[i] = Float16FromFloat(1 + math.Sin(float64(i))/2)
+	}
+
+	for _, acc := range []struct {
+		name string
+		acc  Accumulator
+	}{
+		{"Float32", AccumulateFloat32},
+		{"Float64", AccumulateFloat64},
+		{"Exact", AccumulateExact},
+	} {
+		b.Run(acc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				x.Dot(x, acc.acc)
+			}
+		})
+	}
+
+	b.Run("Float16Dot", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Float16Dot(x, x, SumNaive)
+		}
+	})
+}
+
+func testFloat16OpFMA[RND RoundingMode](t *testing.T) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(16, 3))
+
+	for i := 0; i < 1<<14; i++ {
+		x := Float16WithRound[RND]{uint16(rng.Uint32())}
+		y := Float16WithRound[RND]{uint16(rng.Uint32())}
+		z := Float16WithRound[RND]{uint16(rng.Uint32())}
+
+		got, expect := x.FMA(y, z), exactFMA16(x, y, &z)
+		if got != expect && !(got.IsNaN() && expect.IsNaN()) {
+			t.Errorf("%T: %04x × %04x + %04x = %04x, expected %04x", x, x.bits, y.bits, z.bits, got.bits, expect.bits)
+		}
+	}
+}
+
+func TestFloat16OpFMA(t *testing.T) {
+	testFloat16OpFMA[RoundTiesToEven](t)
+	testFloat16OpFMA[RoundTiesToAway](t)
+	testFloat16OpFMA[RoundTowardZero](t)
+	testFloat16OpFMA[RoundTowardPositive](t)
+	testFloat16OpFMA[RoundTowardNegative](t)
+
+	// The product overflows, but the sum does not.
+	x, y, z := Float16FromBits(0xd041), Float16FromBits(0xe8fc), Float16FromBits(0xf90e)
+	if got, expect := x.FMA(y, z), exactFMA16(x, y, &z); got != expect {
+		t.Errorf("%v × %v + %v = %v, expected %v", x, y, z, got, expect)
+	}
+}
@@ -110,6 +110,30 @@ func (x Float32WithRound[RND]) IsNaN() bool {
 	return isNaN[binary32](x.bits)
 }
 
+func (x Float32WithRound[RND]) Class() Class {
+	return class[binary32](x.bits)
+}
+
+func (x Float32WithRound[RND]) IsSignaling() bool {
+	return isSignaling[binary32](x.bits)
+}
+
+func (x Float32WithRound[RND]) IsZero() bool {
+	return isZero[binary32](x.bits)
+}
+
+func (x Float32WithRound[RND]) IsFinite() bool {
+	return isFinite[binary32](x.bits)
+}
+
+func (x Float32WithRound[RND]) IsNormal() bool {
+	return isNormal[binary32](x.bits)
+}
+
+func (x Float32WithRound[RND]) IsSubnormal() bool {
+	return isSubnormal[binary32](x.bits)
+}
+
 func (x Float32WithRound[RND]) Sign() int {
 	return getSign[binary32](x.bits)
 }
@@ -138,15 +162,37 @@ func (x Float32WithRound[RND]) NextDown() Float32WithRound[RND] {
 	return Float32WithRound[RND]{nextDown[binary32](x.bits)}
 }
 
+func (x Float32WithRound[RND]) NextAfter(y Float32WithRound[RND]) Float32WithRound[RND] {
+	return Float32WithRound[RND]{nextAfter[binary32](x.bits, y.bits)}
+}
+
+func (x Float32WithRound[RND]) ULP() Float32WithRound[RND] {
+	return Float32WithRound[RND]{ulp[binary32](x.bits)}
+}
+
 func (x Float32WithRound[RND]) Add(y Float32WithRound[RND]) Float32WithRound[RND] {
 	var rnd RND
 
+	// Native arithmetic is correctly rounded ties to even, so it gives the same result as the software,
+	// except for NaN, where the software returns a NaN operand as is, or else its own canonical NaN.
+	if rnd.native() {
+		if z := x.Native() + y.Native(); z == z {
+			return Float32WithRound[RND]{math.Float32bits(z)}
+		}
+	}
+
 	return Float32WithRound[RND]{add[binary32](x.bits, y.bits, rnd)}
 }
 
 func (x Float32WithRound[RND]) Sub(y Float32WithRound[RND]) Float32WithRound[RND] {
 	var rnd RND
 
+	if rnd.native() {
+		if z := x.Native() - y.Native(); z == z {
+			return Float32WithRound[RND]{math.Float32bits(z)}
+		}
+	}
+
 	return Float32WithRound[RND]{sub[binary32](x.bits, y.bits, rnd)}
 }
 
@@ -159,12 +205,53 @@ func (x Float32WithRound[RND]) Dim(y Float32WithRound[RND]) Float32WithRound[RND
 func (x Float32WithRound[RND]) Mul(y Float32WithRound[RND]) Float32WithRound[RND] {
 	var rnd RND
 
+	if rnd.native() {
+		if z := x.Native() * y.Native(); z == z {
+			return Float32WithRound[RND]{math.Float32bits(z)}
+		}
+	}
+
 	return Float32WithRound[RND]{mul[binary32](x.bits, y.bits, rnd)}
 }
 
+func (x Float32WithRound[RND]) FMA(y, z Float32WithRound[RND]) Float32WithRound[RND] {
+	var rnd RND
+
+	if r := fmaOdd(float64(x.Native()), float64(y.Native()), float64(z.Native()), rnd); r == r {
+		if rnd.native() {
+			return Float32WithRound[RND]{math.Float32bits(float32(r))}
+		}
+
+		return Float32WithRoundFromFloat[RND](r)
+	}
+
+	return Float32WithRound[RND]{madd[binary32](x.bits, y.bits, z.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) AugmentedAdd(y Float32WithRound[RND]) (sum, err Float32WithRound[RND]) {
+	s, e := augmentedAdd[binary32](x.bits, y.bits)
+	return Float32WithRound[RND]{s}, Float32WithRound[RND]{e}
+}
+
+func (x Float32WithRound[RND]) AugmentedSub(y Float32WithRound[RND]) (diff, err Float32WithRound[RND]) {
+	d, e := augmentedSub[binary32](x.bits, y.bits)
+	return Float32WithRound[RND]{d}, Float32WithRound[RND]{e}
+}
+
+func (x Float32WithRound[RND]) AugmentedMul(y Float32WithRound[RND]) (prod, err Float32WithRound[RND]) {
+	p, e := augmentedMul[binary32](x.bits, y.bits)
+	return Float32WithRound[RND]{p}, Float32WithRound[RND]{e}
+}
+
 func (x Float32WithRound[RND]) Div(y Float32WithRound[RND]) Float32WithRound[RND] {
 	var rnd RND
 
+	if rnd.native() {
+		if z := x.Native() / y.Native(); z == z {
+			return Float32WithRound[RND]{math.Float32bits(z)}
+		}
+	}
+
 	return Float32WithRound[RND]{div[binary32](x.bits, y.bits, rnd)}
 }
 
@@ -174,6 +261,10 @@ func (x Float32WithRound[RND]) Mod(y Float32WithRound[RND]) Float32WithRound[RND
 	return Float32WithRound[RND]{mod[binary32](x.bits, y.bits, rnd)}
 }
 
+func (x Float32WithRound[RND]) Remainder(y Float32WithRound[RND]) Float32WithRound[RND] {
+	return Float32WithRound[RND]{remainder[binary32](x.bits, y.bits)}
+}
+
 func (x Float32WithRound[RND]) ModF() (i, f Float32WithRound[RND]) {
 	q, r := modf[binary32](x.bits)
 	return Float32WithRound[RND]{q}, Float32WithRound[RND]{r}
@@ -188,8 +279,8 @@ func (x Float32WithRound[RND]) Compare(y Float32WithRound[RND]) int {
 }
 
 func (x Float32WithRound[RND]) Equal(y Float32WithRound[RND]) bool {
-	order, _ := fcmp[binary32](x.bits, y.bits)
-	return order == 0
+	order, ordered := fcmp[binary32](x.bits, y.bits)
+	return order == 0 && ordered
 }
 
 func (x Float32WithRound[RND]) Cmp(y Float32WithRound[RND]) (order int, ordered bool) {
@@ -216,6 +307,22 @@ func (x Float32WithRound[RND]) MaxMag(y Float32WithRound[RND]) Float32WithRound[
 	return Float32WithRound[RND]{fmaxMag[binary32](x.bits, y.bits)}
 }
 
+func (x Float32WithRound[RND]) Minimum(y Float32WithRound[RND]) Float32WithRound[RND] {
+	return Float32WithRound[RND]{fminimum[binary32](x.bits, y.bits)}
+}
+
+func (x Float32WithRound[RND]) Maximum(y Float32WithRound[RND]) Float32WithRound[RND] {
+	return Float32WithRound[RND]{fmaximum[binary32](x.bits, y.bits)}
+}
+
+func (x Float32WithRound[RND]) MinimumMag(y Float32WithRound[RND]) Float32WithRound[RND] {
+	return Float32WithRound[RND]{fminimumMag[binary32](x.bits, y.bits)}
+}
+
+func (x Float32WithRound[RND]) MaximumMag(y Float32WithRound[RND]) Float32WithRound[RND] {
+	return Float32WithRound[RND]{fmaximumMag[binary32](x.bits, y.bits)}
+}
+
 func (x Float32WithRound[RND]) Round() Float32WithRound[RND] {
 	return Float32WithRound[RND]{round[binary32](x.bits)}
 }
@@ -239,6 +346,12 @@ func (x Float32WithRound[RND]) Ceil() Float32WithRound[RND] {
 func (x Float32WithRound[RND]) Sqrt() Float32WithRound[RND] {
 	var rnd RND
 
+	if rnd.native() {
+		if z := float32(math.Sqrt(float64(x.Native()))); z == z {
+			return Float32WithRound[RND]{math.Float32bits(z)}
+		}
+	}
+
 	return Float32WithRound[RND]{sqrt[binary32](x.bits, rnd)}
 }
 
@@ -266,6 +379,133 @@ func (x Float32WithRound[RND]) Exp2() Float32WithRound[RND] {
 	return Float32WithRound[RND]{exp2[binary32](x.bits, rnd)}
 }
 
+func (x Float32WithRound[RND]) Exp10() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{exp10[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) ExpM1() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{expM1[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Exp2M1() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{exp2M1[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Exp10M1() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{exp10M1[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Log1p() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{log1p[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Log2p1() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{log2P1[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Log10p1() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{log10P1[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Pow(y Float32WithRound[RND]) Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{pow[binary32](x.bits, y.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Pown(n int) Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{pown[binary32](x.bits, n, rnd)}
+}
+
+func (x Float32WithRound[RND]) Powr(y Float32WithRound[RND]) Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{powr[binary32](x.bits, y.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Rootn(n int) Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{rootn[binary32](x.bits, n, rnd)}
+}
+
+func (x Float32WithRound[RND]) Cbrt() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{rootn[binary32](x.bits, 3, rnd)}
+}
+
+func (x Float32WithRound[RND]) Compound(n int) Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{compound[binary32](x.bits, n, rnd)}
+}
+
+func (x Float32WithRound[RND]) Erf() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{erf[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Erfc() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{erfc[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Gamma() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{gamma[binary32](x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) LnGamma() (lngamma Float32WithRound[RND], sign int) {
+	var rnd RND
+
+	l, sign := lnGamma[binary32](x.bits, rnd)
+	return Float32WithRound[RND]{l}, sign
+}
+
+func (x Float32WithRound[RND]) J0() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{besselJ[binary32](0, x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) J1() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{besselJ[binary32](1, x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Y0() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{besselY[binary32](0, x.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) Y1() Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{besselY[binary32](1, x.bits, rnd)}
+}
+
 func (x Float32WithRound[RND]) LogB() Float32WithRound[RND] {
 	return Float32WithRound[RND]{logb[binary32](x.bits)}
 }
@@ -273,3 +513,59 @@ func (x Float32WithRound[RND]) LogB() Float32WithRound[RND] {
 func (x Float32WithRound[RND]) ILogB() (int, bool) {
 	return ilogb[binary32](x.bits)
 }
+
+func (x Float32WithRound[RND]) ScaleB(n int) Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{ldexp[binary32](x.bits, n, rnd)}
+}
+
+func (x Float32WithRound[RND]) FrExp() (frac Float32WithRound[RND], exp int) {
+	f, exp := frexp[binary32](x.bits)
+	return Float32WithRound[RND]{f}, exp
+}
+
+func (x Float32WithRound[RND]) LdExp(exp int) Float32WithRound[RND] {
+	return x.ScaleB(exp)
+}
+
+// The following unexported methods provide the reductions needed by Sum and Dot.
+
+func (x Float32WithRound[RND]) sum(xs []Float32WithRound[RND], alg SumAlgorithm) Float32WithRound[RND] {
+	return Float32Sum(xs, alg)
+}
+
+func (x Float32WithRound[RND]) dot(xs, ys []Float32WithRound[RND], alg SumAlgorithm) Float32WithRound[RND] {
+	return Float32Dot(xs, ys, alg)
+}
+
+// The following unexported methods provide the elementary functions needed by Complex.
+
+func (x Float32WithRound[RND]) fromFloat64(v float64) Float32WithRound[RND] {
+	return Float32WithRoundFromFloat[RND](v)
+}
+
+func (x Float32WithRound[RND]) ldexp(exp int) Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{ldexp[binary32](x.bits, exp, rnd)}
+}
+
+func (x Float32WithRound[RND]) logHypot(y Float32WithRound[RND]) Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{logHypot[binary32](x.bits, y.bits, rnd)}
+}
+
+func (x Float32WithRound[RND]) sinCos() (sin, cos Float32WithRound[RND]) {
+	var rnd RND
+
+	s, c := sinCos[binary32](x.bits, rnd)
+	return Float32WithRound[RND]{s}, Float32WithRound[RND]{c}
+}
+
+func (y Float32WithRound[RND]) atan2(x Float32WithRound[RND]) Float32WithRound[RND] {
+	var rnd RND
+
+	return Float32WithRound[RND]{atan2[binary32](y.bits, x.bits, rnd)}
+}
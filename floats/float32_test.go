@@ -3,6 +3,7 @@ package floats
 import (
 	"math"
 	"math/big"
+	"math/rand/v2"
 	"testing"
 )
 
@@ -184,3 +185,172 @@ func TestFloat32OpExp(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat32Native(t *testing.T) {
+	rng := rand.New(rand.NewPCG(32, 1))
+
+	var rnd RoundTiesToEven
+
+	tests := []struct {
+		name     string
+		native   func(x, y Float32) Float32
+		software func(x, y uint32) uint32
+	}{
+		{"add", Float32.Add, func(x, y uint32) uint32 { return add[binary32](x, y, rnd) }},
+		{"sub", Float32.Sub, func(x, y uint32) uint32 { return sub[binary32](x, y, rnd) }},
+		{"mul", Float32.Mul, func(x, y uint32) uint32 { return mul[binary32](x, y, rnd) }},
+		{"div", Float32.Div, func(x, y uint32) uint32 { return div[binary32](x, y, rnd) }},
+		{"sqrt", func(x, _ Float32) Float32 { return x.Sqrt() }, func(x, _ uint32) uint32 { return sqrt[binary32](x, rnd) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for n := 0; n < 1<<14; n++ {
+				x, y := rng.Uint32(), rng.Uint32()
+
+				// Bring the exponents together at times, so that sums cancel, and products stay finite.
+				if n&1 == 1 {
+					y = y&0x807fffff | x&0x7f800000
+				}
+
+				got := tt.native(Float32{x}, Float32{y})
+				expect := Float32{tt.software(x, y)}
+
+				if got.bits != expect.bits && !(got.IsNaN() && expect.IsNaN()) {
+					t.Fatalf("%s(%0*x, %0*x) = %0*x, expected %0*x", tt.name, 32/4, x, 32/4, y, 32/4, got.bits, 32/4, expect.bits)
+				}
+			}
+		})
+	}
+}
+
+func benchmarkFloat32Add[RND RoundingMode](b *testing.B) {
+	xs := make([]Float32WithRound[RND], 1<<12)
+	for i := range xs {
+		xs[i] = Float32WithRoundFromFloat[RND](math.Sin(float64(i)) * float64(i%1000))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := xs[0]
+		for _, x := range xs[1:] {
+			sum = sum.Add(x)
+		}
+	}
+}
+
+func BenchmarkFloat32Add(b *testing.B) {
+	benchmarkFloat32Add[RoundTiesToEven](b)
+}
+
+func BenchmarkFloat32AddTowardZero(b *testing.B) {
+	benchmarkFloat32Add[RoundTowardZero](b)
+}
+
+func benchmarkFloat32MulDiv[RND RoundingMode](b *testing.B) {
+	xs := make([]Float32WithRound[RND], 1<<12)
+	for i := range xs {
+		xs[i] = Float32WithRoundFromFloat[RND](1 + math.Sin(float64(i))/2)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := xs[0]
+		for j, x := range xs[1:] {
+			if j&1 == 0 {
+				p = p.Mul(x)
+			} else {
+				p = p.Div(x)
+			}
+		}
+	}
+}
+
+func BenchmarkFloat32MulDiv(b *testing.B) {
+	benchmarkFloat32MulDiv[RoundTiesToEven](b)
+}
+
+func BenchmarkFloat32MulDivTowardZero(b *testing.B) {
+	benchmarkFloat32MulDiv[RoundTowardZero](b)
+}
+
+func benchmarkFloat32Sqrt[RND RoundingMode](b *testing.B) {
+	xs := make([]Float32WithRound[RND], 1<<12)
+	for i := range xs {
+		xs[i] = Float32WithRoundFromFloat[RND](float64(i))
+	}
+	dst := make([]Float32WithRound[RND], len(xs))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, x := range xs {
+			dst[j] = x.Sqrt()
+		}
+	}
+}
+
+func BenchmarkFloat32Sqrt(b *testing.B) {
+	benchmarkFloat32Sqrt[RoundTiesToEven](b)
+}
+
+func BenchmarkFloat32SqrtTowardZero(b *testing.B) {
+	benchmarkFloat32Sqrt[RoundTowardZero](b)
+}
+
+// exactFMA32 returns x*y+z computed exactly, and rounded once to a Float32.
+func exactFMA32[RND RoundingMode](x, y, z Float32WithRound[RND]) Float32WithRound[RND] {
+	p, zf := float64(x.Native())*float64(y.Native()), float64(z.Native()) // the product is exact
+
+	if math.IsInf(p, 0) || math.IsInf(zf, 0) || p != p || zf != zf {
+		return Float32WithRoundFromFloat[RND](p + zf)
+	}
+
+	// Enough precision for any sum of a product and a value in the format.
+	v := new(big.Float).SetPrec(800).SetFloat64(p)
+	if v.Add(v, new(big.Float).SetFloat64(zf)).Sign() == 0 {
+		// An exact zero sum is -0 when both terms are -0,
+		// or when rounding toward negative, unless both terms are +0.
+		neg := math.Signbit(p) && math.Signbit(zf)
+		if _, ok := any(*new(RND)).(RoundTowardNegative); ok {
+			neg = math.Signbit(p) || math.Signbit(zf)
+		}
+
+		if neg {
+			return Float32WithRoundFromFloat[RND](math.Copysign(0, -1))
+		}
+
+		return Float32WithRoundFromFloat[RND](0.0)
+	}
+
+	return Float32WithRoundFromFloat[RND](v)
+}
+
+func testFloat32OpFMA[RND RoundingMode](t *testing.T) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(32, 3))
+
+	for i := 0; i < 1<<14; i++ {
+		x := Float32WithRound[RND]{rng.Uint32()}
+		y := Float32WithRound[RND]{rng.Uint32()}
+		z := Float32WithRound[RND]{rng.Uint32()}
+
+		if i&1 == 0 {
+			// Cancellation, with z near -x*y.
+			z = Float32WithRoundFromFloat[RND](-float64(x.Native()) * float64(y.Native()))
+		}
+
+		got, expect := x.FMA(y, z), exactFMA32(x, y, z)
+		if got != expect && !(got.IsNaN() && expect.IsNaN()) {
+			t.Errorf("%T: %08x × %08x + %08x = %08x, expected %08x", x, x.bits, y.bits, z.bits, got.bits, expect.bits)
+		}
+	}
+}
+
+func TestFloat32OpFMA(t *testing.T) {
+	testFloat32OpFMA[RoundTiesToEven](t)
+	testFloat32OpFMA[RoundTiesToAway](t)
+	testFloat32OpFMA[RoundTowardZero](t)
+	testFloat32OpFMA[RoundTowardPositive](t)
+	testFloat32OpFMA[RoundTowardNegative](t)
+}
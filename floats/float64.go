@@ -110,6 +110,30 @@ func (x Float64WithRound[RND]) IsNaN() bool {
 	return isNaN[binary64](x.bits)
 }
 
+func (x Float64WithRound[RND]) Class() Class {
+	return class[binary64](x.bits)
+}
+
+func (x Float64WithRound[RND]) IsSignaling() bool {
+	return isSignaling[binary64](x.bits)
+}
+
+func (x Float64WithRound[RND]) IsZero() bool {
+	return isZero[binary64](x.bits)
+}
+
+func (x Float64WithRound[RND]) IsFinite() bool {
+	return isFinite[binary64](x.bits)
+}
+
+func (x Float64WithRound[RND]) IsNormal() bool {
+	return isNormal[binary64](x.bits)
+}
+
+func (x Float64WithRound[RND]) IsSubnormal() bool {
+	return isSubnormal[binary64](x.bits)
+}
+
 func (x Float64WithRound[RND]) Sign() int {
 	return getSign[binary64](x.bits)
 }
@@ -138,15 +162,35 @@ func (x Float64WithRound[RND]) NextDown() Float64WithRound[RND] {
 	return Float64WithRound[RND]{nextDown[binary64](x.bits)}
 }
 
+func (x Float64WithRound[RND]) NextAfter(y Float64WithRound[RND]) Float64WithRound[RND] {
+	return Float64WithRound[RND]{nextAfter[binary64](x.bits, y.bits)}
+}
+
+func (x Float64WithRound[RND]) ULP() Float64WithRound[RND] {
+	return Float64WithRound[RND]{ulp[binary64](x.bits)}
+}
+
 func (x Float64WithRound[RND]) Add(y Float64WithRound[RND]) Float64WithRound[RND] {
 	var rnd RND
 
+	if rnd.native() {
+		if z := x.Native() + y.Native(); z == z {
+			return Float64WithRound[RND]{math.Float64bits(z)}
+		}
+	}
+
 	return Float64WithRound[RND]{add[binary64](x.bits, y.bits, rnd)}
 }
 
 func (x Float64WithRound[RND]) Sub(y Float64WithRound[RND]) Float64WithRound[RND] {
 	var rnd RND
 
+	if rnd.native() {
+		if z := x.Native() - y.Native(); z == z {
+			return Float64WithRound[RND]{math.Float64bits(z)}
+		}
+	}
+
 	return Float64WithRound[RND]{sub[binary64](x.bits, y.bits, rnd)}
 }
 
@@ -159,12 +203,51 @@ func (x Float64WithRound[RND]) Dim(y Float64WithRound[RND]) Float64WithRound[RND
 func (x Float64WithRound[RND]) Mul(y Float64WithRound[RND]) Float64WithRound[RND] {
 	var rnd RND
 
+	if rnd.native() {
+		if z := x.Native() * y.Native(); z == z {
+			return Float64WithRound[RND]{math.Float64bits(z)}
+		}
+	}
+
 	return Float64WithRound[RND]{mul[binary64](x.bits, y.bits, rnd)}
 }
 
+func (x Float64WithRound[RND]) FMA(y, z Float64WithRound[RND]) Float64WithRound[RND] {
+	var rnd RND
+
+	if rnd.native() {
+		if r := math.FMA(x.Native(), y.Native(), z.Native()); r == r {
+			return Float64WithRound[RND]{math.Float64bits(r)}
+		}
+	}
+
+	return Float64WithRound[RND]{madd[binary64](x.bits, y.bits, z.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) AugmentedAdd(y Float64WithRound[RND]) (sum, err Float64WithRound[RND]) {
+	s, e := augmentedAdd[binary64](x.bits, y.bits)
+	return Float64WithRound[RND]{s}, Float64WithRound[RND]{e}
+}
+
+func (x Float64WithRound[RND]) AugmentedSub(y Float64WithRound[RND]) (diff, err Float64WithRound[RND]) {
+	d, e := augmentedSub[binary64](x.bits, y.bits)
+	return Float64WithRound[RND]{d}, Float64WithRound[RND]{e}
+}
+
+func (x Float64WithRound[RND]) AugmentedMul(y Float64WithRound[RND]) (prod, err Float64WithRound[RND]) {
+	p, e := augmentedMul[binary64](x.bits, y.bits)
+	return Float64WithRound[RND]{p}, Float64WithRound[RND]{e}
+}
+
 func (x Float64WithRound[RND]) Div(y Float64WithRound[RND]) Float64WithRound[RND] {
 	var rnd RND
 
+	if rnd.native() {
+		if z := x.Native() / y.Native(); z == z {
+			return Float64WithRound[RND]{math.Float64bits(z)}
+		}
+	}
+
 	return Float64WithRound[RND]{div[binary64](x.bits, y.bits, rnd)}
 }
 
@@ -174,6 +257,10 @@ func (x Float64WithRound[RND]) Mod(y Float64WithRound[RND]) Float64WithRound[RND
 	return Float64WithRound[RND]{mod[binary64](x.bits, y.bits, rnd)}
 }
 
+func (x Float64WithRound[RND]) Remainder(y Float64WithRound[RND]) Float64WithRound[RND] {
+	return Float64WithRound[RND]{remainder[binary64](x.bits, y.bits)}
+}
+
 func (x Float64WithRound[RND]) ModF() (i, f Float64WithRound[RND]) {
 	q, r := modf[binary64](x.bits)
 	return Float64WithRound[RND]{q}, Float64WithRound[RND]{r}
@@ -188,8 +275,8 @@ func (x Float64WithRound[RND]) Compare(y Float64WithRound[RND]) int {
 }
 
 func (x Float64WithRound[RND]) Equal(y Float64WithRound[RND]) bool {
-	order, _ := fcmp[binary64](x.bits, y.bits)
-	return order == 0
+	order, ordered := fcmp[binary64](x.bits, y.bits)
+	return order == 0 && ordered
 }
 
 func (x Float64WithRound[RND]) Cmp(y Float64WithRound[RND]) (order int, ordered bool) {
@@ -216,6 +303,22 @@ func (x Float64WithRound[RND]) MaxMag(y Float64WithRound[RND]) Float64WithRound[
 	return Float64WithRound[RND]{fmaxMag[binary64](x.bits, y.bits)}
 }
 
+func (x Float64WithRound[RND]) Minimum(y Float64WithRound[RND]) Float64WithRound[RND] {
+	return Float64WithRound[RND]{fminimum[binary64](x.bits, y.bits)}
+}
+
+func (x Float64WithRound[RND]) Maximum(y Float64WithRound[RND]) Float64WithRound[RND] {
+	return Float64WithRound[RND]{fmaximum[binary64](x.bits, y.bits)}
+}
+
+func (x Float64WithRound[RND]) MinimumMag(y Float64WithRound[RND]) Float64WithRound[RND] {
+	return Float64WithRound[RND]{fminimumMag[binary64](x.bits, y.bits)}
+}
+
+func (x Float64WithRound[RND]) MaximumMag(y Float64WithRound[RND]) Float64WithRound[RND] {
+	return Float64WithRound[RND]{fmaximumMag[binary64](x.bits, y.bits)}
+}
+
 func (x Float64WithRound[RND]) Round() Float64WithRound[RND] {
 	return Float64WithRound[RND]{round[binary64](x.bits)}
 }
@@ -239,6 +342,12 @@ func (x Float64WithRound[RND]) Ceil() Float64WithRound[RND] {
 func (x Float64WithRound[RND]) Sqrt() Float64WithRound[RND] {
 	var rnd RND
 
+	if rnd.native() {
+		if z := math.Sqrt(x.Native()); z == z {
+			return Float64WithRound[RND]{math.Float64bits(z)}
+		}
+	}
+
 	return Float64WithRound[RND]{sqrt[binary64](x.bits, rnd)}
 }
 
@@ -266,6 +375,133 @@ func (x Float64WithRound[RND]) Exp2() Float64WithRound[RND] {
 	return Float64WithRound[RND]{exp2[binary64](x.bits, rnd)}
 }
 
+func (x Float64WithRound[RND]) Exp10() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{exp10[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) ExpM1() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{expM1[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Exp2M1() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{exp2M1[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Exp10M1() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{exp10M1[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Log1p() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{log1p[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Log2p1() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{log2P1[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Log10p1() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{log10P1[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Pow(y Float64WithRound[RND]) Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{pow[binary64](x.bits, y.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Pown(n int) Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{pown[binary64](x.bits, n, rnd)}
+}
+
+func (x Float64WithRound[RND]) Powr(y Float64WithRound[RND]) Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{powr[binary64](x.bits, y.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Rootn(n int) Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{rootn[binary64](x.bits, n, rnd)}
+}
+
+func (x Float64WithRound[RND]) Cbrt() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{rootn[binary64](x.bits, 3, rnd)}
+}
+
+func (x Float64WithRound[RND]) Compound(n int) Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{compound[binary64](x.bits, n, rnd)}
+}
+
+func (x Float64WithRound[RND]) Erf() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{erf[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Erfc() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{erfc[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Gamma() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{gamma[binary64](x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) LnGamma() (lngamma Float64WithRound[RND], sign int) {
+	var rnd RND
+
+	l, sign := lnGamma[binary64](x.bits, rnd)
+	return Float64WithRound[RND]{l}, sign
+}
+
+func (x Float64WithRound[RND]) J0() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{besselJ[binary64](0, x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) J1() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{besselJ[binary64](1, x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Y0() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{besselY[binary64](0, x.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) Y1() Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{besselY[binary64](1, x.bits, rnd)}
+}
+
 func (x Float64WithRound[RND]) LogB() Float64WithRound[RND] {
 	return Float64WithRound[RND]{logb[binary64](x.bits)}
 }
@@ -273,3 +509,59 @@ func (x Float64WithRound[RND]) LogB() Float64WithRound[RND] {
 func (x Float64WithRound[RND]) ILogB() (int, bool) {
 	return ilogb[binary64](x.bits)
 }
+
+func (x Float64WithRound[RND]) ScaleB(n int) Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{ldexp[binary64](x.bits, n, rnd)}
+}
+
+func (x Float64WithRound[RND]) FrExp() (frac Float64WithRound[RND], exp int) {
+	f, exp := frexp[binary64](x.bits)
+	return Float64WithRound[RND]{f}, exp
+}
+
+func (x Float64WithRound[RND]) LdExp(exp int) Float64WithRound[RND] {
+	return x.ScaleB(exp)
+}
+
+// The following unexported methods provide the reductions needed by Sum and Dot.
+
+func (x Float64WithRound[RND]) sum(xs []Float64WithRound[RND], alg SumAlgorithm) Float64WithRound[RND] {
+	return Float64Sum(xs, alg)
+}
+
+func (x Float64WithRound[RND]) dot(xs, ys []Float64WithRound[RND], alg SumAlgorithm) Float64WithRound[RND] {
+	return Float64Dot(xs, ys, alg)
+}
+
+// The following unexported methods provide the elementary functions needed by Complex.
+
+func (x Float64WithRound[RND]) fromFloat64(v float64) Float64WithRound[RND] {
+	return Float64WithRoundFromFloat[RND](v)
+}
+
+func (x Float64WithRound[RND]) ldexp(exp int) Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{ldexp[binary64](x.bits, exp, rnd)}
+}
+
+func (x Float64WithRound[RND]) logHypot(y Float64WithRound[RND]) Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{logHypot[binary64](x.bits, y.bits, rnd)}
+}
+
+func (x Float64WithRound[RND]) sinCos() (sin, cos Float64WithRound[RND]) {
+	var rnd RND
+
+	s, c := sinCos[binary64](x.bits, rnd)
+	return Float64WithRound[RND]{s}, Float64WithRound[RND]{c}
+}
+
+func (y Float64WithRound[RND]) atan2(x Float64WithRound[RND]) Float64WithRound[RND] {
+	var rnd RND
+
+	return Float64WithRound[RND]{atan2[binary64](y.bits, x.bits, rnd)}
+}
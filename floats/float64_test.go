@@ -3,6 +3,7 @@ package floats
 import (
 	"math"
 	"math/big"
+	"math/rand/v2"
 	"testing"
 )
 
@@ -187,3 +188,165 @@ func TestFloat64OpExp(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat64Native(t *testing.T) {
+	rng := rand.New(rand.NewPCG(64, 1))
+
+	var rnd RoundTiesToEven
+
+	tests := []struct {
+		name     string
+		native   func(x, y Float64) Float64
+		software func(x, y uint64) uint64
+	}{
+		{"add", Float64.Add, func(x, y uint64) uint64 { return add[binary64](x, y, rnd) }},
+		{"sub", Float64.Sub, func(x, y uint64) uint64 { return sub[binary64](x, y, rnd) }},
+		{"mul", Float64.Mul, func(x, y uint64) uint64 { return mul[binary64](x, y, rnd) }},
+		{"div", Float64.Div, func(x, y uint64) uint64 { return div[binary64](x, y, rnd) }},
+		{"sqrt", func(x, _ Float64) Float64 { return x.Sqrt() }, func(x, _ uint64) uint64 { return sqrt[binary64](x, rnd) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for n := 0; n < 1<<14; n++ {
+				x, y := rng.Uint64(), rng.Uint64()
+
+				// Bring the exponents together at times, so that sums cancel, and products stay finite.
+				if n&1 == 1 {
+					y = y&0x800fffffffffffff | x&0x7ff0000000000000
+				}
+
+				got := tt.native(Float64{x}, Float64{y})
+				expect := Float64{tt.software(x, y)}
+
+				if got.bits != expect.bits && !(got.IsNaN() && expect.IsNaN()) {
+					t.Fatalf("%s(%0*x, %0*x) = %0*x, expected %0*x", tt.name, 64/4, x, 64/4, y, 64/4, got.bits, 64/4, expect.bits)
+				}
+			}
+		})
+	}
+}
+
+func benchmarkFloat64Add[RND RoundingMode](b *testing.B) {
+	xs := make([]Float64WithRound[RND], 1<<12)
+	for i := range xs {
+		xs[i] = Float64WithRoundFromFloat[RND](math.Sin(float64(i)) * float64(i%1000))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := xs[0]
+		for _, x := range xs[1:] {
+			sum = sum.Add(x)
+		}
+	}
+}
+
+func BenchmarkFloat64Add(b *testing.B) {
+	benchmarkFloat64Add[RoundTiesToEven](b)
+}
+
+func BenchmarkFloat64AddTowardZero(b *testing.B) {
+	benchmarkFloat64Add[RoundTowardZero](b)
+}
+
+func benchmarkFloat64MulDiv[RND RoundingMode](b *testing.B) {
+	xs := make([]Float64WithRound[RND], 1<<12)
+	for i := range xs {
+		xs[i] = Float64WithRoundFromFloat[RND](1 + math.Sin(float64(i))/2)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := xs[0]
+		for j, x := range xs[1:] {
+			if j&1 == 0 {
+				p = p.Mul(x)
+			} else {
+				p = p.Div(x)
+			}
+		}
+	}
+}
+
+func BenchmarkFloat64MulDiv(b *testing.B) {
+	benchmarkFloat64MulDiv[RoundTiesToEven](b)
+}
+
+func BenchmarkFloat64MulDivTowardZero(b *testing.B) {
+	benchmarkFloat64MulDiv[RoundTowardZero](b)
+}
+
+func benchmarkFloat64Sqrt[RND RoundingMode](b *testing.B) {
+	xs := make([]Float64WithRound[RND], 1<<12)
+	for i := range xs {
+		xs[i] = Float64WithRoundFromFloat[RND](float64(i))
+	}
+	dst := make([]Float64WithRound[RND], len(xs))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, x := range xs {
+			dst[j] = x.Sqrt()
+		}
+	}
+}
+
+func BenchmarkFloat64Sqrt(b *testing.B) {
+	benchmarkFloat64Sqrt[RoundTiesToEven](b)
+}
+
+func BenchmarkFloat64SqrtTowardZero(b *testing.B) {
+	benchmarkFloat64Sqrt[RoundTowardZero](b)
+}
+
+func testFloat64OpFMA[RND RoundingMode](t *testing.T) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(64, 3))
+
+	// Values with exponents in a narrow range, such that 2000 bits holds any sum exactly.
+	value := func() float64 {
+		return math.Ldexp(rng.NormFloat64(), rng.IntN(200)-100)
+	}
+
+	for i := 0; i < 1<<12; i++ {
+		xf, yf, zf := value(), value(), value()
+		if i&1 == 0 {
+			// Cancellation, with z near -x*y.
+			zf = -xf * yf
+		}
+
+		x, y, z := Float64WithRoundFromFloat[RND](xf), Float64WithRoundFromFloat[RND](yf), Float64WithRoundFromFloat[RND](zf)
+
+		v := new(big.Float).SetPrec(2000).SetFloat64(xf)
+		v.Mul(v, new(big.Float).SetFloat64(yf))
+		v.Add(v, new(big.Float).SetFloat64(zf))
+
+		if v.Sign() == 0 {
+			continue
+		}
+
+		if got, expect := x.FMA(y, z), Float64WithRoundFromFloat[RND](v); got != expect {
+			t.Errorf("%T: %v × %v + %v = %v, expected %v", x, x, y, z, got, expect)
+		}
+	}
+}
+
+func TestFloat64OpFMA(t *testing.T) {
+	testFloat64OpFMA[RoundTiesToEven](t)
+	testFloat64OpFMA[RoundTiesToAway](t)
+	testFloat64OpFMA[RoundTowardZero](t)
+	testFloat64OpFMA[RoundTowardPositive](t)
+	testFloat64OpFMA[RoundTowardNegative](t)
+
+	inf, one, two := Inf64(false), Float64FromFloat(1.0), Float64FromFloat(2.0)
+
+	if got := inf.FMA(two, one); got != inf {
+		t.Errorf("+Inf × 2 + 1 = %v, expected +Inf", got)
+	}
+
+	if got := two.FMA(inf, inf.Neg()); !got.IsNaN() {
+		t.Errorf("2 × +Inf - Inf = %v, expected NaN", got)
+	}
+}
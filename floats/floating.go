@@ -0,0 +1,173 @@
+package floats
+
+import (
+	"fmt"
+)
+
+// Floating is the set of floating-point types of this package: Float16, BFloat16, Float32, Float64, and Float128,
+// with any rounding mode.
+// It allows an algorithm to be written once, as a generic function, and used at every precision.
+type Floating[T any] interface {
+	comparable
+	fmt.Formatter
+
+	Add(T) T
+	Sub(T) T
+	Mul(T) T
+	Div(T) T
+	FMA(y, z T) T
+	Neg() T
+	Abs() T
+	CopySign(T) T
+	Dim(T) T
+	Sqrt() T
+	Cbrt() T
+	Hypot(T) T
+	Mod(T) T
+	Remainder(T) T
+
+	AugmentedAdd(T) (sum, err T)
+	AugmentedSub(T) (diff, err T)
+	AugmentedMul(T) (prod, err T)
+
+	Cmp(T) (order int, ordered bool)
+	Compare(T) int
+	Equal(T) bool
+	Less(T) bool
+	Max(T) T
+	Min(T) T
+	Maximum(T) T
+	Minimum(T) T
+	MaxMag(T) T
+	MinMag(T) T
+	MaximumMag(T) T
+	MinimumMag(T) T
+
+	Class() Class
+	IsFinite() bool
+	IsInf(sign int) bool
+	IsNaN() bool
+	IsNormal() bool
+	IsSubnormal() bool
+	IsSignaling() bool
+	IsZero() bool
+	SignBit() bool
+
+	LdExp(exp int) T
+	FrExp() (frac T, exp int)
+	ILogB() (int, bool)
+	LogB() T
+	ULP() T
+	NextUp() T
+	NextDown() T
+	NextAfter(T) T
+
+	Floor() T
+	Ceil() T
+	Trunc() T
+	Round() T
+	RoundToEven() T
+	ModF() (i, f T)
+
+	Exp() T
+	ExpM1() T
+	Exp2() T
+	Exp10() T
+	Log1p() T
+	Pow(T) T
+	Pown(n int) T
+
+	Erf() T
+	Erfc() T
+	Gamma() T
+	LnGamma() (lngamma T, sign int)
+	J0() T
+	J1() T
+	Y0() T
+	Y1() T
+
+	fromFloat64(float64) T
+	sum(xs []T, alg SumAlgorithm) T
+	dot(xs, ys []T, alg SumAlgorithm) T
+}
+
+// FromFloat returns the float64 v converted to the floating-point type T, rounded per its rounding mode.
+func FromFloat[T Floating[T]](v float64) T {
+	var zero T
+
+	return zero.fromFloat64(v)
+}
+
+// Sum returns the sum of xs, accumulated according to alg.
+func Sum[T Floating[T]](xs []T, alg SumAlgorithm) T {
+	var zero T
+
+	return zero.sum(xs, alg)
+}
+
+// Dot returns the dot product of xs and ys, accumulated according to alg.
+// It panics if xs and ys have different lengths.
+func Dot[T Floating[T]](xs, ys []T, alg SumAlgorithm) T {
+	var zero T
+
+	return zero.dot(xs, ys, alg)
+}
+
+// Horner returns the value at x of the polynomial with the coefficients c, lowest degree first,
+// evaluated by Horner’s method.
+// If c is empty, it returns zero.
+func Horner[T Floating[T]](x T, c ...T) T {
+	var p T
+	if len(c) == 0 {
+		return p
+	}
+
+	// Start from the leading coefficient, rather than 0·x + c, which is NaN for an infinite x, and loses the sign of -0.
+	p = c[len(c)-1]
+
+	for i := len(c) - 2; i >= 0; i-- {
+		p = p.Mul(x).Add(c[i])
+	}
+
+	return p
+}
+
+// Newton returns a zero of f near x0, found by Newton’s method, where df is the derivative of f.
+//
+// It iterates until x no longer changes, or alternates between two neighbours,
+// where it returns whichever is closer to a zero, which is as close as the precision of T allows.
+// It reports false if it stopped for any other reason:
+// after maxIter iterations, or at a point where the step is not finite, such as where the derivative is zero.
+func Newton[T Floating[T]](f, df func(T) T, x0 T, maxIter int) (T, bool) {
+	x, prev := x0, x0
+
+	for i := 0; i < maxIter; i++ {
+		fx := f(x)
+		if fx.IsZero() {
+			return x, true
+		}
+
+		step := fx.Div(df(x))
+		if !step.IsFinite() {
+			return x, false
+		}
+
+		next := x.Sub(step)
+
+		switch {
+		case next.Equal(x):
+			return x, true
+
+		case i > 0 && next.Equal(prev):
+			// Rounding has trapped the iteration in a cycle between x and prev.
+			if f(prev).Abs().Less(fx.Abs()) {
+				return prev, true
+			}
+			return x, true
+		}
+
+		x, prev = next, x
+	}
+
+	return x, false
+}
@@ -0,0 +1,144 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+// withinULPs reports whether got is within n ULPs of expect.
+func withinULPs[T Floating[T]](got, expect T, n int) bool {
+	return got.Sub(expect).Abs().Compare(expect.ULP().Mul(FromFloat[T](float64(n)))) <= 0
+}
+
+func testFloating[T Floating[T]](t *testing.T) {
+	t.Helper()
+
+	var zero T
+	name := typeName(zero)
+
+	two := FromFloat[T](2)
+
+	// Newton’s method for √2.
+	f := func(x T) T { return x.Mul(x).Sub(two) }
+	df := func(x T) T { return x.Add(x) }
+
+	root, ok := Newton(f, df, FromFloat[T](1), 100)
+	if !ok {
+		t.Errorf("%s: Newton did not converge, stopped at %v", name, root)
+	}
+
+	if expect := two.Sqrt(); !withinULPs(root, expect, 1) {
+		t.Errorf("%s: Newton found √2 = %v, expected %v", name, root, expect)
+	}
+
+	// From the other side, it finds the other zero.
+	if root, ok := Newton(f, df, FromFloat[T](-3), 100); !ok || !withinULPs(root, two.Sqrt().Neg(), 1) {
+		t.Errorf("%s: Newton found -√2 = %v, converged: %t", name, root, ok)
+	}
+
+	// A zero derivative stops the iteration.
+	if _, ok := Newton(f, df, zero, 100); ok {
+		t.Errorf("%s: Newton converged from a zero derivative", name)
+	}
+
+	// The Taylor series of exp(x), to beyond the precision of Float128 at x = ½.
+	c := make([]T, 32)
+	c[0] = FromFloat[T](1)
+	for i := 1; i < len(c); i++ {
+		c[i] = c[i-1].Div(FromFloat[T](float64(i)))
+	}
+
+	half := FromFloat[T](0.5)
+	if got, expect := Horner(half, c...), fromFloat128[T](SqrtE); !withinULPs(got, expect, 4) {
+		t.Errorf("%s: Horner gave exp(½) = %v, expected %v", name, got, expect)
+	}
+
+	if got := Horner(half); !got.IsZero() {
+		t.Errorf("%s: Horner of no coefficients = %v, expected 0", name, got)
+	}
+
+	// A constant is itself, at any x.
+	if got := Horner(two.Div(zero), two); !got.Equal(two) {
+		t.Errorf("%s: Horner(+Inf, 2) = %v, expected 2", name, got)
+	}
+
+	if got := Horner(half, zero.Neg()); !got.IsZero() || !got.SignBit() {
+		t.Errorf("%s: Horner(½, -0) = %v, expected -0", name, got)
+	}
+
+	xs := []T{FromFloat[T](1), FromFloat[T](2), FromFloat[T](3)}
+	ys := []T{FromFloat[T](4), FromFloat[T](5), FromFloat[T](6)}
+
+	for _, alg := range []SumAlgorithm{SumNaive, SumPairwise, SumNeumaier, SumExact} {
+		if got := Sum(xs, alg); !got.Equal(FromFloat[T](6)) {
+			t.Errorf("%s: Sum(1, 2, 3) = %v, expected 6", name, got)
+		}
+
+		if got := Dot(xs, ys, alg); !got.Equal(FromFloat[T](32)) {
+			t.Errorf("%s: Dot((1, 2, 3), (4, 5, 6)) = %v, expected 32", name, got)
+		}
+	}
+}
+
+func typeName(x any) string {
+	switch x.(type) {
+	case Float16:
+		return "Float16"
+	case BFloat16:
+		return "BFloat16"
+	case Float32:
+		return "Float32"
+	case Float64:
+		return "Float64"
+	case Float128:
+		return "Float128"
+	}
+
+	return "Float32WithRound[RoundTowardZero]"
+}
+
+// fromFloat128 returns x converted to each of the types that testFloating is run with.
+func fromFloat128[T Floating[T]](x Float128) T {
+	var zero T
+
+	var v any
+	switch any(zero).(type) {
+	case Float16:
+		v = x.Float16()
+	case BFloat16:
+		v = x.BFloat16()
+	case Float32:
+		v = x.Float32()
+	case Float64:
+		v = x.Float64()
+	case Float128:
+		v = x
+	case Float32WithRound[RoundTowardZero]:
+		v = Float128WithRound[RoundTowardZero]{x.bits}.Float32()
+	}
+
+	return v.(T)
+}
+
+func TestFloating(t *testing.T) {
+	testFloating[Float16](t)
+	testFloating[BFloat16](t)
+	testFloating[Float32](t)
+	testFloating[Float64](t)
+	testFloating[Float128](t)
+	testFloating[Float32WithRound[RoundTowardZero]](t)
+}
+
+func TestFromFloat(t *testing.T) {
+	if got, expect := FromFloat[Float16](0.1), Float16FromFloat(0.1); got != expect {
+		t.Errorf("FromFloat[Float16](0.1) = %v, expected %v", got, expect)
+	}
+
+	if got, expect := FromFloat[Float64](math.Pi).Native(), math.Pi; got != expect {
+		t.Errorf("FromFloat[Float64](π) = %v, expected %v", got, expect)
+	}
+
+	if got := FromFloat[Float32WithRound[RoundTowardZero]](0.1).Native(); got >= 0.1 {
+		t.Errorf("FromFloat[Float32WithRound[RoundTowardZero]](0.1) = %v, expected below 0.1", got)
+	}
+}
@@ -0,0 +1,325 @@
+package npy
+
+import (
+	stdbinary "encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	stdbits "math/bits"
+	"strconv"
+	"strings"
+)
+
+// magic is the prefix of every .npy file.
+const magic = "\x93NUMPY"
+
+// headerAlign is the alignment that the header is padded out to, so that the data can be memory-mapped.
+const headerAlign = 64
+
+// LongDouble selects how the 16-byte floating-point dtype 'f16' is interpreted.
+//
+// NumPy uses 'f16' for the platform’s long double, which is not the same format on every platform,
+// and nothing in the file records which platform wrote it.
+type LongDouble int
+
+const (
+	// LongDoubleUnknown is the zero value, with which 'f16' is not read at all, rather than guessed at.
+	LongDoubleUnknown LongDouble = iota
+
+	// LongDoubleIEEE interprets 'f16' as an IEEE 754 128-bit floating-point number,
+	// which is the long double of aarch64 Linux, among others.
+	LongDoubleIEEE
+
+	// LongDoubleX87 interprets 'f16' as the x87 80-bit extended-precision format padded out to 16 bytes,
+	// which is the long double of x86 and x86-64.
+	// Every such value is exactly representable as an IEEE 754 128-bit floating-point number.
+	LongDoubleX87
+)
+
+// Header describes the array stored in a .npy file.
+type Header struct {
+	// Descr is the NumPy dtype description, such as "<f2".
+	Descr string
+
+	// FortranOrder reports whether the data is stored in column-major order, rather than row-major order.
+	FortranOrder bool
+
+	// Shape is the size of each dimension of the array, which is empty for a scalar.
+	Shape []int
+
+	// LongDouble selects how a Descr of 'f16' is interpreted when reading the data.
+	// It is not stored in the file, so ReadHeader always leaves it as LongDoubleUnknown.
+	LongDouble LongDouble
+}
+
+// Len returns the number of elements in the array described by the header,
+// or -1 if a dimension is negative, or the number of elements overflows an int.
+func (h *Header) Len() int {
+	for _, dim := range h.Shape {
+		switch {
+		case dim < 0:
+			return -1
+		case dim == 0:
+			return 0
+		}
+	}
+
+	n := 1
+	for _, dim := range h.Shape {
+		hi, lo := stdbits.Mul64(uint64(n), uint64(dim))
+		if hi != 0 || lo > math.MaxInt {
+			return -1
+		}
+		n = int(lo)
+	}
+	return n
+}
+
+// ReadHeader reads a .npy header from r, leaving r positioned at the start of the data.
+// Versions 1.0, 2.0, and 3.0 of the format are supported.
+func ReadHeader(r io.Reader) (*Header, error) {
+	var pre [len(magic) + 2]byte
+	if _, err := io.ReadFull(r, pre[:]); err != nil {
+		return nil, err
+	}
+
+	if string(pre[:len(magic)]) != magic {
+		return nil, errors.New("npy: not a .npy file")
+	}
+
+	major := pre[len(magic)]
+
+	var n int
+
+	switch major {
+	case 1:
+		var b [2]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, err
+		}
+		n = int(stdbinary.LittleEndian.Uint16(b[:]))
+
+	case 2, 3:
+		var b [4]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, err
+		}
+		n = int(stdbinary.LittleEndian.Uint32(b[:]))
+
+	default:
+		return nil, fmt.Errorf("npy: unsupported format version %d.%d", major, pre[len(magic)+1])
+	}
+
+	// A version 2.0 header may claim up to 4 GiB, so it is only read as far as r actually holds.
+	dict, err := io.ReadAll(io.LimitReader(r, int64(n)))
+	if err != nil {
+		return nil, err
+	}
+	if len(dict) < n {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	h, err := parseHeader(string(dict))
+	if err != nil {
+		return nil, fmt.Errorf("npy: bad header: %w", err)
+	}
+
+	if h.Len() < 0 {
+		return nil, fmt.Errorf("npy: bad header: shape %v has too many elements", h.Shape)
+	}
+
+	return h, nil
+}
+
+// WriteHeader writes a .npy header to w.
+// Version 1.0 of the format is used, unless the header is too long for it, in which case version 2.0 is used.
+func WriteHeader(w io.Writer, h *Header) error {
+	shape := make([]string, len(h.Shape))
+	for i, dim := range h.Shape {
+		shape[i] = strconv.Itoa(dim)
+	}
+
+	tuple := strings.Join(shape, ", ")
+	if len(shape) == 1 {
+		tuple += ","
+	}
+
+	fortran := "False"
+	if h.FortranOrder {
+		fortran = "True"
+	}
+
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': %s, 'shape': (%s), }", h.Descr, fortran, tuple)
+
+	major, lenSize := byte(1), 2
+	if len(magic)+2+lenSize+len(dict)+1 > 0xffff {
+		major, lenSize = 2, 4
+	}
+
+	// Pad with spaces, and a final newline, out to the alignment.
+	total := len(magic) + 2 + lenSize + len(dict) + 1
+	pad := (headerAlign - total%headerAlign) % headerAlign
+	dict += strings.Repeat(" ", pad) + "\n"
+
+	b := make([]byte, 0, len(magic)+2+lenSize+len(dict))
+	b = append(b, magic...)
+	b = append(b, major, 0)
+
+	if lenSize == 2 {
+		b = stdbinary.LittleEndian.AppendUint16(b, uint16(len(dict)))
+	} else {
+		b = stdbinary.LittleEndian.AppendUint32(b, uint32(len(dict)))
+	}
+
+	b = append(b, dict...)
+
+	_, err := w.Write(b)
+	return err
+}
+
+// parseHeader parses the Python dictionary literal of a .npy header.
+func parseHeader(s string) (*Header, error) {
+	p := &parser{s: s}
+
+	if !p.consume('{') {
+		return nil, errors.New("expected '{'")
+	}
+
+	h := new(Header)
+	seen := make(map[string]bool)
+
+	for !p.consume('}') {
+		key, err := p.str()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.consume(':') {
+			return nil, errors.New("expected ':'")
+		}
+
+		switch key {
+		case "descr":
+			if h.Descr, err = p.str(); err != nil {
+				return nil, err
+			}
+
+		case "fortran_order":
+			if h.FortranOrder, err = p.bool(); err != nil {
+				return nil, err
+			}
+
+		case "shape":
+			if h.Shape, err = p.tuple(); err != nil {
+				return nil, err
+			}
+
+		default:
+			return nil, fmt.Errorf("unexpected key %q", key)
+		}
+
+		seen[key] = true
+
+		if !p.consume(',') && !p.peek('}') {
+			return nil, errors.New("expected ',' or '}'")
+		}
+	}
+
+	for _, key := range []string{"descr", "fortran_order", "shape"} {
+		if !seen[key] {
+			return nil, fmt.Errorf("missing key %q", key)
+		}
+	}
+
+	return h, nil
+}
+
+type parser struct {
+	s string
+}
+
+func (p *parser) skipSpace() {
+	p.s = strings.TrimLeft(p.s, " \t\r\n")
+}
+
+func (p *parser) peek(c byte) bool {
+	p.skipSpace()
+	return len(p.s) > 0 && p.s[0] == c
+}
+
+func (p *parser) consume(c byte) bool {
+	if !p.peek(c) {
+		return false
+	}
+
+	p.s = p.s[1:]
+	return true
+}
+
+func (p *parser) str() (string, error) {
+	p.skipSpace()
+
+	if len(p.s) == 0 || (p.s[0] != '\'' && p.s[0] != '"') {
+		return "", errors.New("expected a string")
+	}
+
+	quote := p.s[0]
+
+	end := strings.IndexByte(p.s[1:], quote)
+	if end < 0 {
+		return "", errors.New("unterminated string")
+	}
+
+	v := p.s[1 : end+1]
+	p.s = p.s[end+2:]
+
+	return v, nil
+}
+
+func (p *parser) bool() (bool, error) {
+	p.skipSpace()
+
+	switch {
+	case strings.HasPrefix(p.s, "True"):
+		p.s = p.s[len("True"):]
+		return true, nil
+
+	case strings.HasPrefix(p.s, "False"):
+		p.s = p.s[len("False"):]
+		return false, nil
+	}
+
+	return false, errors.New("expected True or False")
+}
+
+func (p *parser) tuple() ([]int, error) {
+	if !p.consume('(') {
+		return nil, errors.New("expected '('")
+	}
+
+	shape := []int{}
+
+	for !p.consume(')') {
+		p.skipSpace()
+
+		end := strings.IndexFunc(p.s, func(r rune) bool { return r < '0' || r > '9' })
+		if end <= 0 {
+			return nil, errors.New("expected a dimension")
+		}
+
+		dim, err := strconv.Atoi(p.s[:end])
+		if err != nil {
+			return nil, err
+		}
+		p.s = p.s[end:]
+
+		shape = append(shape, dim)
+
+		if !p.consume(',') && !p.peek(')') {
+			return nil, errors.New("expected ',' or ')'")
+		}
+	}
+
+	return shape, nil
+}
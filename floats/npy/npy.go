@@ -0,0 +1,380 @@
+// Package npy reads and writes NumPy .npy and .npz files of floating-point arrays.
+//
+// The dtypes supported are float16 ('f2'), float32 ('f4'), float64 ('f8'), long double ('f16'),
+// and the bfloat16 of ml_dtypes ('bfloat16'), in either byte order.
+package npy
+
+import (
+	stdbinary "encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/puellanivis/math/bits"
+	"github.com/puellanivis/math/floats"
+)
+
+// Element is the set of types that arrays may be read into, or written from.
+type Element interface {
+	floats.Float16 | floats.BFloat16 | floats.Float32 | floats.Float64 | floats.Float128 | float32 | float64
+}
+
+// Array is an n-dimensional array of floating-point numbers.
+type Array[T Element] struct {
+	// Shape is the size of each dimension of the array, which is empty for a scalar.
+	Shape []int
+
+	// FortranOrder reports whether Data is stored in column-major order, rather than row-major order.
+	FortranOrder bool
+
+	// Data holds the elements of the array.
+	Data []T
+}
+
+// kind is a dtype with its byte order removed.
+type kind string
+
+const (
+	kindFloat16  kind = "f2"
+	kindBFloat16 kind = "bfloat16"
+	kindFloat32  kind = "f4"
+	kindFloat64  kind = "f8"
+	kindFloat128 kind = "f16"
+)
+
+// parseDescr splits a dtype description into its kind and byte order.
+func parseDescr(descr string) (kind, stdbinary.ByteOrder, error) {
+	if descr == string(kindBFloat16) {
+		return kindBFloat16, stdbinary.LittleEndian, nil
+	}
+
+	if len(descr) < 2 {
+		return "", nil, fmt.Errorf("npy: unsupported dtype %q", descr)
+	}
+
+	var order stdbinary.ByteOrder
+
+	switch descr[0] {
+	case '<':
+		order = stdbinary.LittleEndian
+	case '>':
+		order = stdbinary.BigEndian
+	case '=', '|':
+		order = stdbinary.NativeEndian
+	default:
+		return "", nil, fmt.Errorf("npy: unsupported dtype %q", descr)
+	}
+
+	switch k := kind(descr[1:]); k {
+	case kindFloat16, kindFloat32, kindFloat64, kindFloat128:
+		return k, order, nil
+	}
+
+	return "", nil, fmt.Errorf("npy: unsupported dtype %q", descr)
+}
+
+// descrOf returns the little-endian dtype description for the element type T.
+func descrOf[T Element]() string {
+	var z T
+
+	switch any(z).(type) {
+	case floats.Float16:
+		return "<" + string(kindFloat16)
+	case floats.BFloat16:
+		return string(kindBFloat16)
+	case floats.Float32, float32:
+		return "<" + string(kindFloat32)
+	case floats.Float64, float64:
+		return "<" + string(kindFloat64)
+	case floats.Float128:
+		return "<" + string(kindFloat128)
+	}
+
+	panic("impossible type")
+}
+
+// Read reads a whole .npy file from r.
+//
+// A Descr of 'f16' is rejected, because its format depends upon the platform that wrote the file,
+// use ReadWith to state which format it is.
+func Read[T Element](r io.Reader) (*Array[T], error) {
+	return ReadWith[T](r, LongDoubleUnknown)
+}
+
+// ReadWith reads a whole .npy file from r, interpreting a Descr of 'f16' as the long double format ld.
+func ReadWith[T Element](r io.Reader, ld LongDouble) (*Array[T], error) {
+	h, err := ReadHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	h.LongDouble = ld
+
+	data, err := ReadData[T](r, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Array[T]{
+		Shape:        h.Shape,
+		FortranOrder: h.FortranOrder,
+		Data:         data,
+	}, nil
+}
+
+// ReadData reads the data of the .npy file described by h from r.
+//
+// The dtype must be the same as T,
+// except that float32 and float64 may also read any narrower dtype, which they can represent exactly.
+// A dtype of 'f16' is read only if h.LongDouble states its format.
+func ReadData[T Element](r io.Reader, h *Header) ([]T, error) {
+	k, order, err := parseDescr(h.Descr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canRead[T](k) {
+		return nil, fmt.Errorf("npy: cannot read dtype %q into %T", h.Descr, []T(nil))
+	}
+
+	if k == kindFloat128 && h.LongDouble != LongDoubleIEEE && h.LongDouble != LongDoubleX87 {
+		return nil, fmt.Errorf("npy: cannot read dtype %q without knowing its long double format", h.Descr)
+	}
+
+	n := h.Len()
+	if n < 0 {
+		return nil, fmt.Errorf("npy: shape %v has too many elements", h.Shape)
+	}
+
+	fr := floats.NewReader(r, order)
+
+	// The data is read a chunk at a time, growing geometrically,
+	// so that a header claiming far more elements than r holds cannot force a huge allocation up front.
+	data := make([]T, 0, min(n, chunkLen))
+
+	for len(data) < n {
+		m := min(n-len(data), max(len(data), chunkLen))
+
+		data = slices.Grow(data, m)[:len(data)+m]
+
+		if err := readChunk(fr, r, order, k, h.LongDouble, data[len(data)-m:]); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// chunkLen is the number of elements that ReadData first allocates and reads at once.
+const chunkLen = 1 << 16
+
+// canRead reports whether the dtype k can be read into T.
+func canRead[T Element](k kind) bool {
+	var z T
+
+	switch any(z).(type) {
+	case floats.Float16:
+		return k == kindFloat16
+	case floats.BFloat16:
+		return k == kindBFloat16
+	case floats.Float32:
+		return k == kindFloat32
+	case floats.Float64:
+		return k == kindFloat64
+	case floats.Float128:
+		return k == kindFloat128
+	case float32:
+		return k == kindFloat32 || k == kindFloat16 || k == kindBFloat16
+	case float64:
+		return k != kindFloat128
+	}
+
+	panic("impossible type")
+}
+
+// readChunk reads len(dst) elements of the dtype k, which canRead into T, from r or fr.
+func readChunk[T Element](fr *floats.Reader, r io.Reader, order stdbinary.ByteOrder, k kind, ld LongDouble, dst []T) error {
+	switch dst := any(dst).(type) {
+	case []floats.Float16:
+		return floats.ReadFloat16s(fr, dst)
+	case []floats.BFloat16:
+		return floats.ReadBFloat16s(fr, dst)
+	case []floats.Float32:
+		return floats.ReadFloat32s(fr, dst)
+	case []floats.Float64:
+		return floats.ReadFloat64s(fr, dst)
+	case []floats.Float128:
+		if ld == LongDoubleX87 {
+			return readX87(r, order, dst)
+		}
+		return floats.ReadFloat128s(fr, dst)
+	case []float32:
+		return readFloat32s(r, order, k, dst)
+	case []float64:
+		return readFloat64s(r, order, k, dst)
+	}
+
+	panic("impossible type")
+}
+
+// errMismatch is returned internally when the dtype cannot be read into the destination.
+var errMismatch = errors.New("npy: dtype mismatch")
+
+func readFloat32s(r io.Reader, order stdbinary.ByteOrder, k kind, dst []float32) error {
+	switch k {
+	case kindFloat32:
+		return stdbinary.Read(r, order, dst)
+
+	case kindFloat16, kindBFloat16:
+		src := make([]uint16, len(dst))
+		if err := stdbinary.Read(r, order, src); err != nil {
+			return err
+		}
+
+		if k == kindFloat16 {
+			floats.DecodeFloat16s(dst, src)
+		} else {
+			floats.DecodeBFloat16s(dst, src)
+		}
+
+		return nil
+	}
+
+	return errMismatch
+}
+
+func readFloat64s(r io.Reader, order stdbinary.ByteOrder, k kind, dst []float64) error {
+	switch k {
+	case kindFloat64:
+		return stdbinary.Read(r, order, dst)
+
+	case kindFloat32:
+		src := make([]float32, len(dst))
+		if err := stdbinary.Read(r, order, src); err != nil {
+			return err
+		}
+
+		for i, x := range src {
+			dst[i] = float64(x)
+		}
+
+		return nil
+
+	case kindFloat16, kindBFloat16:
+		src := make([]uint16, len(dst))
+		if err := stdbinary.Read(r, order, src); err != nil {
+			return err
+		}
+
+		if k == kindFloat16 {
+			floats.DecodeFloat16s(dst, src)
+		} else {
+			floats.DecodeBFloat16s(dst, src)
+		}
+
+		return nil
+	}
+
+	return errMismatch
+}
+
+// readX87 reads x87 80-bit extended-precision numbers, each padded out to 16 bytes,
+// and converts them exactly into IEEE 754 128-bit floating-point numbers.
+func readX87(r io.Reader, order stdbinary.ByteOrder, dst []floats.Float128) error {
+	var probe [2]byte
+	if order.PutUint16(probe[:], 1); probe[0] != 1 {
+		return errors.New("npy: x87 extended-precision numbers must be little-endian")
+	}
+
+	var b [16]byte
+
+	for i := range dst {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			if err == io.EOF && i > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+
+		sig := stdbinary.LittleEndian.Uint64(b[:8])
+		se := uint64(stdbinary.LittleEndian.Uint16(b[8:10]))
+
+		dst[i] = floats.Float128WithRoundFromBits[floats.RoundTiesToEven](x87ToBinary128(sig, se))
+	}
+
+	return nil
+}
+
+// x87ToBinary128 converts the x87 extended-precision number with the given significand and sign-exponent
+// into the bits of the same IEEE 754 128-bit floating-point number.
+//
+// Both formats have a 15-bit exponent with the same bias,
+// but x87 has an explicit integer bit followed by a 63-bit fraction, where binary128 has an implicit bit and a 112-bit fraction.
+func x87ToBinary128(sig, se uint64) bits.Uint128 {
+	exp := se & 0x7fff
+	integer := sig >> 63
+
+	switch {
+	case exp == 0 && integer == 1:
+		// A pseudo-denormal has the same value as the smallest normal number.
+		exp = 1
+
+	case exp != 0 && integer == 0:
+		// Pseudo-NaNs, pseudo-infinities, and unnormals are not valid operands on any x87 since the 80387.
+		return floats.NaN128().Bits()
+	}
+
+	frac := sig &^ (1 << 63)
+
+	return bits.Uint128{
+		Hi: (se&0x8000|exp)<<48 | frac>>15,
+		Lo: frac << 49,
+	}
+}
+
+// Write writes a whole .npy file to w, in little-endian byte order.
+// It returns an error if the Shape does not match the number of elements in Data.
+func Write[T Element](w io.Writer, a *Array[T]) error {
+	h := &Header{
+		Descr:        descrOf[T](),
+		FortranOrder: a.FortranOrder,
+		Shape:        a.Shape,
+	}
+
+	if h.Len() != len(a.Data) {
+		return fmt.Errorf("npy: shape %v does not hold %d elements", a.Shape, len(a.Data))
+	}
+
+	if err := WriteHeader(w, h); err != nil {
+		return err
+	}
+
+	return WriteData(w, a.Data)
+}
+
+// WriteData writes just the data of a .npy file to w, in little-endian byte order.
+func WriteData[T Element](w io.Writer, data []T) error {
+	fw := floats.NewWriter(w, stdbinary.LittleEndian)
+
+	switch data := any(data).(type) {
+	case []floats.Float16:
+		return floats.WriteFloat16s(fw, data)
+	case []floats.BFloat16:
+		return floats.WriteBFloat16s(fw, data)
+	case []floats.Float32:
+		return floats.WriteFloat32s(fw, data)
+	case []floats.Float64:
+		return floats.WriteFloat64s(fw, data)
+	case []floats.Float128:
+		return floats.WriteFloat128s(fw, data)
+	case []float32:
+		return stdbinary.Write(w, stdbinary.LittleEndian, data)
+	case []float64:
+		return stdbinary.Write(w, stdbinary.LittleEndian, data)
+	}
+
+	panic("impossible type")
+}
@@ -0,0 +1,331 @@
+package npy
+
+import (
+	"bytes"
+	stdbinary "encoding/binary"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/puellanivis/math/bits"
+	"github.com/puellanivis/math/floats"
+)
+
+// numpyHeader is the header written by numpy.save(f, numpy.array([1, 2, 3], dtype='<f2')).
+const numpyHeader = "\x93NUMPY\x01\x00\x76\x00{'descr': '<f2', 'fortran_order': False, 'shape': (3,), }" +
+	"                                                            \n"
+
+func TestWriteHeaderMatchesNumPy(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := WriteHeader(&buf, &Header{Descr: "<f2", Shape: []int{3}}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if got := buf.String(); got != numpyHeader {
+		t.Errorf("WriteHeader() = %q, but expected %q", got, numpyHeader)
+	}
+}
+
+func TestReadHeader(t *testing.T) {
+	type test struct {
+		name  string
+		input string
+
+		expect *Header
+	}
+
+	v2 := "{'descr': '>f8', 'fortran_order': True, 'shape': (2, 3), }\n"
+
+	tests := []test{
+		{"numpy", numpyHeader, &Header{Descr: "<f2", Shape: []int{3}}},
+		{"scalar", "\x93NUMPY\x01\x00\x3a\x00{'shape': (), 'fortran_order': False, 'descr': 'bfloat16'}\n", &Header{Descr: "bfloat16", Shape: []int{}}},
+		{"version 2", "\x93NUMPY\x02\x00" + string([]byte{byte(len(v2)), 0, 0, 0}) + v2, &Header{Descr: ">f8", FortranOrder: true, Shape: []int{2, 3}}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := ReadHeader(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			if !reflect.DeepEqual(h, tt.expect) {
+				t.Errorf("ReadHeader() = %+v, but expected %+v", h, tt.expect)
+			}
+		})
+	}
+
+	for _, bad := range []string{
+		"NUMPY\x01\x00",
+		"\x93NUMPY\x04\x00\x00\x00",
+		"\x93NUMPY\x01\x00\x10\x00{'descr': '<f2'}",
+		"\x93NUMPY\x01\x00\x20\x00{'descr': '<f2', 'shape': (3,}",
+	} {
+		if h, err := ReadHeader(strings.NewReader(bad)); err == nil {
+			t.Errorf("ReadHeader(%q) = %+v, but expected an error", bad, h)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	f16s := &Array[floats.Float16]{
+		Shape: []int{2, 2},
+		Data:  []floats.Float16{floats.Float16FromBits(0x3c00), floats.Float16FromBits(0x4000), floats.Float16FromBits(0xc200), floats.Float16FromBits(0x7c00)},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, f16s); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if buf.Len() != 128+8 {
+		t.Errorf("wrote %d bytes, but expected %d", buf.Len(), 128+8)
+	}
+
+	b := buf.Bytes()
+
+	got, err := Read[floats.Float16](bytes.NewReader(b))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(got, f16s) {
+		t.Errorf("Read() = %+v, but expected %+v", got, f16s)
+	}
+
+	// float16 is read exactly into the builtin float types.
+	f32s, err := Read[float32](bytes.NewReader(b))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if expect := []float32{1, 2, -3, float32(math.Inf(1))}; !reflect.DeepEqual(f32s.Data, expect) {
+		t.Errorf("Read[float32]() = %v, but expected %v", f32s.Data, expect)
+	}
+
+	// but not into a different soft float type.
+	if _, err := Read[floats.BFloat16](bytes.NewReader(b)); err == nil {
+		t.Error("Read[BFloat16]() of float16 data, expected an error")
+	}
+
+	// and a short payload is an error.
+	if _, err := Read[floats.Float16](bytes.NewReader(b[:len(b)-1])); err == nil {
+		t.Error("Read() of a truncated file, expected an error")
+	}
+
+	if err := Write(&buf, &Array[float64]{Shape: []int{3}, Data: []float64{1, 2}}); err == nil {
+		t.Error("Write() with a mismatched shape, expected an error")
+	}
+}
+
+func TestHeaderLen(t *testing.T) {
+	type test struct {
+		name  string
+		shape []int
+
+		expect int
+	}
+
+	tests := []test{
+		{"scalar", []int{}, 1},
+		{"matrix", []int{2, 3}, 6},
+		{"empty", []int{0, 1 << 62, 4}, 0},
+		{"negative", []int{-1, 2}, -1},
+		{"overflow", []int{3074457345618258603, 3}, -1},
+		{"overflow to zero", []int{1 << 62, 4}, -1},
+	}
+
+	for _, tt := range tests {
+		h := &Header{Descr: "<f2", Shape: tt.shape}
+
+		if got := h.Len(); got != tt.expect {
+			t.Errorf("%s: Len() = %d, but expected %d", tt.name, got, tt.expect)
+		}
+	}
+}
+
+func TestReadTruncated(t *testing.T) {
+	header := func(descr string, shape ...int) *bytes.Buffer {
+		var buf bytes.Buffer
+		if err := WriteHeader(&buf, &Header{Descr: descr, Shape: shape}); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+		return &buf
+	}
+
+	// A shape whose number of elements overflows is rejected, rather than read as an empty array.
+	for _, shape := range [][]int{{3074457345618258603, 3}, {1 << 62, 4}} {
+		if a, err := Read[float64](header("<f8", shape...)); err == nil {
+			t.Errorf("Read() of shape %v = %v, but expected an error", shape, a.Data)
+		}
+	}
+
+	// A header claiming far more elements than the file holds must not be allocated up front.
+	buf := header("<f8", 1<<40)
+	stdbinary.Write(buf, stdbinary.LittleEndian, []float64{1, 2, 3})
+
+	if _, err := Read[float64](buf); err != io.ErrUnexpectedEOF {
+		t.Errorf("Read() of a truncated file = %v, but expected %v", err, io.ErrUnexpectedEOF)
+	}
+
+	// Likewise for a version 2.0 header claiming 4 GiB.
+	if _, err := ReadHeader(strings.NewReader("\x93NUMPY\x02\x00\xff\xff\xff\xff{'descr'")); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadHeader() of a truncated header = %v, but expected %v", err, io.ErrUnexpectedEOF)
+	}
+
+	// The dtype is checked before anything is allocated.
+	if _, err := Read[floats.Float16](header("<f8", 1<<40)); err == nil || err == io.ErrUnexpectedEOF {
+		t.Errorf("Read[floats.Float16]() of dtype '<f8' = %v, but expected a dtype error", err)
+	}
+}
+
+func TestReadBigEndian(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := WriteHeader(&buf, &Header{Descr: ">f4", Shape: []int{2}}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	stdbinary.Write(&buf, stdbinary.BigEndian, []float32{1.5, -0.25})
+
+	got, err := Read[float64](&buf)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if expect := []float64{1.5, -0.25}; !reflect.DeepEqual(got.Data, expect) {
+		t.Errorf("Read[float64]() = %v, but expected %v", got.Data, expect)
+	}
+}
+
+func TestReadX87(t *testing.T) {
+	type test struct {
+		name     string
+		sig      uint64
+		se       uint16
+		expectHi uint64
+		expectLo uint64
+	}
+
+	tests := []test{
+		{"one", 0x8000000000000000, 0x3fff, 0x3fff000000000000, 0},
+		{"-one and a half", 0xc000000000000000, 0xbfff, 0xbfff800000000000, 0},
+		{"odd", 0x8000000000000001, 0x3fff, 0x3fff000000000000, 1 << 49},
+		{"denormal", 0x0000000000000001, 0x0000, 0, 1 << 49},
+		{"pseudo-denormal", 0x8000000000000000, 0x0000, 0x0001000000000000, 0},
+		{"-inf", 0x8000000000000000, 0xffff, 0xffff000000000000, 0},
+	}
+
+	var buf bytes.Buffer
+
+	if err := WriteHeader(&buf, &Header{Descr: "<f16", Shape: []int{len(tests)}}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for _, tt := range tests {
+		var b [16]byte
+		stdbinary.LittleEndian.PutUint64(b[:], tt.sig)
+		stdbinary.LittleEndian.PutUint16(b[8:], tt.se)
+		buf.Write(b[:])
+	}
+
+	h, err := ReadHeader(&buf)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	h.LongDouble = LongDoubleX87
+
+	got, err := ReadData[floats.Float128](&buf, h)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for i, tt := range tests {
+		if expect := (bits.Uint128{Hi: tt.expectHi, Lo: tt.expectLo}); got[i].Bits() != expect {
+			t.Errorf("%s: got %016x:%016x, but expected %016x:%016x", tt.name, got[i].Bits().Hi, got[i].Bits().Lo, expect.Hi, expect.Lo)
+		}
+	}
+}
+
+func TestReadLongDouble(t *testing.T) {
+	a := &Array[floats.Float128]{
+		Shape: []int{2},
+		Data:  []floats.Float128{floats.Float128FromBits[floats.RoundTiesToEven](bits.Uint128{Hi: 0x3fff000000000000}), floats.Float128FromBits[floats.RoundTiesToEven](bits.Uint128{Hi: 0xc000800000000000, Lo: 1})},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, a); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	b := buf.Bytes()
+
+	if got, err := Read[floats.Float128](bytes.NewReader(b)); err == nil {
+		t.Errorf("Read() of dtype '<f16' = %+v, but expected an error", got)
+	}
+
+	got, err := ReadWith[floats.Float128](bytes.NewReader(b), LongDoubleIEEE)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(got, a) {
+		t.Errorf("ReadWith(LongDoubleIEEE) = %+v, but expected %+v", got, a)
+	}
+}
+
+func TestNPZ(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		var buf bytes.Buffer
+
+		zw := NewNPZWriter(&buf, compress)
+
+		weights := &Array[floats.BFloat16]{Shape: []int{3}, Data: []floats.BFloat16{floats.BFloat16FromBits(0x3f80), floats.BFloat16FromBits(0x4000), floats.BFloat16FromBits(0x4040)}}
+		if err := WriteNPZ(zw, "weights", weights); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		bias := &Array[float64]{Shape: []int{}, Data: []float64{0.5}}
+		if err := WriteNPZ(zw, "bias", bias); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if err := zw.Close(); err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		z, err := NewNPZ(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if names, expect := z.Names(), []string{"weights", "bias"}; !reflect.DeepEqual(names, expect) {
+			t.Errorf("Names() = %q, but expected %q", names, expect)
+		}
+
+		gotWeights, err := ReadNPZ[floats.BFloat16](z, "weights")
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if !reflect.DeepEqual(gotWeights, weights) {
+			t.Errorf("ReadNPZ(weights) = %+v, but expected %+v", gotWeights, weights)
+		}
+
+		gotBias, err := ReadNPZ[float64](z, "bias")
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if !reflect.DeepEqual(gotBias, bias) {
+			t.Errorf("ReadNPZ(bias) = %+v, but expected %+v", gotBias, bias)
+		}
+
+		if _, err := ReadNPZ[float64](z, "missing"); err == nil {
+			t.Error("ReadNPZ(missing), expected an error")
+		}
+	}
+}
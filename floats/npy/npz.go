@@ -0,0 +1,110 @@
+package npy
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// npyExt is the extension of each array within a .npz archive, which NumPy strips from the array names.
+const npyExt = ".npy"
+
+// NPZ is a .npz archive of named arrays, as written by numpy.savez and numpy.savez_compressed.
+type NPZ struct {
+	zr *zip.Reader
+}
+
+// NewNPZ returns an NPZ reading from r, which has the given size.
+func NewNPZ(r io.ReaderAt, size int64) (*NPZ, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NPZ{zr: zr}, nil
+}
+
+// Names returns the names of the arrays in the archive, in the order they are stored.
+func (z *NPZ) Names() []string {
+	var names []string
+
+	for _, f := range z.zr.File {
+		if name, ok := strings.CutSuffix(f.Name, npyExt); ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// Open returns a reader of the .npy file for the named array.
+func (z *NPZ) Open(name string) (io.ReadCloser, error) {
+	f, err := z.zr.Open(name + npyExt)
+	if err != nil {
+		return nil, fmt.Errorf("npy: %w", err)
+	}
+
+	return f, nil
+}
+
+// Header returns the header of the named array.
+func (z *NPZ) Header(name string) (*Header, error) {
+	f, err := z.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadHeader(f)
+}
+
+// ReadNPZ reads the named array from the archive z.
+// Like Read, it rejects a Descr of 'f16', use Open and ReadWith to read one.
+func ReadNPZ[T Element](z *NPZ, name string) (*Array[T], error) {
+	f, err := z.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read[T](f)
+}
+
+// NPZWriter writes a .npz archive of named arrays.
+type NPZWriter struct {
+	zw       *zip.Writer
+	compress bool
+}
+
+// NewNPZWriter returns an NPZWriter writing to w.
+// If compress is true, then the arrays are compressed, as by numpy.savez_compressed.
+func NewNPZWriter(w io.Writer, compress bool) *NPZWriter {
+	return &NPZWriter{
+		zw:       zip.NewWriter(w),
+		compress: compress,
+	}
+}
+
+// WriteNPZ writes the named array into the archive z.
+func WriteNPZ[T Element](z *NPZWriter, name string, a *Array[T]) error {
+	method := zip.Store
+	if z.compress {
+		method = zip.Deflate
+	}
+
+	w, err := z.zw.CreateHeader(&zip.FileHeader{
+		Name:   name + npyExt,
+		Method: method,
+	})
+	if err != nil {
+		return err
+	}
+
+	return Write(w, a)
+}
+
+// Close finishes writing the archive, but does not close the underlying writer.
+func (z *NPZWriter) Close() error {
+	return z.zw.Close()
+}
@@ -0,0 +1,519 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+)
+
+// exactPowLimit is the largest number of bits that an integer power is computed to exactly.
+// Any larger power needs more bits than any format has, and so cannot be exact.
+const exactPowLimit = 1 << 14
+
+// bigPowInt returns x**n for n > 0, computed exactly, and true,
+// or false if the result would need more than exactPowLimit bits.
+func bigPowInt(x *big.Float, n uint64) (*big.Float, bool) {
+	if n > exactPowLimit || uint64(x.MinPrec())*n > exactPowLimit {
+		return nil, false
+	}
+
+	prec := max(uint(x.MinPrec())*uint(n), 1)
+
+	z := new(big.Float).SetPrec(prec).SetInt64(1)
+	b := new(big.Float).SetPrec(prec).Set(x)
+
+	for ; n > 0; n >>= 1 {
+		if n&1 != 0 {
+			z.Mul(z, b)
+		}
+
+		if n > 1 {
+			b.Mul(b, b)
+		}
+	}
+
+	return z, true
+}
+
+// bigOnePlus returns 1+x, computed exactly, and true,
+// or false if the result would need more than exactPowLimit bits.
+func bigOnePlus(x *big.Float) (*big.Float, bool) {
+	// The highest bit of x is 2**(e-1), and its lowest bit is 2**(e-MinPrec), while the sum might carry one more bit.
+	e := x.MantExp(nil)
+
+	span := max(e, 1) - min(e-int(x.MinPrec()), 0) + 1
+	if span > exactPowLimit {
+		return nil, false
+	}
+
+	return new(big.Float).SetPrec(uint(span)).Add(x, big.NewFloat(1)), true
+}
+
+// expRange returns the overflowed or underflowed result of e**t, negated if neg, and true,
+// or false if t is not far outside of the range of the format SPEC.
+func expRange[SPEC spec[D], D datum](neg bool, t *big.Float, rounding RoundingMode) (D, bool) {
+	var spec SPEC
+
+	// The format ranges from 2**-(bias + mantWidth - 1) to just under 2**(bias + 1).
+	limit := float64(expBias[SPEC]()+spec.mantWidth()+2) * math.Ln2
+
+	switch tf, _ := t.Float64(); {
+	case tf > limit:
+		// EXCEPTION: overflow
+		return overflow[SPEC](neg, rounding), true
+
+	case tf < -limit:
+		// EXCEPTION: underflow
+		return underflow[SPEC](neg, rounding), true
+	}
+
+	var z D
+	return z, false
+}
+
+// underflow returns the result of rounding a non-zero number too small to be represented,
+// so that the rounding mode decides between zero and the smallest subnormal.
+func underflow[SPEC spec[D], D datum](neg bool, rounding RoundingMode) D {
+	var spec SPEC
+
+	tiny := new(big.Float).SetMantExp(big.NewFloat(1), -(expBias[SPEC]() + spec.mantWidth() + 2))
+	if neg {
+		tiny.Neg(tiny)
+	}
+
+	return fromBigFloat[SPEC](tiny, rounding)
+}
+
+// expResult returns e**t, negated if neg, rounded to the format SPEC.
+func expResult[SPEC spec[D], D datum](neg bool, t *big.Float, prec uint, rounding RoundingMode) D {
+	if r, ok := expRange[SPEC](neg, t, rounding); ok {
+		return r
+	}
+
+	v := bigExp(t, prec)
+	if neg {
+		v.Neg(v)
+	}
+
+	return fromBigFloat[SPEC](v, rounding)
+}
+
+// rationalPowResult returns x**(p/q), negated if neg, for the positive finite x, rounded to the format SPEC,
+// where t = log(x) × p/q has already been computed.
+//
+// Only a finite number of such results can be exact, but these need to be found,
+// since rounding the nearly exact approximation might otherwise go the wrong way,
+// either in a directed rounding mode, or when the exact result is halfway between two numbers.
+func rationalPowResult[SPEC spec[D], D datum](neg bool, x *big.Float, p int64, q uint64, t *big.Float, prec uint, rounding RoundingMode) D {
+	if r, ok := expRange[SPEC](neg, t, rounding); ok {
+		return r
+	}
+
+	var spec SPEC
+
+	v := bigExp(t, prec)
+
+	// An exact result that is representable, or halfway between two representable numbers, fits in width+1 bits.
+	c := new(big.Float).SetPrec(uint(spec.width()) + 1).Set(v)
+
+	absP := uint64(p)
+	if p < 0 {
+		absP = uint64(-p)
+	}
+
+	if cq, ok := bigPowInt(c, q); ok {
+		if xp, ok := bigPowInt(x, absP); ok {
+			if p < 0 {
+				// c**q = 1 / x**|p|
+				cq.SetPrec(cq.Prec() + xp.Prec())
+				cq.Mul(cq, xp)
+				xp = big.NewFloat(1)
+			}
+
+			if cq.Cmp(xp) == 0 {
+				v = c
+			}
+		}
+	}
+
+	if neg {
+		v.Neg(v)
+	}
+
+	return fromBigFloat[SPEC](v, rounding)
+}
+
+// powInt returns x**n, negated if neg, for the positive finite x, rounded to the format SPEC.
+// The result is exact whenever it can be.
+func powInt[SPEC spec[D], D datum](neg bool, x *big.Float, n int64, rounding RoundingMode) D {
+	prec := workPrec[SPEC]()
+
+	abs := uint64(n)
+	if n < 0 {
+		abs = uint64(-n)
+	}
+
+	if v, ok := bigPowInt(x, abs); ok {
+		if n < 0 {
+			// Only a power of two has an exact reciprocal, and then this quotient is exact.
+			v = new(big.Float).SetPrec(prec).Quo(big.NewFloat(1), v)
+		}
+
+		if neg {
+			v.Neg(v)
+		}
+
+		return fromBigFloat[SPEC](v, rounding)
+	}
+
+	t := bigLog(new(big.Float).SetPrec(prec).Set(x), prec)
+	t.Mul(t, new(big.Float).SetInt64(n))
+
+	return expResult[SPEC](neg, t, prec, rounding)
+}
+
+// powReal returns x**y, negated if neg, for the positive finite x and finite y, rounded to the format SPEC.
+func powReal[SPEC spec[D], D datum](neg bool, x *big.Float, y D, rounding RoundingMode) D {
+	if isInteger[SPEC](y) {
+		if e, _ := ilogb[SPEC](y); e < 62 {
+			n, _ := toBigFloat[SPEC](y).Int64()
+			return powInt[SPEC](neg, x, n, rounding)
+		}
+	}
+
+	prec := workPrec[SPEC]()
+
+	by := toBigFloat[SPEC](y)
+
+	t := bigLog(new(big.Float).SetPrec(prec).Set(x), prec)
+	t.Mul(t, by)
+
+	// y = p / 2**k, for an odd integer p.
+	if mp := int(by.MinPrec()); mp < 63 {
+		if k := mp - by.MantExp(nil); k > 0 && k < 63 {
+			p, _ := new(big.Float).SetMantExp(by, k).Int64()
+			return rationalPowResult[SPEC](neg, x, p, 1<<k, t, prec, rounding)
+		}
+	}
+
+	return expResult[SPEC](neg, t, prec, rounding)
+}
+
+func isInteger[SPEC spec[D], D datum](x D) bool {
+	var spec SPEC
+
+	_, m := mag[SPEC](x)
+	if spec.Gte(m, magInf[SPEC]()) {
+		return false
+	}
+
+	return spec.Eq(trunc[SPEC](x), x)
+}
+
+func isOddInteger[SPEC spec[D], D datum](x D) bool {
+	if !isInteger[SPEC](x) {
+		return false
+	}
+
+	var spec SPEC
+
+	// Halving an integer is exact, as no integer other than zero is subnormal.
+	h := ldexp[SPEC](x, -1, RoundTiesToEven{})
+	return !spec.Eq(trunc[SPEC](h), h)
+}
+
+func pow[SPEC spec[D], D datum](x, y D, rounding RoundingMode) D {
+	xs, xm := mag[SPEC](x)
+	_, ym := mag[SPEC](y)
+
+	var spec SPEC
+	var z D
+
+	one := one[SPEC]()
+	magInf := magInf[SPEC]()
+
+	switch {
+	case spec.IsZero(ym):
+		return one
+	case spec.Eq(x, one):
+		return one
+	case spec.Eq(y, one):
+		return x
+	case spec.Gt(xm, magInf):
+		return x
+	case spec.Gt(ym, magInf):
+		return y
+	}
+
+	xNeg := !spec.IsZero(xs)
+	yNeg := signBit[SPEC](y)
+	yOdd := isOddInteger[SPEC](y)
+
+	switch {
+	case spec.IsZero(xm):
+		switch {
+		case yNeg && yOdd:
+			// EXCEPTION: divide by zero
+			return inf[SPEC](xNeg)
+		case yNeg:
+			// EXCEPTION: divide by zero, unless y is -∞.
+			return magInf
+		case yOdd:
+			return x
+		}
+		return z
+
+	case spec.Eq(ym, magInf):
+		switch spec.Cmp(xm, one) {
+		case 0:
+			return one // pow(-1, ±∞) = 1
+		case 1:
+			if yNeg {
+				return z
+			}
+			return magInf
+		}
+
+		if yNeg {
+			return magInf
+		}
+		return z
+
+	case spec.Eq(xm, magInf):
+		r := magInf
+		if yNeg {
+			r = z
+		}
+
+		if xNeg && yOdd {
+			return neg[SPEC](r)
+		}
+		return r
+
+	case xNeg && !isInteger[SPEC](y):
+		// EXCEPTION: invalid operation
+		return nan[SPEC]()
+	}
+
+	return powReal[SPEC](xNeg && yOdd, toBigFloat[SPEC](xm), y, rounding)
+}
+
+func pown[SPEC spec[D], D datum](x D, n int, rounding RoundingMode) D {
+	xs, xm := mag[SPEC](x)
+
+	var spec SPEC
+	var z D
+
+	magInf := magInf[SPEC]()
+
+	xNeg := !spec.IsZero(xs)
+	odd := n%2 != 0
+
+	switch {
+	case n == 0:
+		return one[SPEC]()
+	case spec.Gt(xm, magInf):
+		return x
+
+	case spec.IsZero(xm):
+		switch {
+		case n < 0 && odd:
+			// EXCEPTION: divide by zero
+			return inf[SPEC](xNeg)
+		case n < 0:
+			// EXCEPTION: divide by zero
+			return magInf
+		case odd:
+			return x
+		}
+		return z
+
+	case spec.Eq(xm, magInf):
+		r := magInf
+		if n < 0 {
+			r = z
+		}
+
+		if xNeg && odd {
+			return neg[SPEC](r)
+		}
+		return r
+	}
+
+	return powInt[SPEC](xNeg && odd, toBigFloat[SPEC](xm), int64(n), rounding)
+}
+
+func powr[SPEC spec[D], D datum](x, y D, rounding RoundingMode) D {
+	xs, xm := mag[SPEC](x)
+	_, ym := mag[SPEC](y)
+
+	var spec SPEC
+	var z D
+
+	one := one[SPEC]()
+	magInf := magInf[SPEC]()
+
+	yNeg := signBit[SPEC](y)
+
+	switch {
+	case spec.Gt(xm, magInf):
+		return x
+	case spec.Gt(ym, magInf):
+		return y
+
+	case !spec.IsZero(xs) && !spec.IsZero(xm):
+		// EXCEPTION: invalid operation
+		return nan[SPEC]()
+
+	case spec.IsZero(xm):
+		switch {
+		case spec.IsZero(ym):
+			// EXCEPTION: invalid operation
+			return nan[SPEC]()
+		case yNeg:
+			// EXCEPTION: divide by zero, unless y is -∞.
+			return magInf
+		}
+		return z
+
+	case spec.Eq(xm, magInf):
+		switch {
+		case spec.IsZero(ym):
+			// EXCEPTION: invalid operation
+			return nan[SPEC]()
+		case yNeg:
+			return z
+		}
+		return magInf
+
+	case spec.Eq(x, one):
+		if spec.Eq(ym, magInf) {
+			// EXCEPTION: invalid operation
+			return nan[SPEC]()
+		}
+		return one
+
+	case spec.IsZero(ym):
+		return one
+
+	case spec.Eq(ym, magInf):
+		if spec.Gt(xm, one) != yNeg {
+			return magInf
+		}
+		return z
+	}
+
+	return powReal[SPEC](false, toBigFloat[SPEC](xm), y, rounding)
+}
+
+func rootn[SPEC spec[D], D datum](x D, n int, rounding RoundingMode) D {
+	xs, xm := mag[SPEC](x)
+
+	var spec SPEC
+	var z D
+
+	magInf := magInf[SPEC]()
+
+	xNeg := !spec.IsZero(xs)
+	odd := n%2 != 0
+
+	switch {
+	case n == 0:
+		// EXCEPTION: invalid operation
+		return nan[SPEC]()
+	case spec.Gt(xm, magInf):
+		return x
+
+	case spec.IsZero(xm):
+		switch {
+		case n < 0 && odd:
+			// EXCEPTION: divide by zero
+			return inf[SPEC](xNeg)
+		case n < 0:
+			// EXCEPTION: divide by zero
+			return magInf
+		case odd:
+			return x
+		}
+		return z
+
+	case xNeg && !odd:
+		// EXCEPTION: invalid operation
+		return nan[SPEC]()
+
+	case spec.Eq(xm, magInf):
+		if n < 0 {
+			return spec.Or(xs, z)
+		}
+		return x
+	}
+
+	prec := workPrec[SPEC]()
+
+	bx := toBigFloat[SPEC](xm)
+
+	t := bigLog(new(big.Float).SetPrec(prec).Set(bx), prec)
+	t.Quo(t, new(big.Float).SetInt64(int64(n)))
+
+	p, q := int64(1), uint64(n)
+	if n < 0 {
+		p, q = -1, uint64(-n)
+	}
+
+	return rationalPowResult[SPEC](xNeg, bx, p, q, t, prec, rounding)
+}
+
+func compound[SPEC spec[D], D datum](x D, n int, rounding RoundingMode) D {
+	xs, xm := mag[SPEC](x)
+
+	var spec SPEC
+	var z D
+
+	one := one[SPEC]()
+	magInf := magInf[SPEC]()
+
+	xNeg := !spec.IsZero(xs)
+
+	switch {
+	case spec.Gt(xm, magInf):
+		if n == 0 {
+			return one
+		}
+		return x
+
+	case xNeg && spec.Gt(xm, one):
+		// EXCEPTION: invalid operation
+		return nan[SPEC]()
+
+	case n == 0:
+		return one
+
+	case xNeg && spec.Eq(xm, one):
+		if n < 0 {
+			// EXCEPTION: divide by zero
+			return magInf
+		}
+		return z
+
+	case spec.Eq(xm, magInf):
+		if n < 0 {
+			return z
+		}
+		return magInf
+
+	case spec.IsZero(xm):
+		return one
+	}
+
+	prec := workPrec[SPEC]()
+
+	bx := toBigFloat[SPEC](x)
+
+	if u, ok := bigOnePlus(bx); ok {
+		return powInt[SPEC](false, u, int64(n), rounding)
+	}
+
+	t := bigLog1p(new(big.Float).SetPrec(prec).Set(bx), prec)
+	t.Mul(t, new(big.Float).SetInt64(int64(n)))
+
+	return expResult[SPEC](false, t, prec, rounding)
+}
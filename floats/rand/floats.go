@@ -0,0 +1,130 @@
+package rand
+
+import (
+	"github.com/puellanivis/math/floats"
+)
+
+// Float16 returns an IEEE 754 16-bit floating-point number uniformly distributed over the real numbers of [0,1),
+// rounded toward zero, so that every value in [0,1) is returned with a probability equal to
+// its distance from the next larger value.
+func Float16[RND floats.RoundingMode](r *Rand) floats.Float16WithRound[RND] {
+	return floats.Float16WithRoundFromBits[RND](uint16(r.unit(binary16).Lo))
+}
+
+// AnyFloat16 returns an IEEE 754 16-bit floating-point number uniformly distributed over all of its finite values,
+// including the subnormal values, and both positive and negative zero.
+func AnyFloat16[RND floats.RoundingMode](r *Rand) floats.Float16WithRound[RND] {
+	return floats.Float16WithRoundFromBits[RND](uint16(r.finite(binary16).Lo))
+}
+
+// NormFloat16 returns a normally distributed IEEE 754 16-bit floating-point number,
+// with mean 0 and standard deviation 1.
+func NormFloat16[RND floats.RoundingMode](r *Rand) floats.Float16WithRound[RND] {
+	return floats.Float16WithRoundFromFloat[RND](r.norm64())
+}
+
+// ExpFloat16 returns an exponentially distributed IEEE 754 16-bit floating-point number,
+// with rate parameter 1, and so mean 1.
+func ExpFloat16[RND floats.RoundingMode](r *Rand) floats.Float16WithRound[RND] {
+	return floats.Float16WithRoundFromFloat[RND](r.exp64())
+}
+
+// BFloat16 returns a bfloat16 floating-point number uniformly distributed over the real numbers of [0,1),
+// rounded toward zero, so that every value in [0,1) is returned with a probability equal to
+// its distance from the next larger value.
+func BFloat16[RND floats.RoundingMode](r *Rand) floats.BFloat16WithRound[RND] {
+	return floats.BFloat16WithRoundFromBits[RND](uint16(r.unit(bfloat16).Lo))
+}
+
+// AnyBFloat16 returns a bfloat16 floating-point number uniformly distributed over all of its finite values,
+// including the subnormal values, and both positive and negative zero.
+func AnyBFloat16[RND floats.RoundingMode](r *Rand) floats.BFloat16WithRound[RND] {
+	return floats.BFloat16WithRoundFromBits[RND](uint16(r.finite(bfloat16).Lo))
+}
+
+// NormBFloat16 returns a normally distributed bfloat16 floating-point number,
+// with mean 0 and standard deviation 1.
+func NormBFloat16[RND floats.RoundingMode](r *Rand) floats.BFloat16WithRound[RND] {
+	return floats.BFloat16WithRoundFromFloat[RND](r.norm64())
+}
+
+// ExpBFloat16 returns an exponentially distributed bfloat16 floating-point number,
+// with rate parameter 1, and so mean 1.
+func ExpBFloat16[RND floats.RoundingMode](r *Rand) floats.BFloat16WithRound[RND] {
+	return floats.BFloat16WithRoundFromFloat[RND](r.exp64())
+}
+
+// Float32 returns an IEEE 754 32-bit floating-point number uniformly distributed over the real numbers of [0,1),
+// rounded toward zero, so that every value in [0,1) is returned with a probability equal to
+// its distance from the next larger value.
+func Float32[RND floats.RoundingMode](r *Rand) floats.Float32WithRound[RND] {
+	return floats.Float32WithRoundFromBits[RND](uint32(r.unit(binary32).Lo))
+}
+
+// AnyFloat32 returns an IEEE 754 32-bit floating-point number uniformly distributed over all of its finite values,
+// including the subnormal values, and both positive and negative zero.
+func AnyFloat32[RND floats.RoundingMode](r *Rand) floats.Float32WithRound[RND] {
+	return floats.Float32WithRoundFromBits[RND](uint32(r.finite(binary32).Lo))
+}
+
+// NormFloat32 returns a normally distributed IEEE 754 32-bit floating-point number,
+// with mean 0 and standard deviation 1.
+func NormFloat32[RND floats.RoundingMode](r *Rand) floats.Float32WithRound[RND] {
+	return floats.Float32WithRoundFromFloat[RND](r.norm64())
+}
+
+// ExpFloat32 returns an exponentially distributed IEEE 754 32-bit floating-point number,
+// with rate parameter 1, and so mean 1.
+func ExpFloat32[RND floats.RoundingMode](r *Rand) floats.Float32WithRound[RND] {
+	return floats.Float32WithRoundFromFloat[RND](r.exp64())
+}
+
+// Float64 returns an IEEE 754 64-bit floating-point number uniformly distributed over the real numbers of [0,1),
+// rounded toward zero, so that every value in [0,1) is returned with a probability equal to
+// its distance from the next larger value.
+func Float64[RND floats.RoundingMode](r *Rand) floats.Float64WithRound[RND] {
+	return floats.Float64WithRoundFromBits[RND](r.unit(binary64).Lo)
+}
+
+// AnyFloat64 returns an IEEE 754 64-bit floating-point number uniformly distributed over all of its finite values,
+// including the subnormal values, and both positive and negative zero.
+func AnyFloat64[RND floats.RoundingMode](r *Rand) floats.Float64WithRound[RND] {
+	return floats.Float64WithRoundFromBits[RND](r.finite(binary64).Lo)
+}
+
+// NormFloat64 returns a normally distributed IEEE 754 64-bit floating-point number,
+// with mean 0 and standard deviation 1.
+func NormFloat64[RND floats.RoundingMode](r *Rand) floats.Float64WithRound[RND] {
+	return floats.Float128WithRoundFromBits[RND](r.norm128().Bits()).Float64()
+}
+
+// ExpFloat64 returns an exponentially distributed IEEE 754 64-bit floating-point number,
+// with rate parameter 1, and so mean 1.
+func ExpFloat64[RND floats.RoundingMode](r *Rand) floats.Float64WithRound[RND] {
+	return floats.Float128WithRoundFromBits[RND](r.exp128().Bits()).Float64()
+}
+
+// Float128 returns an IEEE 754 128-bit floating-point number uniformly distributed over the real numbers of [0,1),
+// rounded toward zero, so that every value in [0,1) is returned with a probability equal to
+// its distance from the next larger value.
+func Float128[RND floats.RoundingMode](r *Rand) floats.Float128WithRound[RND] {
+	return floats.Float128WithRoundFromBits[RND](r.unit(binary128))
+}
+
+// AnyFloat128 returns an IEEE 754 128-bit floating-point number uniformly distributed over all of its finite values,
+// including the subnormal values, and both positive and negative zero.
+func AnyFloat128[RND floats.RoundingMode](r *Rand) floats.Float128WithRound[RND] {
+	return floats.Float128WithRoundFromBits[RND](r.finite(binary128))
+}
+
+// NormFloat128 returns a normally distributed IEEE 754 128-bit floating-point number,
+// with mean 0 and standard deviation 1.
+func NormFloat128[RND floats.RoundingMode](r *Rand) floats.Float128WithRound[RND] {
+	return floats.Float128WithRoundFromBits[RND](r.norm128().Bits())
+}
+
+// ExpFloat128 returns an exponentially distributed IEEE 754 128-bit floating-point number,
+// with rate parameter 1, and so mean 1.
+func ExpFloat128[RND floats.RoundingMode](r *Rand) floats.Float128WithRound[RND] {
+	return floats.Float128WithRoundFromBits[RND](r.exp128().Bits())
+}
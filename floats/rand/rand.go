@@ -0,0 +1,227 @@
+// Package rand samples random floating-point numbers of every floats type from a math/rand/v2 source.
+//
+// Each type has four distributions:
+// a uniform distribution over the real numbers of [0,1), rounded toward zero to the type;
+// a uniform distribution over every finite value of the type, including the subnormals and both zeros;
+// a standard normal distribution;
+// and a standard exponential distribution.
+//
+// Values are built directly from the bits of the source, rather than by converting a float64,
+// so that the small values of a type are just as well sampled as the large ones,
+// and no type is limited to the 53 bits of precision of a float64.
+package rand
+
+import (
+	"math"
+	stdbits "math/bits"
+	stdrand "math/rand/v2"
+
+	"github.com/puellanivis/math/bits"
+	"github.com/puellanivis/math/floats"
+)
+
+// Rand is a source of random floating-point numbers.
+//
+// A Rand is not safe for concurrent use, unless its source is,
+// and all calls are serialized by the caller.
+type Rand struct {
+	src stdrand.Source
+
+	// buf holds n unused random bits in its most-significant bits.
+	buf uint64
+	n   int
+}
+
+// New returns a new Rand that takes its random bits from src.
+func New(src stdrand.Source) *Rand {
+	return &Rand{
+		src: src,
+	}
+}
+
+// uint64 returns k random bits, where 0 ≤ k ≤ 64.
+func (r *Rand) uint64(k int) uint64 {
+	if k <= r.n {
+		v := r.buf >> (64 - k)
+		r.buf <<= k
+		r.n -= k
+		return v
+	}
+
+	// Take what is left in the buffer, and the remainder from a fresh word.
+	need := k - r.n
+	v := r.buf >> (64 - r.n) << need
+
+	w := r.src.Uint64()
+	v |= w >> (64 - need)
+
+	r.buf, r.n = w<<need, 64-need
+	return v
+}
+
+// zeros reads random bits until it reads a one bit, or it has read limit zero bits.
+// It returns the number of zero bits read.
+func (r *Rand) zeros(limit int) int {
+	var n int
+
+	for n < limit {
+		if r.n == 0 {
+			r.buf, r.n = r.src.Uint64(), 64
+		}
+
+		z := min(stdbits.LeadingZeros64(r.buf), r.n, limit-n)
+		r.buf <<= z
+		r.n -= z
+		n += z
+
+		if n < limit && r.n > 0 {
+			// The next bit is the one bit.
+			r.buf <<= 1
+			r.n--
+			return n
+		}
+	}
+
+	return n
+}
+
+// format describes the encoding of a binary floating-point type.
+type format struct {
+	mantWidth int
+	expWidth  int
+}
+
+var (
+	binary16  = format{mantWidth: 10, expWidth: 5}
+	bfloat16  = format{mantWidth: 7, expWidth: 8}
+	binary32  = format{mantWidth: 23, expWidth: 8}
+	binary64  = format{mantWidth: 52, expWidth: 11}
+	binary128 = format{mantWidth: 112, expWidth: 15}
+)
+
+// width returns the total number of bits in the format.
+func (f format) width() int {
+	return 1 + f.expWidth + f.mantWidth
+}
+
+// bits returns k random bits, where 0 ≤ k ≤ 128.
+func (r *Rand) bits(k int) bits.Uint128 {
+	if k <= 64 {
+		return bits.Uint128{Lo: r.uint64(k)}
+	}
+
+	hi := r.uint64(k - 64)
+	return bits.Uint128{Hi: hi, Lo: r.uint64(64)}
+}
+
+// unit returns the encoding of a uniformly distributed real number in [0,1) rounded toward zero to f.
+//
+// The binary expansion of such a number is an endless stream of random bits.
+// Its leading zero bits select the exponent, and the bits that follow the first one bit are the mantissa.
+// Should the stream reach the subnormal range without a one bit,
+// then the next bits of the stream are the mantissa of the subnormal.
+func (r *Rand) unit(f format) bits.Uint128 {
+	bias := 1<<(f.expWidth-1) - 1
+
+	exp := bias - 1 - r.zeros(bias-1)
+	mant := r.bits(f.mantWidth)
+
+	return or(shl(bits.Uint128{Lo: uint64(exp)}, f.mantWidth), mant)
+}
+
+// finite returns the encoding of a value of f chosen uniformly from all of its finite values.
+func (r *Rand) finite(f format) bits.Uint128 {
+	expMask := 1<<f.expWidth - 1
+
+	for {
+		v := r.bits(f.width())
+
+		if exp := int(shr(v, f.mantWidth).Lo) & expMask; exp != expMask {
+			return v
+		}
+
+		// Infinities and NaNs are rejected, and another value drawn.
+	}
+}
+
+// sign returns x with its sign bit set at random.
+func (r *Rand) sign(f format, x bits.Uint128) bits.Uint128 {
+	return or(x, shl(bits.Uint128{Lo: r.uint64(1)}, f.width()-1))
+}
+
+func shl(x bits.Uint128, k int) bits.Uint128 {
+	var b bits.Bits128
+	return b.Shl(x, k)
+}
+
+func shr(x bits.Uint128, k int) bits.Uint128 {
+	var b bits.Bits128
+	return b.Shr(x, k)
+}
+
+func or(x, y bits.Uint128) bits.Uint128 {
+	var b bits.Bits128
+	return b.Or(x, y)
+}
+
+// exp64 returns a standard exponentially distributed float64.
+//
+// If U is uniform over (0,1], then -ln(U) is exponentially distributed.
+// Halving U adds ln(2) to the result, so each leading zero bit of U adds ln(2),
+// and the remaining U in (½,1] is 1 - w, with w uniform over [0,½),
+// for which -ln(1-w) keeps the full precision of w.
+func (r *Rand) exp64() float64 {
+	g := r.zeros(math.MaxInt)
+	w := math.Float64frombits(r.unit(binary64).Lo) / 2
+
+	return float64(g)*math.Ln2 - math.Log1p(-w)
+}
+
+// norm64 returns a standard normally distributed float64.
+//
+// This is the polar method of Marsaglia, with a point (u,v) chosen uniformly in the unit disc.
+// As the squared radius s of that point is uniform over (0,1) and independent of its angle,
+// the -ln(s) of the polar method is replaced by an independent exponential sample,
+// which has precision over its whole range.
+func (r *Rand) norm64() float64 {
+	for {
+		u := math.Float64frombits(r.sign(binary64, r.unit(binary64)).Lo)
+		v := math.Float64frombits(r.unit(binary64).Lo)
+
+		s := u*u + v*v
+		if s >= 1 || s == 0 {
+			continue
+		}
+
+		return u * math.Sqrt(2*r.exp64()/s)
+	}
+}
+
+// ln2 is the natural logarithm of 2, rounded to a Float128.
+var ln2 = floats.Float128FromFloat(1.0).Log1p()
+
+// exp128 is the same as exp64, only evaluated with Float128 arithmetic.
+func (r *Rand) exp128() floats.Float128 {
+	g := r.zeros(math.MaxInt)
+	w := floats.Float128WithRoundFromBits[floats.RoundTiesToEven](r.unit(binary128)).LdExp(-1)
+
+	return floats.Float128FromFloat(float64(g)).Mul(ln2).Sub(w.Neg().Log1p())
+}
+
+// norm128 is the same as norm64, only evaluated with Float128 arithmetic.
+func (r *Rand) norm128() floats.Float128 {
+	one := floats.Float128FromFloat(1.0)
+	two := floats.Float128FromFloat(2.0)
+
+	for {
+		u := floats.Float128WithRoundFromBits[floats.RoundTiesToEven](r.sign(binary128, r.unit(binary128)))
+		v := floats.Float128WithRoundFromBits[floats.RoundTiesToEven](r.unit(binary128))
+
+		s := u.Mul(u).Add(v.Mul(v))
+		if !s.Less(one) || s.IsZero() {
+			continue
+		}
+
+		return u.Mul(two.Mul(r.exp128()).Div(s).Sqrt())
+	}
+}
@@ -0,0 +1,238 @@
+package rand
+
+import (
+	"math"
+	stdrand "math/rand/v2"
+	"testing"
+
+	"github.com/puellanivis/math/floats"
+)
+
+type RNE = floats.RoundTiesToEven
+
+func newTestRand() *Rand {
+	return New(stdrand.NewPCG(1, 2))
+}
+
+// within reports whether count is within 6 standard deviations of the count expected of n trials with probability p.
+func within(count, n int, p float64) bool {
+	mean := float64(n) * p
+	sd := math.Sqrt(mean * (1 - p))
+
+	return math.Abs(float64(count)-mean) <= 6*sd+1
+}
+
+func TestBits(t *testing.T) {
+	r := newTestRand()
+	ref := stdrand.New(stdrand.NewPCG(1, 2))
+
+	// Bits are taken from the source most-significant first, regardless of how they are split up.
+	w0, w1 := ref.Uint64(), ref.Uint64()
+
+	if got := r.uint64(3); got != w0>>61 {
+		t.Errorf("uint64(3) = %#x, expected %#x", got, w0>>61)
+	}
+
+	if got := r.uint64(64); got != w0<<3|w1>>61 {
+		t.Errorf("uint64(64) = %#x, expected %#x", got, w0<<3|w1>>61)
+	}
+
+	if got := r.uint64(0); got != 0 {
+		t.Errorf("uint64(0) = %#x, expected 0", got)
+	}
+
+	if got := r.uint64(61); got != w1&(1<<61-1) {
+		t.Errorf("uint64(61) = %#x, expected %#x", got, w1&(1<<61-1))
+	}
+}
+
+func TestZeros(t *testing.T) {
+	const n = 1 << 16
+
+	r := newTestRand()
+
+	counts := make(map[int]int)
+	for i := 0; i < n; i++ {
+		counts[r.zeros(8)]++
+	}
+
+	for z := 0; z < 8; z++ {
+		if !within(counts[z], n, math.Ldexp(1, -(z+1))) {
+			t.Errorf("zeros(8) = %d occurred %d times in %d", z, counts[z], n)
+		}
+	}
+
+	if !within(counts[8], n, math.Ldexp(1, -8)) {
+		t.Errorf("zeros(8) = 8 occurred %d times in %d", counts[8], n)
+	}
+}
+
+func TestFloat16(t *testing.T) {
+	const n = 1 << 20
+
+	r := newTestRand()
+
+	// Every value of a binade is equally likely, and each binade is half as likely as the one above it.
+	// The subnormals are as likely as the lowest normal binade.
+	counts := make(map[uint16]int)
+
+	for i := 0; i < n; i++ {
+		x := Float16[RNE](r)
+
+		if x.Sign() < 0 || x.SignBit() || !x.Less(floats.Float16FromFloat(1.0)) {
+			t.Fatalf("Float16() = %v, expected a value in [0,1)", x)
+		}
+
+		counts[x.Bits()>>10]++
+	}
+
+	for exp := uint16(0); exp < 15; exp++ {
+		p := math.Ldexp(1, int(exp)-15)
+		if exp == 0 {
+			p = math.Ldexp(1, -14)
+		}
+
+		if !within(counts[exp], n, p) {
+			t.Errorf("Float16() had exponent %d occur %d times in %d", exp, counts[exp], n)
+		}
+	}
+}
+
+func TestAnyFloat16(t *testing.T) {
+	const n = 1 << 20
+
+	r := newTestRand()
+
+	var subnormal, negative int
+
+	for i := 0; i < n; i++ {
+		x := AnyFloat16[RNE](r)
+
+		if !x.IsFinite() {
+			t.Fatalf("AnyFloat16() = %v, expected a finite value", x)
+		}
+
+		if x.IsSubnormal() || x.IsZero() {
+			subnormal++
+		}
+
+		if x.SignBit() {
+			negative++
+		}
+	}
+
+	// There are 31 exponents of finite values, one of which is the subnormals and zero.
+	if !within(subnormal, n, 1.0/31) {
+		t.Errorf("AnyFloat16() was subnormal or zero %d times in %d", subnormal, n)
+	}
+
+	if !within(negative, n, 0.5) {
+		t.Errorf("AnyFloat16() was negative %d times in %d", negative, n)
+	}
+}
+
+func TestFloat128(t *testing.T) {
+	r := newTestRand()
+
+	one := floats.Float128FromFloat(1.0)
+	half := floats.Float128FromFloat(0.5)
+
+	var low int
+
+	for i := 0; i < 1<<12; i++ {
+		x := Float128[RNE](r)
+
+		if x.SignBit() || !x.Less(one) {
+			t.Fatalf("Float128() = %v, expected a value in [0,1)", x)
+		}
+
+		if x.Less(half) {
+			low++
+		}
+
+		// The mantissa is filled out below the precision of a float64.
+		if x.Bits().Lo&(1<<60-1) == 0 {
+			t.Errorf("Float128() = %v has only %#x in its low word", x, x.Bits().Lo)
+		}
+	}
+
+	if !within(low, 1<<12, 0.5) {
+		t.Errorf("Float128() was less than ½ %d times in %d", low, 1<<12)
+	}
+}
+
+// moments returns the mean and variance of n samples taken from next.
+func moments(n int, next func() float64) (mean, variance float64) {
+	var sum, sum2 float64
+
+	for i := 0; i < n; i++ {
+		x := next()
+		sum += x
+		sum2 += x * x
+	}
+
+	mean = sum / float64(n)
+	return mean, sum2/float64(n) - mean*mean
+}
+
+func TestNormal(t *testing.T) {
+	r := newTestRand()
+
+	tests := []struct {
+		name string
+		n    int
+		next func() float64
+	}{
+		{"Float16", 1 << 16, func() float64 { return NormFloat16[RNE](r).Float64().Native() }},
+		{"BFloat16", 1 << 16, func() float64 { return NormBFloat16[RNE](r).Float64().Native() }},
+		{"Float32", 1 << 16, func() float64 { return NormFloat32[RNE](r).Float64().Native() }},
+		{"Float64", 1 << 10, func() float64 { return NormFloat64[RNE](r).Native() }},
+		{"Float128", 1 << 10, func() float64 { return NormFloat128[RNE](r).Float64().Native() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mean, variance := moments(tt.n, tt.next)
+
+			// The standard error of the mean is 1/√n, and that of the variance √(2/n).
+			if math.Abs(mean) > 6/math.Sqrt(float64(tt.n)) {
+				t.Errorf("mean of %d samples = %g, expected 0", tt.n, mean)
+			}
+
+			if math.Abs(variance-1) > 6*math.Sqrt(2/float64(tt.n)) {
+				t.Errorf("variance of %d samples = %g, expected 1", tt.n, variance)
+			}
+		})
+	}
+}
+
+func TestExponential(t *testing.T) {
+	r := newTestRand()
+
+	tests := []struct {
+		name string
+		n    int
+		next func() float64
+	}{
+		{"Float16", 1 << 16, func() float64 { return ExpFloat16[RNE](r).Float64().Native() }},
+		{"BFloat16", 1 << 16, func() float64 { return ExpBFloat16[RNE](r).Float64().Native() }},
+		{"Float32", 1 << 16, func() float64 { return ExpFloat32[RNE](r).Float64().Native() }},
+		{"Float64", 1 << 10, func() float64 { return ExpFloat64[RNE](r).Native() }},
+		{"Float128", 1 << 10, func() float64 { return ExpFloat128[RNE](r).Float64().Native() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mean, variance := moments(tt.n, tt.next)
+
+			// The standard error of the mean is 1/√n, and that of the variance √(8/n).
+			if math.Abs(mean-1) > 6/math.Sqrt(float64(tt.n)) {
+				t.Errorf("mean of %d samples = %g, expected 1", tt.n, mean)
+			}
+
+			if math.Abs(variance-1) > 6*math.Sqrt(8/float64(tt.n)) {
+				t.Errorf("variance of %d samples = %g, expected 1", tt.n, variance)
+			}
+		})
+	}
+}
@@ -2,8 +2,6 @@ package floats
 
 import (
 	"fmt"
-
-	"github.com/puellanivis/math/bits"
 )
 
 var _ = fmt.Println
@@ -11,12 +9,10 @@ var _ = fmt.Println
 // RoundingMode defines a rounding mode.
 type RoundingMode interface {
 	finiteOverflow(sign bool) bool
+	bulkBias() bulkBias
 
-	round16(f *binary[binary16, uint16])
-	round32(f *binary[binary32, uint32])
-	round64(f *binary[binary64, uint64])
-	round128(f *binary[binary128, bits.Uint128])
-	roundBF16(f *binary[bfloat16, uint16])
+	// native reports whether the rounding mode is that of native float32 and float64 arithmetic.
+	native() bool
 }
 
 func incAway[SPEC spec[D], D datum]() D {
@@ -31,24 +27,10 @@ func incNear[SPEC spec[D], D datum]() D {
 	return spec.Pow2(spec.expWidth() - 1)
 }
 
-func incNearEven[SPEC spec[D], D datum](x D) D {
+func incNearZero[SPEC spec[D], D datum]() D {
 	var spec SPEC
-	var z D
-
-	x = spec.And(spec.Shr(x, spec.expWidth()), spec.Pow2(0))
 
-	m, _ := spec.Add(incNear[SPEC](), spec.Not(z), x)
-	return m
-}
-
-func incNearOdd[SPEC spec[D], D datum](x D) D {
-	var spec SPEC
-	var z D
-
-	x = spec.And(spec.Not(spec.Shr(x, spec.expWidth())), spec.Pow2(0))
-
-	m, _ := spec.Add(incNear[SPEC](), spec.Not(z), x)
-	return m
+	return spec.Dec(incNear[SPEC]())
 }
 
 func overflow[SPEC spec[D], D datum](sign bool, rounding RoundingMode) D {
@@ -62,20 +44,62 @@ func overflow[SPEC spec[D], D datum](sign bool, rounding RoundingMode) D {
 	return x
 }
 
-func applyRounding[B binary[SPEC, D], SPEC spec[D], D datum](f *B, rounding RoundingMode) {
-	switch f := any(f).(type) {
-	case *binary[binary16, uint16]:
-		rounding.round16(f)
-	case *binary[binary32, uint32]:
-		rounding.round32(f)
-	case *binary[binary64, uint64]:
-		rounding.round64(f)
-	case *binary[binary128, bits.Uint128]:
-		rounding.round128(f)
-	case *binary[bfloat16, uint16]:
-		rounding.roundBF16(f)
-	default:
-		panic(fmt.Errorf("unsupported type in closed type-switch: %T", f))
+// towardNegative reports whether rounding rounds toward negative infinity,
+// as its bulkBias rounds up everything that is negative, and nothing that is positive.
+func towardNegative(rounding RoundingMode) bool {
+	b := rounding.bulkBias()
+	return b == bulkBias{biasNone, biasNone, biasAll, biasAll}
+}
+
+// toNearest reports whether rounding rounds to nearest,
+// as its bulkBias rounds up from about the halfway point, whatever the sign.
+func toNearest(rounding RoundingMode) bool {
+	for _, b := range rounding.bulkBias() {
+		if b == biasNone || b == biasAll {
+			return false
+		}
+	}
+
+	return true
+}
+
+// applyRounding rounds the mantissa of f to its width, less the guard bits, per the bulkBias of rounding.
+//
+// Every rounding mode is an empty struct, so they all share one GC shape,
+// and making this generic over the rounding mode would still fetch the bulkBias through a dictionary.
+// Here it is fetched once per rounding, by a single indirect call, which costs no more than that.
+func applyRounding[SPEC spec[D], D datum](f *binary[SPEC, D], rounding RoundingMode) {
+	var spec SPEC
+
+	// Only finite values are ever rounded, so an exponent this large can only be an overflow.
+	if f.e >= expMax[SPEC]() {
+		// EXCEPTION: overflow
+		*f = decode[SPEC](overflow[SPEC](f.s, rounding))
+		return
+	}
+
+	var idx int
+	if f.s {
+		idx = 2
+	}
+	if !spec.IsZero(spec.And(f.m, spec.Pow2(spec.expWidth()))) {
+		idx |= 1
+	}
+
+	switch rounding.bulkBias()[idx] {
+	case biasUnderHalf:
+		f.add(incNearZero[SPEC]())
+	case biasHalf:
+		f.add(incNear[SPEC]())
+	case biasAll:
+		f.add(incAway[SPEC]())
+	}
+
+	f.trunc()
+
+	if f.isInf() {
+		// EXCEPTION: overflow, as rounding up carried out of the largest finite value.
+		*f = decode[SPEC](overflow[SPEC](f.s, rounding))
 	}
 }
 
@@ -89,24 +113,12 @@ func (RoundTowardZero) finiteOverflow(_ bool) bool {
 	return true
 }
 
-func (RoundTowardZero) round16(f *binary[binary16, uint16]) {
-	f.trunc()
-}
-
-func (RoundTowardZero) round32(f *binary[binary32, uint32]) {
-	f.trunc()
-}
-
-func (RoundTowardZero) round64(f *binary[binary64, uint64]) {
-	f.trunc()
-}
-
-func (RoundTowardZero) round128(f *binary[binary128, bits.Uint128]) {
-	f.trunc()
+func (RoundTowardZero) native() bool {
+	return false
 }
 
-func (RoundTowardZero) roundBF16(f *binary[bfloat16, uint16]) {
-	f.trunc()
+func (RoundTowardZero) bulkBias() bulkBias {
+	return bulkBias{biasNone, biasNone, biasNone, biasNone}
 }
 
 // RoundTowardPositive rounds infinitely precise results to the floating-point numbers
@@ -119,74 +131,12 @@ func (RoundTowardPositive) finiteOverflow(sign bool) bool {
 	return sign
 }
 
-func (RoundTowardPositive) round16(f *binary[binary16, uint16]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case !f.s:
-		// for positive numbers, this is a round away from zero.
-		f.add(incAway[binary16]())
-		fallthrough
-	default:
-		// for negative numbers, this is a truncation
-		f.trunc()
-	}
-}
-
-func (RoundTowardPositive) round32(f *binary[binary32, uint32]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case !f.s:
-		// for positive numbers, this is a round away from zero.
-		f.add(incAway[binary32]())
-		fallthrough
-	default:
-		// for negative numbers, this is a truncation
-		f.trunc()
-	}
-}
-
-func (RoundTowardPositive) round64(f *binary[binary64, uint64]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case !f.s:
-		// for positive numbers, this is a round away from zero.
-		f.add(incAway[binary64]())
-		fallthrough
-	default:
-		// for negative numbers, this is a truncation
-		f.trunc()
-	}
-}
-
-func (RoundTowardPositive) round128(f *binary[binary128, bits.Uint128]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case !f.s:
-		// for positive numbers, this is a round away from zero.
-		f.add(incAway[binary128]())
-		fallthrough
-	default:
-		// for negative numbers, this is a truncation
-		f.trunc()
-	}
+func (RoundTowardPositive) native() bool {
+	return false
 }
 
-func (RoundTowardPositive) roundBF16(f *binary[bfloat16, uint16]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case !f.s:
-		// for positive numbers, this is a round away from zero.
-		f.add(incAway[binary16]())
-		fallthrough
-	default:
-		// for negative numbers, this is a truncation
-		f.trunc()
-	}
+func (RoundTowardPositive) bulkBias() bulkBias {
+	return bulkBias{biasAll, biasAll, biasNone, biasNone}
 }
 
 // RoundTowardNegative rounds infinitely precise results to the floating-point numbers
@@ -199,74 +149,12 @@ func (RoundTowardNegative) finiteOverflow(sign bool) bool {
 	return !sign
 }
 
-func (RoundTowardNegative) round16(f *binary[binary16, uint16]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case f.s:
-		// for negative numbers, this is a round away from zero.
-		f.add(incAway[binary16]())
-		fallthrough
-	default:
-		// for positive numbers, this is a truncation.
-		f.trunc()
-	}
-}
-
-func (RoundTowardNegative) round32(f *binary[binary32, uint32]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case f.s:
-		// for negative numbers, this is a round away from zero.
-		f.add(incAway[binary32]())
-		fallthrough
-	default:
-		// for positive numbers, this is a truncation.
-		f.trunc()
-	}
-}
-
-func (RoundTowardNegative) round64(f *binary[binary64, uint64]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case f.s:
-		// for negative numbers, this is a round away from zero.
-		f.add(incAway[binary64]())
-		fallthrough
-	default:
-		// for positive numbers, this is a truncation.
-		f.trunc()
-	}
-}
-
-func (RoundTowardNegative) round128(f *binary[binary128, bits.Uint128]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case f.s:
-		// for negative numbers, this is a round away from zero.
-		f.add(incAway[binary128]())
-		fallthrough
-	default:
-		// for positive numbers, this is a truncation.
-		f.trunc()
-	}
+func (RoundTowardNegative) native() bool {
+	return false
 }
 
-func (RoundTowardNegative) roundBF16(f *binary[bfloat16, uint16]) {
-	inf, nan := f.classify()
-	switch {
-	case inf, nan:
-	case f.s:
-		// for negative numbers, this is a round away from zero.
-		f.add(incAway[binary16]())
-		fallthrough
-	default:
-		// for positive numbers, this is a truncation.
-		f.trunc()
-	}
+func (RoundTowardNegative) bulkBias() bulkBias {
+	return bulkBias{biasNone, biasNone, biasAll, biasAll}
 }
 
 // RoundTiesToAway rounds infinitely precise results to the floating-point numbers
@@ -281,49 +169,12 @@ func (RoundTiesToAway) finiteOverflow(_ bool) bool {
 	return false
 }
 
-func (RoundTiesToAway) round16(f *binary[binary16, uint16]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNear[binary16]())
-	f.trunc()
-}
-
-func (RoundTiesToAway) round32(f *binary[binary32, uint32]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNear[binary32]())
-	f.trunc()
-}
-
-func (RoundTiesToAway) round64(f *binary[binary64, uint64]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNear[binary64]())
-	f.trunc()
-}
-
-func (RoundTiesToAway) round128(f *binary[binary128, bits.Uint128]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNear[binary128]())
-	f.trunc()
+func (RoundTiesToAway) native() bool {
+	return false
 }
 
-func (RoundTiesToAway) roundBF16(f *binary[bfloat16, uint16]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNear[binary16]())
-	f.trunc()
+func (RoundTiesToAway) bulkBias() bulkBias {
+	return bulkBias{biasHalf, biasHalf, biasHalf, biasHalf}
 }
 
 // RoundTiesToEven rounds infinitely precise results to the floating-point numbers
@@ -338,49 +189,12 @@ func (RoundTiesToEven) finiteOverflow(_ bool) bool {
 	return false
 }
 
-func (RoundTiesToEven) round16(f *binary[binary16, uint16]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearEven[binary16](f.m))
-	f.trunc()
-}
-
-func (RoundTiesToEven) round32(f *binary[binary32, uint32]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearEven[binary32](f.m))
-	f.trunc()
-}
-
-func (RoundTiesToEven) round64(f *binary[binary64, uint64]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearEven[binary64](f.m))
-	f.trunc()
-}
-
-func (RoundTiesToEven) round128(f *binary[binary128, bits.Uint128]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearEven[binary128](f.m))
-	f.trunc()
+func (RoundTiesToEven) native() bool {
+	return true
 }
 
-func (RoundTiesToEven) roundBF16(f *binary[bfloat16, uint16]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearEven[bfloat16](f.m))
-	f.trunc()
+func (RoundTiesToEven) bulkBias() bulkBias {
+	return bulkBias{biasUnderHalf, biasHalf, biasUnderHalf, biasHalf}
 }
 
 // RoundTiesToOdd rounds infinitely precise results to the floating-point numbers
@@ -393,47 +207,30 @@ func (RoundTiesToOdd) finiteOverflow(_ bool) bool {
 	return false
 }
 
-func (RoundTiesToOdd) round16(f *binary[binary16, uint16]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearOdd[binary16](f.m))
-	f.trunc()
+func (RoundTiesToOdd) native() bool {
+	return false
 }
 
-func (RoundTiesToOdd) round32(f *binary[binary32, uint32]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearOdd[binary32](f.m))
-	f.trunc()
+func (RoundTiesToOdd) bulkBias() bulkBias {
+	return bulkBias{biasHalf, biasUnderHalf, biasHalf, biasUnderHalf}
 }
 
-func (RoundTiesToOdd) round64(f *binary[binary64, uint64]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearOdd[binary64](f.m))
-	f.trunc()
+// RoundTiesToZero rounds infinitely precise results to the floating-point numbers
+// (possibly ±∞) nearest to the infinitely precise result;
+// if the two nearest floating-point numbers bracketing an unrepresentable infinitely precise result are equally near,
+// it will return the one with smaller magnitude.
+//
+// IEEE-754 roundTiesTowardZero, which is only used by the augmented operations.
+type RoundTiesToZero struct{}
+
+func (RoundTiesToZero) finiteOverflow(_ bool) bool {
+	return false
 }
 
-func (RoundTiesToOdd) round128(f *binary[binary128, bits.Uint128]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearOdd[binary128](f.m))
-	f.trunc()
+func (RoundTiesToZero) native() bool {
+	return false
 }
 
-func (RoundTiesToOdd) roundBF16(f *binary[bfloat16, uint16]) {
-	inf, nan := f.classify()
-	if inf || nan {
-		return
-	}
-	f.add(incNearOdd[bfloat16](f.m))
-	f.trunc()
+func (RoundTiesToZero) bulkBias() bulkBias {
+	return bulkBias{biasUnderHalf, biasUnderHalf, biasUnderHalf, biasUnderHalf}
 }
@@ -6,4 +6,5 @@ var (
 	_ RoundingMode = RoundTowardNegative{}
 	_ RoundingMode = RoundTiesToAway{}
 	_ RoundingMode = RoundTiesToEven{}
+	_ RoundingMode = RoundTiesToZero{}
 )
@@ -0,0 +1,582 @@
+// Package safetensors reads and writes safetensors files of floating-point tensors.
+//
+// A safetensors file is an 8-byte little-endian header size, a JSON header describing each tensor,
+// and then the little-endian data of all of the tensors.
+//
+// Files are read through an io.ReaderAt, and only the bytes of the tensors asked for are read,
+// so a memory-mapped file may be used to avoid reading a whole checkpoint.
+package safetensors
+
+import (
+	"bytes"
+	stdbinary "encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	stdbits "math/bits"
+	"sort"
+
+	"github.com/puellanivis/math/floats"
+)
+
+// DType is the element type of a tensor.
+type DType string
+
+// The floating-point element types.
+const (
+	F16    DType = "F16"
+	BF16   DType = "BF16"
+	F32    DType = "F32"
+	F64    DType = "F64"
+	F8E4M3 DType = "F8_E4M3"
+	F8E5M2 DType = "F8_E5M2"
+)
+
+// Size returns the size of each element in bytes, or 0 if the dtype is not supported.
+func (d DType) Size() int {
+	switch d {
+	case F8E4M3, F8E5M2:
+		return 1
+	case F16, BF16:
+		return 2
+	case F32:
+		return 4
+	case F64:
+		return 8
+	}
+
+	return 0
+}
+
+// metadataKey is the header key holding the free-form string metadata, rather than a tensor.
+const metadataKey = "__metadata__"
+
+// maxHeaderSize limits the size of the header, so that a corrupt size cannot cause an enormous allocation.
+const maxHeaderSize = 100 << 20
+
+// Element is the set of types that tensors may be read into, or written from.
+//
+// There is no 8-bit floating-point type in floats,
+// so F8_E4M3 and F8_E5M2 tensors are read into float32 or float64, and are written with AddRaw.
+type Element interface {
+	floats.Float16 | floats.BFloat16 | floats.Float32 | floats.Float64 | float32 | float64
+}
+
+// Tensor is an n-dimensional array of floating-point numbers in row-major order.
+type Tensor[T Element] struct {
+	// Shape is the size of each dimension of the tensor, which is empty for a scalar.
+	Shape []int
+
+	// Data holds the elements of the tensor.
+	Data []T
+}
+
+// TensorInfo describes a tensor stored in a file.
+type TensorInfo struct {
+	DType DType `json:"dtype"`
+	Shape []int `json:"shape"`
+
+	// DataOffsets are the start and end of the tensor’s data, relative to the end of the header.
+	DataOffsets [2]int64 `json:"data_offsets"`
+}
+
+// Len returns the number of elements in the tensor,
+// or -1 if a dimension is negative, or the number of elements overflows an int.
+func (ti TensorInfo) Len() int {
+	for _, dim := range ti.Shape {
+		switch {
+		case dim < 0:
+			return -1
+		case dim == 0:
+			return 0
+		}
+	}
+
+	n := 1
+	for _, dim := range ti.Shape {
+		hi, lo := stdbits.Mul64(uint64(n), uint64(dim))
+		if hi != 0 || lo > math.MaxInt {
+			return -1
+		}
+		n = int(lo)
+	}
+	return n
+}
+
+// File is a safetensors file opened for reading.
+type File struct {
+	r         io.ReaderAt
+	dataStart int64
+
+	// Metadata is the free-form string metadata of the file.
+	Metadata map[string]string
+
+	tensors map[string]TensorInfo
+	names   []string
+}
+
+// Open returns a File reading from r, which has the given size.
+// The header is read and validated, but no tensor data is read.
+func Open(r io.ReaderAt, size int64) (*File, error) {
+	var pre [8]byte
+	if _, err := r.ReadAt(pre[:], 0); err != nil {
+		return nil, fmt.Errorf("safetensors: reading header size: %w", err)
+	}
+
+	n := stdbinary.LittleEndian.Uint64(pre[:])
+	if n > maxHeaderSize || int64(n) > size-8 {
+		return nil, fmt.Errorf("safetensors: header size %d is too large", n)
+	}
+
+	header := make([]byte, n)
+	if _, err := r.ReadAt(header, 8); err != nil {
+		return nil, fmt.Errorf("safetensors: reading header: %w", err)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(header, &raw); err != nil {
+		return nil, fmt.Errorf("safetensors: bad header: %w", err)
+	}
+
+	f := &File{
+		r:         r,
+		dataStart: 8 + int64(n),
+		tensors:   make(map[string]TensorInfo),
+	}
+
+	for name, msg := range raw {
+		if name == metadataKey {
+			if err := json.Unmarshal(msg, &f.Metadata); err != nil {
+				return nil, fmt.Errorf("safetensors: bad metadata: %w", err)
+			}
+			continue
+		}
+
+		var ti TensorInfo
+		if err := json.Unmarshal(msg, &ti); err != nil {
+			return nil, fmt.Errorf("safetensors: bad tensor %q: %w", name, err)
+		}
+
+		f.tensors[name] = ti
+		f.names = append(f.names, name)
+	}
+
+	sort.Strings(f.names)
+
+	if err := f.validate(size - f.dataStart); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// validate checks that every tensor’s data is within the data,
+// and, for the supported dtypes, is the right size for its shape.
+func (f *File) validate(dataSize int64) error {
+	for _, name := range f.names {
+		ti := f.tensors[name]
+		start, end := ti.DataOffsets[0], ti.DataOffsets[1]
+
+		if start < 0 || start > end || end > dataSize {
+			return fmt.Errorf("safetensors: tensor %q has data offsets %v out of range", name, ti.DataOffsets)
+		}
+
+		// The shape is checked whatever the dtype, so that Len can be trusted for every tensor.
+		n := ti.Len()
+		if n < 0 {
+			return fmt.Errorf("safetensors: tensor %q has invalid shape %v", name, ti.Shape)
+		}
+
+		// Dividing the byte count, rather than multiplying the number of elements, cannot overflow.
+		if size := int64(ti.DType.Size()); size != 0 && ((end-start)%size != 0 || (end-start)/size != int64(n)) {
+			return fmt.Errorf("safetensors: tensor %q of shape %v has %d bytes of %s data", name, ti.Shape, end-start, ti.DType)
+		}
+	}
+
+	return nil
+}
+
+// Names returns the names of the tensors in the file, in sorted order.
+func (f *File) Names() []string {
+	return append([]string(nil), f.names...)
+}
+
+// Info returns the description of the named tensor.
+func (f *File) Info(name string) (TensorInfo, bool) {
+	ti, ok := f.tensors[name]
+	return ti, ok
+}
+
+// section returns a reader of the named tensor’s data.
+func (f *File) section(name string) (TensorInfo, *io.SectionReader, error) {
+	ti, ok := f.tensors[name]
+	if !ok {
+		return ti, nil, fmt.Errorf("safetensors: no tensor %q", name)
+	}
+
+	start, end := ti.DataOffsets[0], ti.DataOffsets[1]
+
+	return ti, io.NewSectionReader(f.r, f.dataStart+start, end-start), nil
+}
+
+// Raw returns the bytes of the named tensor’s data, whatever its dtype.
+func (f *File) Raw(name string) ([]byte, error) {
+	_, sr, err := f.section(name)
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, sr.Size())
+	if _, err := io.ReadFull(sr, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// ReadTensor reads the named tensor from f.
+//
+// The dtype must be the same as T,
+// except that float32 and float64 may also read any narrower dtype, which they can represent exactly.
+func ReadTensor[T Element](f *File, name string) (*Tensor[T], error) {
+	ti, sr, err := f.section(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canRead[T](ti.DType) {
+		return nil, fmt.Errorf("safetensors: cannot read %s tensor %q into %T", ti.DType, name, []T(nil))
+	}
+
+	// Open has already checked that the data holds exactly this many elements of the dtype.
+	data := make([]T, ti.Len())
+
+	if err := readData(sr, ti.DType, data); err != nil {
+		if errors.Is(err, errMismatch) {
+			return nil, fmt.Errorf("safetensors: cannot read %s tensor %q into %T", ti.DType, name, data)
+		}
+
+		return nil, err
+	}
+
+	return &Tensor[T]{
+		Shape: ti.Shape,
+		Data:  data,
+	}, nil
+}
+
+// canRead reports whether a tensor of the dtype can be read into T.
+func canRead[T Element](dtype DType) bool {
+	var z T
+
+	switch any(z).(type) {
+	case floats.Float16:
+		return dtype == F16
+	case floats.BFloat16:
+		return dtype == BF16
+	case floats.Float32:
+		return dtype == F32
+	case floats.Float64:
+		return dtype == F64
+	case float32:
+		return dtype != F64 && dtype.Size() != 0
+	case float64:
+		return dtype.Size() != 0
+	}
+
+	panic("impossible type")
+}
+
+// errMismatch is returned internally when the dtype cannot be read into the destination.
+var errMismatch = errors.New("safetensors: dtype mismatch")
+
+func readData[T Element](r io.Reader, dtype DType, data []T) error {
+	fr := floats.NewReader(r, stdbinary.LittleEndian)
+
+	switch data := any(data).(type) {
+	case []floats.Float16:
+		if dtype == F16 {
+			return floats.ReadFloat16s(fr, data)
+		}
+	case []floats.BFloat16:
+		if dtype == BF16 {
+			return floats.ReadBFloat16s(fr, data)
+		}
+	case []floats.Float32:
+		if dtype == F32 {
+			return floats.ReadFloat32s(fr, data)
+		}
+	case []floats.Float64:
+		if dtype == F64 {
+			return floats.ReadFloat64s(fr, data)
+		}
+	case []float32:
+		return readNative(r, dtype, data)
+	case []float64:
+		return readNative(r, dtype, data)
+	}
+
+	return errMismatch
+}
+
+// readNative reads data of the given dtype into the builtin float type F, widening it exactly if needed.
+func readNative[F float32 | float64](r io.Reader, dtype DType, dst []F) error {
+	var z F
+
+	switch dtype {
+	case F16, BF16, F8E5M2:
+		src := make([]uint16, len(dst))
+
+		if dtype == F8E5M2 {
+			// E5M2 is the top half of an IEEE 754 16-bit floating-point number.
+			b := make([]byte, len(dst))
+			if _, err := io.ReadFull(r, b); err != nil {
+				return err
+			}
+
+			for i, x := range b {
+				src[i] = uint16(x) << 8
+			}
+		} else if err := stdbinary.Read(r, stdbinary.LittleEndian, src); err != nil {
+			return err
+		}
+
+		if dtype == BF16 {
+			floats.DecodeBFloat16s(dst, src)
+		} else {
+			floats.DecodeFloat16s(dst, src)
+		}
+
+		return nil
+
+	case F8E4M3:
+		b := make([]byte, len(dst))
+		if _, err := io.ReadFull(r, b); err != nil {
+			return err
+		}
+
+		for i, x := range b {
+			dst[i] = F(decodeE4M3(x))
+		}
+
+		return nil
+
+	case F32:
+		if _, ok := any(z).(float32); ok {
+			return stdbinary.Read(r, stdbinary.LittleEndian, dst)
+		}
+
+		src := make([]float32, len(dst))
+		if err := stdbinary.Read(r, stdbinary.LittleEndian, src); err != nil {
+			return err
+		}
+
+		for i, x := range src {
+			dst[i] = F(x)
+		}
+
+		return nil
+
+	case F64:
+		if _, ok := any(z).(float64); ok {
+			return stdbinary.Read(r, stdbinary.LittleEndian, dst)
+		}
+	}
+
+	return errMismatch
+}
+
+// decodeE4M3 returns the value of an 8-bit E4M3 floating-point number,
+// which has a 4-bit exponent biased by 7, and a 3-bit mantissa, with no infinities,
+// and only S.1111.111 as NaN.
+func decodeE4M3(x byte) float32 {
+	sign := float32(1)
+	if x&0x80 != 0 {
+		sign = -1
+	}
+
+	exp := int(x>>3) & 0xf
+	mant := float32(x & 0x7)
+
+	switch {
+	case exp == 0xf && mant == 7:
+		return float32(math.NaN())
+	case exp == 0:
+		// sub-normal: 0.mmm × 2**-6
+		return sign * mant / 8 / 64
+	}
+
+	return sign * (1 + mant/8) * float32(uint32(1)<<exp) / 128
+}
+
+// Writer builds a safetensors file, which must have every tensor added before it can be written.
+type Writer struct {
+	metadata map[string]string
+	entries  []entry
+	names    map[string]bool
+}
+
+type entry struct {
+	name string
+	info TensorInfo
+	size int64
+
+	write func(w io.Writer) error
+}
+
+// NewWriter returns a new empty Writer.
+func NewWriter() *Writer {
+	return &Writer{
+		names: make(map[string]bool),
+	}
+}
+
+// SetMetadata sets a free-form string metadata value.
+func (w *Writer) SetMetadata(key, value string) {
+	if w.metadata == nil {
+		w.metadata = make(map[string]string)
+	}
+
+	w.metadata[key] = value
+}
+
+func (w *Writer) add(name string, dtype DType, shape []int, size int64, write func(w io.Writer) error) error {
+	if name == metadataKey || w.names[name] {
+		return fmt.Errorf("safetensors: cannot add tensor %q twice", name)
+	}
+
+	n, elem := TensorInfo{Shape: shape}.Len(), int64(dtype.Size())
+
+	if n < 0 || elem == 0 || size%elem != 0 || size/elem != int64(n) {
+		return fmt.Errorf("safetensors: tensor %q of shape %v cannot have %d bytes of %s data", name, shape, size, dtype)
+	}
+
+	if shape == nil {
+		shape = []int{}
+	}
+
+	w.names[name] = true
+	w.entries = append(w.entries, entry{
+		name:  name,
+		info:  TensorInfo{DType: dtype, Shape: shape},
+		size:  size,
+		write: write,
+	})
+
+	return nil
+}
+
+// AddRaw adds a tensor of the given dtype, with its data already encoded.
+// The data is not copied, and must not be modified until the file is written.
+func (w *Writer) AddRaw(name string, dtype DType, shape []int, data []byte) error {
+	if dtype.Size() == 0 {
+		return fmt.Errorf("safetensors: unsupported dtype %q", dtype)
+	}
+
+	return w.add(name, dtype, shape, int64(len(data)), func(out io.Writer) error {
+		_, err := out.Write(data)
+		return err
+	})
+}
+
+// AddTensor adds the tensor t to w, with the dtype that matches T.
+// The data is not copied, and must not be modified until the file is written.
+func AddTensor[T Element](w *Writer, name string, t *Tensor[T]) error {
+	var dtype DType
+
+	switch any(t.Data).(type) {
+	case []floats.Float16:
+		dtype = F16
+	case []floats.BFloat16:
+		dtype = BF16
+	case []floats.Float32, []float32:
+		dtype = F32
+	case []floats.Float64, []float64:
+		dtype = F64
+	}
+
+	return w.add(name, dtype, t.Shape, int64(len(t.Data)*dtype.Size()), func(out io.Writer) error {
+		return writeData(out, t.Data)
+	})
+}
+
+func writeData[T Element](w io.Writer, data []T) error {
+	fw := floats.NewWriter(w, stdbinary.LittleEndian)
+
+	switch data := any(data).(type) {
+	case []floats.Float16:
+		return floats.WriteFloat16s(fw, data)
+	case []floats.BFloat16:
+		return floats.WriteBFloat16s(fw, data)
+	case []floats.Float32:
+		return floats.WriteFloat32s(fw, data)
+	case []floats.Float64:
+		return floats.WriteFloat64s(fw, data)
+	case []float32:
+		return stdbinary.Write(w, stdbinary.LittleEndian, data)
+	case []float64:
+		return stdbinary.Write(w, stdbinary.LittleEndian, data)
+	}
+
+	panic("impossible type")
+}
+
+// WriteTo writes the safetensors file to out, with the tensors’ data in the order they were added.
+func (w *Writer) WriteTo(out io.Writer) (int64, error) {
+	header := make(map[string]any)
+	if len(w.metadata) > 0 {
+		header[metadataKey] = w.metadata
+	}
+
+	var offset int64
+	for i := range w.entries {
+		e := &w.entries[i]
+
+		e.info.DataOffsets = [2]int64{offset, offset + e.size}
+		offset += e.size
+
+		header[e.name] = e.info
+	}
+
+	js, err := json.Marshal(header)
+	if err != nil {
+		return 0, err
+	}
+
+	// Pad the header with spaces, so that the data starts 8-byte aligned.
+	js = append(js, bytes.Repeat([]byte{' '}, (8-len(js)%8)%8)...)
+
+	cw := &countingWriter{w: out}
+
+	var pre [8]byte
+	stdbinary.LittleEndian.PutUint64(pre[:], uint64(len(js)))
+
+	if _, err := cw.Write(pre[:]); err != nil {
+		return cw.n, err
+	}
+
+	if _, err := cw.Write(js); err != nil {
+		return cw.n, err
+	}
+
+	for _, e := range w.entries {
+		if err := e.write(cw); err != nil {
+			return cw.n, err
+		}
+	}
+
+	return cw.n, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	return n, err
+}
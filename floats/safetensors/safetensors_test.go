@@ -0,0 +1,197 @@
+package safetensors
+
+import (
+	"bytes"
+	stdbinary "encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/puellanivis/math/floats"
+)
+
+func TestWriteMatchesReference(t *testing.T) {
+	// The file written by safetensors.torch.save_file({"test": torch.zeros(2, 2)}).
+	header := `{"test":{"dtype":"F32","shape":[2,2],"data_offsets":[0,16]}}`
+	header += "    " // padded to a multiple of 8
+
+	expect := make([]byte, 8, 8+len(header)+16)
+	stdbinary.LittleEndian.PutUint64(expect, uint64(len(header)))
+	expect = append(expect, header...)
+	expect = append(expect, make([]byte, 16)...)
+
+	w := NewWriter()
+	if err := AddTensor(w, "test", &Tensor[float32]{Shape: []int{2, 2}, Data: make([]float32, 4)}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	var buf bytes.Buffer
+	n, err := w.WriteTo(&buf)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if n != int64(buf.Len()) {
+		t.Errorf("WriteTo() = %d, but wrote %d bytes", n, buf.Len())
+	}
+
+	if got := buf.Bytes(); !bytes.Equal(got, expect) {
+		t.Errorf("WriteTo() wrote %q, but expected %q", got, expect)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	w := NewWriter()
+	w.SetMetadata("format", "pt")
+
+	f16 := &Tensor[floats.Float16]{Shape: []int{3}, Data: []floats.Float16{floats.Float16FromBits(0x3c00), floats.Float16FromBits(0xc000), floats.Float16FromBits(0x0001)}}
+	bf16 := &Tensor[floats.BFloat16]{Shape: []int{1, 2}, Data: []floats.BFloat16{floats.BFloat16FromBits(0x3fc0), floats.BFloat16FromBits(0xff80)}}
+	f64 := &Tensor[float64]{Shape: []int{}, Data: []float64{math.Pi}}
+
+	// E4M3: 1, -448, the smallest sub-normal, NaN; E5M2: 1, -inf.
+	e4m3 := []byte{0x38, 0xfe, 0x01, 0x7f}
+	e5m2 := []byte{0x3c, 0xfc}
+
+	for _, err := range []error{
+		AddTensor(w, "f16", f16),
+		AddTensor(w, "bf16", bf16),
+		AddTensor(w, "f64", f64),
+		w.AddRaw("e4m3", F8E4M3, []int{4}, e4m3),
+		w.AddRaw("e5m2", F8E5M2, []int{2}, e5m2),
+	} {
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if err := AddTensor(w, "f16", f16); err == nil {
+		t.Error("AddTensor() of a duplicate name, expected an error")
+	}
+
+	if err := AddTensor(w, "bad", &Tensor[float32]{Shape: []int{2}, Data: []float32{1}}); err == nil {
+		t.Error("AddTensor() with a mismatched shape, expected an error")
+	}
+
+	var buf bytes.Buffer
+	if _, err := w.WriteTo(&buf); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	f, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if expect := []string{"bf16", "e4m3", "e5m2", "f16", "f64"}; !reflect.DeepEqual(f.Names(), expect) {
+		t.Errorf("Names() = %q, but expected %q", f.Names(), expect)
+	}
+
+	if expect := map[string]string{"format": "pt"}; !reflect.DeepEqual(f.Metadata, expect) {
+		t.Errorf("Metadata = %v, but expected %v", f.Metadata, expect)
+	}
+
+	if got, err := ReadTensor[floats.Float16](f, "f16"); err != nil || !reflect.DeepEqual(got, f16) {
+		t.Errorf("ReadTensor(f16) = %+v, %v, but expected %+v", got, err, f16)
+	}
+
+	if got, err := ReadTensor[floats.BFloat16](f, "bf16"); err != nil || !reflect.DeepEqual(got, bf16) {
+		t.Errorf("ReadTensor(bf16) = %+v, %v, but expected %+v", got, err, bf16)
+	}
+
+	if got, err := ReadTensor[float64](f, "f64"); err != nil || !reflect.DeepEqual(got, f64) {
+		t.Errorf("ReadTensor(f64) = %+v, %v, but expected %+v", got, err, f64)
+	}
+
+	if got, err := ReadTensor[float32](f, "f16"); err != nil || !reflect.DeepEqual(got.Data, []float32{1, -2, 0x1p-24}) {
+		t.Errorf("ReadTensor[float32](f16) = %+v, %v", got, err)
+	}
+
+	if _, err := ReadTensor[float32](f, "f64"); err == nil {
+		t.Error("ReadTensor[float32](f64), expected an error")
+	}
+
+	if _, err := ReadTensor[floats.Float32](f, "f16"); err == nil {
+		t.Error("ReadTensor[Float32](f16), expected an error")
+	}
+
+	e4, err := ReadTensor[float32](f, "e4m3")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if got := e4.Data; got[0] != 1 || got[1] != -448 || got[2] != 0x1p-9 || !math.IsNaN(float64(got[3])) {
+		t.Errorf("ReadTensor(e4m3) = %v", got)
+	}
+
+	e5, err := ReadTensor[float64](f, "e5m2")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if got := e5.Data; got[0] != 1 || !math.IsInf(got[1], -1) {
+		t.Errorf("ReadTensor(e5m2) = %v", got)
+	}
+
+	if raw, err := f.Raw("e4m3"); err != nil || !bytes.Equal(raw, e4m3) {
+		t.Errorf("Raw(e4m3) = %x, %v, but expected %x", raw, err, e4m3)
+	}
+}
+
+func TestOpenInvalid(t *testing.T) {
+	build := func(header string, dataSize int) []byte {
+		b := make([]byte, 8, 8+len(header)+dataSize)
+		stdbinary.LittleEndian.PutUint64(b, uint64(len(header)))
+		b = append(b, header...)
+		return append(b, make([]byte, dataSize)...)
+	}
+
+	for _, b := range [][]byte{
+		{1, 2, 3},
+		build(`{"x":{"dtype":"F16","shape":[2],"data_offsets":[0,4]}`, 4),
+		build(`{"x":{"dtype":"F16","shape":[2],"data_offsets":[0,4]}}`, 3),
+		build(`{"x":{"dtype":"F16","shape":[3],"data_offsets":[0,4]}}`, 4),
+		build(`{"x":{"dtype":"F16","shape":[2],"data_offsets":[4,0]}}`, 4),
+		build(`{"x":{"dtype":"F16","shape":[-2,-1],"data_offsets":[0,4]}}`, 4),
+
+		// Shapes whose number of elements overflows, to 0 or otherwise.
+		build(`{"x":{"dtype":"F16","shape":[4611686018427387904,2],"data_offsets":[0,0]}}`, 0),
+		build(`{"x":{"dtype":"F16","shape":[4611686018427387904,4],"data_offsets":[0,0]}}`, 0),
+		build(`{"x":{"dtype":"F16","shape":[2305843009213693952,4],"data_offsets":[0,0]}}`, 0),
+		build(`{"x":{"dtype":"I8","shape":[4611686018427387904,4],"data_offsets":[0,0]}}`, 0),
+		append([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "{}"...),
+	} {
+		if _, err := Open(bytes.NewReader(b), int64(len(b))); err == nil {
+			t.Errorf("Open(%q), expected an error", b)
+		}
+	}
+}
+
+func TestReadTensorMismatch(t *testing.T) {
+	w := NewWriter()
+	if err := w.AddRaw("x", F8E4M3, []int{2}, []byte{0x38, 0x40}); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := w.WriteTo(&buf); err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	f, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if x, err := ReadTensor[floats.Float16](f, "x"); err == nil {
+		t.Errorf("ReadTensor[floats.Float16](F8_E4M3) = %v, expected an error", x.Data)
+	}
+
+	if x, err := ReadTensor[float32](f, "x"); err != nil || x.Data[0] != 1 || x.Data[1] != 2 {
+		t.Errorf("ReadTensor[float32](F8_E4M3) = %v, %v, expected [1 2]", x, err)
+	}
+
+	// A shape whose number of elements overflows cannot be added either.
+	if err := w.AddRaw("y", F16, []int{1 << 62, 4}, nil); err == nil {
+		t.Error("AddRaw() of an overflowing shape, expected an error")
+	}
+}
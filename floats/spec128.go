@@ -39,6 +39,15 @@ func (binary128) expOverUnder() (overflow, underflow, nearZero bits.Uint128) {
 	return expOverflow, expUnderflow, expNearZero
 }
 
+var (
+	exp10Overflow  = bits.Uint128{Hi: 0x400b34413509f79f, Lo: 0xef311f12b35816fa}
+	exp10Underflow = bits.Uint128{Hi: 0x400b3657d621f4e9, Lo: 0x6893f84497c723c1}
+)
+
+func (binary128) exp10OverUnder() (overflow, underflow bits.Uint128) {
+	return exp10Overflow, exp10Underflow
+}
+
 var (
 	b128ln2hi = bits.Uint128{Hi: 0x3ffe62e42fee0000, Lo: 0x0000000000000000}
 	b128ln2lo = bits.Uint128{Hi: 0x3fdea39ef35793c7, Lo: 0x6000000000000000}
@@ -48,6 +57,7 @@ func (binary128) ln2HiLoE() (hi, lo, ln2e bits.Uint128) {
 	return b128ln2hi, b128ln2lo, Ln2E.bits
 }
 
+// The coefficients P5 to P1 of fdlibm’s e_exp.c; see cmd/remez for how they compare to a minimax polynomial.
 var binary128P5toP0 = []bits.Uint128{
 	bits.Uint128{Hi: 0x3fe66376972bea4d, Lo: 0x0000000000000000},
 	bits.Uint128{Hi: 0xbfebbbd41c5d26bf, Lo: 0x1000000000000000},
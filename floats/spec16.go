@@ -28,10 +28,15 @@ func (binary16) expOverUnder() (overflow, underflow, nearZero uint16) {
 	return 0x498c, 0x4c55, 0x0000
 }
 
+func (binary16) exp10OverUnder() (overflow, underflow uint16) {
+	return 0x44d2, 0x4787
+}
+
 func (binary16) ln2HiLoE() (hi, lo, ln2e uint16) {
 	return 0x398c, 0x0001, 0x3dc5
 }
 
+// Generated by: remez -func expr -degree 4 -format f16
 var binary16P5toP0 = []uint16{
 	0x0001,
 	0x801c,
@@ -68,10 +73,15 @@ func (bfloat16) expOverUnder() (overflow, underflow, nearZero uint16) {
 	return 0x558c, 0x55c3, 0x0000
 }
 
+func (bfloat16) exp10OverUnder() (overflow, underflow uint16) {
+	return 0x421b, 0x4222
+}
+
 func (bfloat16) ln2HiLoE() (hi, lo, ln2e uint16) {
 	return 0x3f31, 0x2f52, 0x3fb9
 }
 
+// Generated by: remez -func expr -degree 4 -format bf16
 var bfloat16P5toP0 = []uint16{
 	0x3332,
 	0xb5de,
@@ -28,10 +28,15 @@ func (binary32) expOverUnder() (overflow, underflow, nearZero uint32) {
 	return 0x42b17218, 0x42cff1b4, 0x31800000
 }
 
+func (binary32) exp10OverUnder() (overflow, underflow uint32) {
+	return 0x421a209b, 0x42349e36
+}
+
 func (binary32) ln2HiLoE() (hi, lo, ln2e uint32) {
 	return 0x3f317218, 0x2f51cf7a, 0x3fb8aa3b
 }
 
+// The coefficients P5 to P1 of fdlibm’s e_exp.c; see cmd/remez for how they compare to a minimax polynomial.
 var binary32P5toP0 = []uint32{
 	0x3331bb4c,
 	0xb5ddea0e,
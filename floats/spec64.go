@@ -28,10 +28,15 @@ func (binary64) expOverUnder() (overflow, underflow, nearZero uint64) {
 	return 0x40862e42fefa39ef, 0xc0874910d52d3052, 0x3e30000000000000
 }
 
+func (binary64) exp10OverUnder() (overflow, underflow uint64) {
+	return 0x40734413509f79ff, 0x407439b746e36b53
+}
+
 func (binary64) ln2HiLoE() (hi, lo, ln2e uint64) {
 	return 0x3fe62e42fee00000, 0x3dea39ef35793c76, 0x3ff71547652b82fe
 }
 
+// The coefficients P5 to P1 of fdlibm’s e_exp.c; see cmd/remez for how they compare to a minimax polynomial.
 var binary64P5toP0 = []uint64{
 	0x3E663769_72BEA4D0, // 4.13813679705723846039e-08
 	0xBEBBBD41_C5D26BF1, // -1.65339022054652515390e-06
@@ -0,0 +1,678 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+	"sync"
+)
+
+var (
+	bigEulerGamma = &bigConst{compute: computeEulerGamma}
+	bigHalfLn2Pi  = &bigConst{compute: computeHalfLn2Pi}
+)
+
+// computeEulerGamma returns the Euler–Mascheroni constant γ, using the Brent–McMillan algorithm,
+// γ = U/V, where U = Σ (n**k / k!)² (H(k) - ln(n)), and V = Σ (n**k / k!)², with an error of about e**-4n.
+func computeEulerGamma(prec uint) *big.Float {
+	n := int64(float64(prec)*math.Ln2/4) + 2
+	wp := prec + 32
+
+	nn := new(big.Float).SetPrec(wp).SetInt64(n * n)
+
+	a := bigLog(new(big.Float).SetPrec(wp).SetInt64(n), wp)
+	a.Neg(a)
+	b := new(big.Float).SetPrec(wp).SetInt64(1)
+
+	u := new(big.Float).SetPrec(wp).Set(a)
+	v := new(big.Float).SetPrec(wp).Set(b)
+
+	k := new(big.Float).SetPrec(wp)
+
+	for i := int64(1); ; i++ {
+		k.SetInt64(i)
+
+		// b = (n**k / k!)², and a = b × (H(k) - ln(n))
+		b.Mul(b, nn)
+		b.Quo(b, k)
+		b.Quo(b, k)
+
+		a.Mul(a, nn)
+		a.Quo(a, k)
+		a.Add(a, b)
+		a.Quo(a, k)
+
+		if i > n && b.MantExp(nil)-v.MantExp(nil) < -int(wp) {
+			break
+		}
+
+		u.Add(u, a)
+		v.Add(v, b)
+	}
+
+	return u.Quo(u, v)
+}
+
+// computeHalfLn2Pi returns ½ ln(2π), the constant term of Stirling’s series.
+func computeHalfLn2Pi(prec uint) *big.Float {
+	r := bigLog(computePi(prec), prec)
+	r.Add(r, computeLn2(prec))
+
+	return r.SetMantExp(r, -1)
+}
+
+// tangentNumbers caches the tangent numbers T(1), T(2), …, the odd coefficients of tan(x) times (2k-1)!,
+// from which the Bernoulli numbers can be derived without any rational arithmetic.
+var tangentNumbers struct {
+	mu sync.Mutex
+	t  []*big.Int
+}
+
+// tangentNumber returns T(k) for k ≥ 1, using the algorithm of Brent and Harvey.
+func tangentNumber(k int) *big.Int {
+	tangentNumbers.mu.Lock()
+	defer tangentNumbers.mu.Unlock()
+
+	if k < len(tangentNumbers.t) {
+		return tangentNumbers.t[k]
+	}
+
+	n := max(2*k, 32)
+
+	t := make([]*big.Int, n+1)
+	t[0] = new(big.Int)
+	t[1] = big.NewInt(1)
+
+	for j := 2; j <= n; j++ {
+		t[j] = new(big.Int).Mul(big.NewInt(int64(j-1)), t[j-1])
+	}
+
+	tmp := new(big.Int)
+	for i := 2; i <= n; i++ {
+		for j := i; j <= n; j++ {
+			tmp.Mul(big.NewInt(int64(j-i)), t[j-1])
+			t[j].Mul(big.NewInt(int64(j-i+2)), t[j])
+			t[j].Add(t[j], tmp)
+		}
+	}
+
+	tangentNumbers.t = t
+	return t[k]
+}
+
+// stirlingCoeff returns the k-th coefficient of Stirling’s series,
+// B(2k) / (2k (2k-1)) = (-1)**(k-1) T(k) / ((2k-1) × 4**k × (4**k - 1)).
+func stirlingCoeff(k int, prec uint) *big.Float {
+	d := new(big.Int).Lsh(big.NewInt(1), uint(2*k))
+	d.Mul(d, new(big.Int).Sub(d, big.NewInt(1)))
+	d.Mul(d, big.NewInt(int64(2*k-1)))
+
+	c := new(big.Float).SetPrec(prec).SetInt(tangentNumber(k))
+	c.Quo(c, new(big.Float).SetPrec(prec).SetInt(d))
+
+	if k%2 == 0 {
+		c.Neg(c)
+	}
+
+	return c
+}
+
+// bigLnGamma returns ln(Γ(x)) for the positive finite x, using Stirling’s series,
+// after shifting x up far enough for the series to converge to prec bits.
+func bigLnGamma(x *big.Float, prec uint) *big.Float {
+	wp := prec + 32
+
+	// The smallest term of the series is about e**-2πz, which must be smaller than 2**-wp.
+	zmin := big.NewFloat(float64(wp)/4 + 2)
+
+	z := new(big.Float).SetPrec(wp).Set(x)
+
+	// Γ(x) = Γ(x+n) / (x × (x+1) × … × (x+n-1))
+	p := new(big.Float).SetPrec(wp).SetInt64(1)
+	shifted := false
+
+	for z.Cmp(zmin) < 0 {
+		p.Mul(p, z)
+		z.Add(z, big.NewFloat(1))
+		shifted = true
+	}
+
+	// ln(Γ(z)) = (z - ½) ln(z) - z + ½ ln(2π) + Σ B(2k) / (2k (2k-1) z**(2k-1))
+	s := new(big.Float).SetPrec(wp).Sub(z, big.NewFloat(0.5))
+	s.Mul(s, bigLog(z, wp))
+	s.Sub(s, z)
+
+	s.Add(s, bigHalfLn2Pi.get(wp))
+
+	z2 := new(big.Float).SetPrec(wp).Mul(z, z)
+	zp := new(big.Float).SetPrec(wp).Set(z)
+	term := new(big.Float).SetPrec(wp)
+
+	for k := 1; ; k++ {
+		term.Quo(stirlingCoeff(k, wp), zp)
+
+		if term.Sign() == 0 || term.MantExp(nil) < -int(wp) {
+			break
+		}
+
+		s.Add(s, term)
+		zp.Mul(zp, z2)
+	}
+
+	if shifted {
+		s.Sub(s, bigLog(p, wp))
+	}
+
+	return s
+}
+
+// bigLnGammaSign returns ln(|Γ(x)|) and whether Γ(x) is negative, for finite x that is not a pole.
+func bigLnGammaSign(x *big.Float, prec uint) (*big.Float, bool) {
+	if x.Sign() > 0 {
+		return bigLnGamma(x, prec), false
+	}
+
+	wp := prec + 32
+
+	// Γ(x) = π / (sin(πx) Γ(1-x)), where sin(πx) = (-1)**n sin(πr), for x = n + r, is computed without losing precision.
+	n, _ := roundBig(x)
+
+	r := new(big.Float).SetPrec(wp).SetInt(n)
+	r.Sub(x, r)
+	r.Mul(r, bigPi.get(wp))
+
+	sin, _ := bigSinCos(r, wp)
+
+	neg := (sin.Sign() < 0) != (n.Bit(0) != 0)
+
+	l := bigLog(bigPi.get(wp), wp)
+	l.Sub(l, bigLog(sin.Abs(sin), wp))
+
+	u := new(big.Float).SetPrec(wp).Sub(big.NewFloat(1), x)
+	l.Sub(l, bigLnGamma(u, wp))
+
+	return l, neg
+}
+
+// bigErfSeries returns erf(x) for positive finite x,
+// using erf(x) = 2x/√π × e**-x² × Σ (2x²)**n / (1 × 3 × … × (2n+1)), whose terms are all positive.
+func bigErfSeries(x *big.Float, prec uint) *big.Float {
+	wp := prec + 32
+
+	x2 := new(big.Float).SetPrec(wp).Mul(x, x)
+	x2f, _ := x2.Float64()
+
+	tx2 := new(big.Float).SetPrec(wp).SetMantExp(x2, 1)
+
+	sum := new(big.Float).SetPrec(wp).SetInt64(1)
+	term := new(big.Float).SetPrec(wp).SetInt64(1)
+	d := new(big.Float).SetPrec(wp)
+
+	for n := int64(1); ; n++ {
+		term.Mul(term, tx2)
+		term.Quo(term, d.SetInt64(2*n+1))
+
+		if float64(n) > x2f && term.MantExp(nil)-sum.MantExp(nil) < -int(wp) {
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	e := bigExp(new(big.Float).Neg(x2), wp)
+	sum.Mul(sum, e)
+	sum.Mul(sum, x)
+	sum.SetMantExp(sum, 1)
+
+	return sum.Quo(sum, new(big.Float).SetPrec(wp).Sqrt(bigPi.get(wp)))
+}
+
+// erfcMargin is how many bits below 2**-wp the smallest term of the asymptotic series of erfc must be,
+// before bigErfc uses it.
+const erfcMargin = 8
+
+// bigErfc returns erfc(x) for positive finite x, to full relative precision.
+func bigErfc(x *big.Float, prec uint) *big.Float {
+	wp := prec + 32
+
+	x2 := new(big.Float).SetPrec(wp).Mul(x, x)
+	x2f, _ := x2.Float64()
+
+	// The smallest term of the asymptotic series is about √2 e**-x², which must be well below 2**-wp.
+	if x2f < float64(wp+erfcMargin)*math.Ln2 {
+		// erfc(x) = 1 - erf(x), where erfc(x) < e**-x², and so the subtraction cancels about x² lg(e) bits.
+		gp := wp + uint(x2f*math.Log2E)
+
+		v := bigErfSeries(x, gp)
+		return v.Sub(big.NewFloat(1), v).SetPrec(wp)
+	}
+
+	// erfc(x) = e**-x² / (x√π) × Σ (-1)**n (1 × 3 × … × (2n-1)) / (2x²)**n,
+	// an asymptotic series whose smallest term is about √2 e**-x², which is smaller than 2**-wp.
+	tx2 := new(big.Float).SetPrec(wp).SetMantExp(x2, 1)
+
+	sum := new(big.Float).SetPrec(wp).SetInt64(1)
+	term := new(big.Float).SetPrec(wp).SetInt64(1)
+	d := new(big.Float).SetPrec(wp)
+
+	// The terms only shrink while 2n-1 < 2x², after which the series diverges.
+	for n := int64(1); float64(2*n-1) < 2*x2f; n++ {
+		term.Mul(term, d.SetInt64(2*n-1))
+		term.Quo(term, tx2)
+		term.Neg(term)
+
+		if term.MantExp(nil) < -int(wp) {
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	e := bigExp(x2.Neg(x2), wp)
+	sum.Mul(sum, e)
+	sum.Quo(sum, x)
+
+	return sum.Quo(sum, new(big.Float).SetPrec(wp).Sqrt(bigPi.get(wp)))
+}
+
+// besselAsymptotic reports whether Hankel’s asymptotic expansion converges to prec bits for x,
+// as its smallest term is about e**-2x.
+func besselAsymptotic(x *big.Float, prec uint) bool {
+	xf, _ := x.Float64()
+	return xf > float64(prec+32)*math.Ln2/2+2
+}
+
+// bigBesselSeries returns Jν(x), and if wantY also Yν(x), for ν = 0 or 1 and positive finite x, using their power series:
+//
+//	Jν(x) = (x/2)**ν Σ (-x²/4)**k / (k! (k+ν)!)
+//	Y0(x) = 2/π × ((ln(x/2) + γ) J0(x) - Σ H(k) (-x²/4)**k / (k!)²)
+//	Y1(x) = -2/(πx) + 2/π × (ln(x/2) + γ) J1(x) - (x/2π) Σ (H(k) + H(k+1)) (-x²/4)**k / (k! (k+1)!)
+func bigBesselSeries(nu int, x *big.Float, prec uint, wantY bool) (j, y *big.Float) {
+	// The terms grow as large as about e**x, before the series converges.
+	xf, _ := x.Float64()
+	wp := prec + 32 + uint(xf*math.Log2E)
+
+	q := new(big.Float).SetPrec(wp).Mul(x, x)
+	q.SetMantExp(q, -2)
+	q.Neg(q)
+
+	sj := new(big.Float).SetPrec(wp).SetInt64(1)
+	sh := new(big.Float).SetPrec(wp)
+
+	term := new(big.Float).SetPrec(wp).SetInt64(1)
+
+	h := new(big.Float).SetPrec(wp) // H(k)
+	hn := new(big.Float).SetPrec(wp)
+	if nu == 1 {
+		hn.SetInt64(1) // H(k+1)
+	}
+
+	ht := new(big.Float).SetPrec(wp)
+	d := new(big.Float).SetPrec(wp)
+
+	if wantY && nu == 1 {
+		sh.Set(hn)
+	}
+
+	for k := int64(1); ; k++ {
+		term.Mul(term, q)
+		term.Quo(term, d.SetInt64(k*(k+int64(nu))))
+
+		if float64(k) > xf && term.MantExp(nil) < -int(wp) {
+			break
+		}
+
+		sj.Add(sj, term)
+
+		if wantY {
+			h.Add(h, d.Quo(big.NewFloat(1), d.SetInt64(k)))
+
+			ht.Set(h)
+			if nu == 1 {
+				hn.Add(hn, d.Quo(big.NewFloat(1), d.SetInt64(k+1)))
+				ht.Add(ht, hn)
+			}
+
+			sh.Add(sh, ht.Mul(ht, term))
+		}
+	}
+
+	half := new(big.Float).SetPrec(wp).SetMantExp(x, -1)
+
+	j = sj
+	if nu == 1 {
+		j.Mul(j, half)
+	}
+
+	if !wantY {
+		return j.SetPrec(prec), nil
+	}
+
+	pi := bigPi.get(wp)
+
+	// (ln(x/2) + γ) Jν(x)
+	y = bigLog(half, wp)
+	y.Add(y, bigEulerGamma.get(wp))
+	y.Mul(y, j)
+
+	if nu == 0 {
+		y.Sub(y, sh)
+		y.SetMantExp(y, 1)
+		y.Quo(y, pi)
+
+		return j.SetPrec(prec), y.SetPrec(prec)
+	}
+
+	y.SetMantExp(y, 1)
+
+	sh.Mul(sh, half)
+	y.Sub(y, sh)
+
+	r := new(big.Float).SetPrec(wp).Quo(big.NewFloat(2), x)
+	y.Sub(y, r)
+	y.Quo(y, pi)
+
+	return j.SetPrec(prec), y.SetPrec(prec)
+}
+
+// bigBesselHankel returns Jν(x) and Yν(x) for ν = 0 or 1 and large finite x, using Hankel’s asymptotic expansion,
+// Jν(x) = √(2/πx) (P cos(χ) - Q sin(χ)) and Yν(x) = √(2/πx) (P sin(χ) + Q cos(χ)), with χ = x - (ν/2 + ¼)π, where
+//
+//	P = a(0) - a(2)/x² + a(4)/x⁴ - …
+//	Q = a(1)/x - a(3)/x³ + a(5)/x⁵ - …
+//	a(k) = (4ν² - 1²)(4ν² - 3²) … (4ν² - (2k-1)²) / (k! 8**k)
+func bigBesselHankel(nu int, x *big.Float, prec uint) (j, y *big.Float) {
+	wp := prec + 32
+
+	mu := int64(4 * nu * nu)
+
+	p := new(big.Float).SetPrec(wp).SetInt64(1)
+	q := new(big.Float).SetPrec(wp)
+
+	term := new(big.Float).SetPrec(wp).SetInt64(1)
+	d := new(big.Float).SetPrec(wp)
+
+	x8 := new(big.Float).SetPrec(wp).SetMantExp(x, 3)
+
+	for k := int64(1); ; k++ {
+		term.Mul(term, d.SetInt64(mu-(2*k-1)*(2*k-1)))
+		term.Quo(term, d.SetInt64(k))
+		term.Quo(term, x8)
+
+		if term.Sign() == 0 || term.MantExp(nil) < -int(wp) {
+			break
+		}
+
+		switch k % 4 {
+		case 0:
+			p.Add(p, term)
+		case 1:
+			q.Add(q, term)
+		case 2:
+			p.Sub(p, term)
+		case 3:
+			q.Sub(q, term)
+		}
+	}
+
+	sin, cos := bigSinCos(x, wp)
+
+	// Expanding cos(χ) and sin(χ) leaves only sin(x) ± cos(x), with a common factor of √½.
+	sum := new(big.Float).SetPrec(wp).Add(sin, cos)
+	diff := new(big.Float).SetPrec(wp).Sub(sin, cos)
+
+	j = new(big.Float).SetPrec(wp)
+	y = new(big.Float).SetPrec(wp)
+	t := new(big.Float).SetPrec(wp)
+
+	if nu == 0 {
+		// J0 = P (sin + cos) - Q (sin - cos), Y0 = P (sin - cos) + Q (sin + cos)
+		j.Mul(p, sum)
+		j.Sub(j, t.Mul(q, diff))
+
+		y.Mul(p, diff)
+		y.Add(y, t.Mul(q, sum))
+	} else {
+		// J1 = P (sin - cos) + Q (sin + cos), Y1 = Q (sin - cos) - P (sin + cos)
+		j.Mul(p, diff)
+		j.Add(j, t.Mul(q, sum))
+
+		y.Mul(q, diff)
+		y.Sub(y, t.Mul(p, sum))
+	}
+
+	c := new(big.Float).SetPrec(wp).Mul(x, bigPi.get(wp))
+	c.Sqrt(c)
+
+	j.Quo(j, c)
+	y.Quo(y, c)
+
+	return j.SetPrec(prec), y.SetPrec(prec)
+}
+
+// bigBessel returns Jν(x), and if wantY also Yν(x), for ν = 0 or 1 and positive finite x.
+func bigBessel(nu int, x *big.Float, prec uint, wantY bool) (j, y *big.Float) {
+	if besselAsymptotic(x, prec) {
+		return bigBesselHankel(nu, x, prec)
+	}
+
+	return bigBesselSeries(nu, x, prec, wantY)
+}
+
+// nearOne returns the result of rounding (n - ε), negated if neg, for the integer 1 ≤ n ≤ 2,
+// where 0 < ε < 2**-(mantWidth + 4) is too small to matter, except to decide the rounding.
+func nearOne[SPEC spec[D], D datum](neg bool, n int64, rounding RoundingMode) D {
+	var spec SPEC
+
+	tiny := new(big.Float).SetMantExp(big.NewFloat(1), -(spec.mantWidth() + 5))
+	v := new(big.Float).SetPrec(uint(spec.width())).Sub(big.NewFloat(float64(n)), tiny)
+
+	if neg {
+		v.Neg(v)
+	}
+
+	return fromBigFloat[SPEC](v, rounding)
+}
+
+func erf[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	s, m := mag[SPEC](x)
+
+	var spec SPEC
+
+	xNeg := !spec.IsZero(s)
+
+	switch {
+	case spec.Gt(m, magInf[SPEC]()):
+		return x
+	case spec.Eq(m, magInf[SPEC]()):
+		return spec.Or(s, one[SPEC]())
+	case spec.IsZero(m):
+		return x
+	}
+
+	bx := toBigFloat[SPEC](m)
+
+	// erf(x) = 1 - erfc(x), where erfc(x) < e**-x² is small enough that it only decides the rounding.
+	if xf, _ := bx.Float64(); xf*xf > float64(spec.mantWidth()+5)*math.Ln2 {
+		return nearOne[SPEC](xNeg, 1, rounding)
+	}
+
+	v := bigErfSeries(bx, workPrec[SPEC]())
+	if xNeg {
+		v.Neg(v)
+	}
+
+	return fromBigFloat[SPEC](v, rounding)
+}
+
+func erfc[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	s, m := mag[SPEC](x)
+
+	var spec SPEC
+	var z D
+
+	switch {
+	case spec.Gt(m, magInf[SPEC]()):
+		return x
+	case spec.Eq(m, magInf[SPEC]()):
+		if !spec.IsZero(s) {
+			return two[SPEC]()
+		}
+		return z
+	case spec.IsZero(m):
+		return one[SPEC]()
+	}
+
+	prec := workPrec[SPEC]()
+
+	bx := toBigFloat[SPEC](m)
+	xf, _ := bx.Float64()
+
+	if !spec.IsZero(s) {
+		// erfc(-x) = 2 - erfc(x) = 1 + erf(x)
+		if xf*xf > float64(spec.mantWidth()+5)*math.Ln2 {
+			return nearOne[SPEC](false, 2, rounding)
+		}
+
+		v := bigErfSeries(bx, prec)
+		return fromBigFloat[SPEC](v.Add(v, big.NewFloat(1)), rounding)
+	}
+
+	if xf*xf > float64(expBias[SPEC]()+spec.mantWidth()+2)*math.Ln2 {
+		// EXCEPTION: underflow
+		return underflow[SPEC](false, rounding)
+	}
+
+	return fromBigFloat[SPEC](bigErfc(bx, prec), rounding)
+}
+
+func gamma[SPEC spec[D], D datum](x D, rounding RoundingMode) D {
+	s, m := mag[SPEC](x)
+
+	var spec SPEC
+
+	xNeg := !spec.IsZero(s)
+
+	switch {
+	case spec.Gt(m, magInf[SPEC]()):
+		return x
+
+	case spec.Eq(m, magInf[SPEC]()):
+		if xNeg {
+			// EXCEPTION: invalid operation
+			return nan[SPEC]()
+		}
+		return x
+
+	case spec.IsZero(m):
+		// EXCEPTION: divide by zero
+		return inf[SPEC](xNeg)
+
+	case xNeg && isInteger[SPEC](x):
+		// EXCEPTION: invalid operation
+		return nan[SPEC]()
+	}
+
+	bx := toBigFloat[SPEC](x)
+
+	if !xNeg && isInteger[SPEC](x) {
+		// Γ(n) = (n-1)! is exact, and for any larger n it overflows every format anyways.
+		if n, _ := bx.Int64(); n < 1<<11 {
+			f := new(big.Int).MulRange(1, n-1)
+			return fromBigFloat[SPEC](new(big.Float).SetInt(f), rounding)
+		}
+	}
+
+	prec := workPrec[SPEC]()
+
+	l, neg := bigLnGammaSign(bx, prec)
+
+	return expResult[SPEC](neg, l, prec, rounding)
+}
+
+func lnGamma[SPEC spec[D], D datum](x D, rounding RoundingMode) (D, int) {
+	s, m := mag[SPEC](x)
+
+	var spec SPEC
+	var z D
+
+	switch {
+	case spec.Gte(m, magInf[SPEC]()):
+		return x, 1
+
+	case spec.IsZero(m):
+		// EXCEPTION: divide by zero
+		return magInf[SPEC](), 1
+
+	case !spec.IsZero(s) && isInteger[SPEC](x):
+		// EXCEPTION: divide by zero
+		return magInf[SPEC](), 1
+
+	case spec.Eq(x, one[SPEC]()), spec.Eq(x, two[SPEC]()):
+		// ln(Γ(1)) = ln(Γ(2)) = 0, exactly.
+		return z, 1
+	}
+
+	l, neg := bigLnGammaSign(toBigFloat[SPEC](x), workPrec[SPEC]())
+
+	sign := 1
+	if neg {
+		sign = -1
+	}
+
+	return fromBigFloat[SPEC](l, rounding), sign
+}
+
+func besselJ[SPEC spec[D], D datum](nu int, x D, rounding RoundingMode) D {
+	s, m := mag[SPEC](x)
+
+	var spec SPEC
+	var z D
+
+	switch {
+	case spec.Gt(m, magInf[SPEC]()):
+		return x
+	case spec.Eq(m, magInf[SPEC]()):
+		return z
+	case spec.IsZero(m):
+		if nu == 0 {
+			return one[SPEC]()
+		}
+		return x
+	}
+
+	j, _ := bigBessel(nu, toBigFloat[SPEC](m), workPrec[SPEC](), false)
+
+	// J1 is odd, while J0 is even.
+	if nu == 1 && !spec.IsZero(s) {
+		j.Neg(j)
+	}
+
+	return fromBigFloat[SPEC](j, rounding)
+}
+
+func besselY[SPEC spec[D], D datum](nu int, x D, rounding RoundingMode) D {
+	s, m := mag[SPEC](x)
+
+	var spec SPEC
+	var z D
+
+	switch {
+	case spec.Gt(m, magInf[SPEC]()):
+		return x
+
+	case spec.IsZero(m):
+		// EXCEPTION: divide by zero
+		return inf[SPEC](true)
+
+	case !spec.IsZero(s):
+		// EXCEPTION: invalid operation
+		return nan[SPEC]()
+
+	case spec.Eq(m, magInf[SPEC]()):
+		return z
+	}
+
+	_, y := bigBessel(nu, toBigFloat[SPEC](m), workPrec[SPEC](), true)
+
+	return fromBigFloat[SPEC](y, rounding)
+}
@@ -0,0 +1,45 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// testErfcAsymptotic sweeps erfc across the point where it switches from 1 - erf(x) to its asymptotic series,
+// comparing it with 1 - erf(x), computed with enough extra precision to cover the cancellation.
+func testErfcAsymptotic[SPEC spec[D], D datum](t *testing.T, name string) {
+	t.Helper()
+
+	var spec SPEC
+	var rnd RoundTiesToEven
+
+	wp := float64(workPrec[SPEC]() + 32)
+	lo, hi := math.Sqrt(wp*math.Ln2)*0.99, math.Sqrt((wp+erfcMargin)*math.Ln2)*1.01
+
+	// Beyond this, erfc(x) underflows, and is not computed at all.
+	limit := math.Sqrt(float64(expBias[SPEC]()+spec.mantWidth()+2) * math.Ln2)
+
+	for xf := lo; xf < hi && xf < limit; xf += (hi - lo) / 256 {
+		x := fromBigFloat[SPEC](big.NewFloat(xf), rnd)
+		bx := toBigFloat[SPEC](x)
+
+		v, _ := bx.Float64()
+		gp := workPrec[SPEC]() + 64 + uint(v*v*math.Log2E)
+
+		ref := bigErfSeries(bx, gp)
+		ref.Sub(big.NewFloat(1), ref)
+
+		if got, expect := erfc[SPEC](x, rnd), fromBigFloat[SPEC](ref, rnd); got != expect {
+			t.Errorf("%s: Erfc(%v) = %#x, expected %#x", name, v, got, expect)
+		}
+	}
+}
+
+func TestErfcAsymptotic(t *testing.T) {
+	testErfcAsymptotic[binary16](t, "Float16")
+	testErfcAsymptotic[bfloat16](t, "BFloat16")
+	testErfcAsymptotic[binary32](t, "Float32")
+	testErfcAsymptotic[binary64](t, "Float64")
+	testErfcAsymptotic[binary128](t, "Float128")
+}
@@ -0,0 +1,527 @@
+package floats
+
+import (
+	"math/big"
+	stdbits "math/bits"
+
+	"github.com/puellanivis/math/bits"
+)
+
+// SumAlgorithm selects how the terms of a sum are accumulated.
+// The functions that take one panic on any value other than those below.
+type SumAlgorithm int
+
+const (
+	// SumNaive adds each term in order, rounding after every addition.
+	// Its error bound grows linearly with the number of terms.
+	SumNaive SumAlgorithm = iota
+
+	// SumPairwise recursively sums each half of the terms, then adds the two halves.
+	// Its error bound grows logarithmically with the number of terms, at almost no extra cost.
+	SumPairwise
+
+	// SumNeumaier uses Kahan–Babuška–Neumaier compensated summation,
+	// carrying the rounding error of each addition in a separate compensation term.
+	// Its error bound is nearly independent of the number of terms, while that number is small compared to 1/ε.
+	// The compensation is only exact when rounding to nearest,
+	// so under the directed rounding modes, the sum is accumulated exactly instead, as with SumExact.
+	SumNeumaier
+
+	// SumExact accumulates the sum exactly, and rounds it only once, which gives a correctly-rounded result.
+	// A sum that is exactly zero is signed as a rounded sum is: -0 if every term is -0,
+	// or if rounding toward negative and any term is negative, and +0 otherwise.
+	SumExact
+)
+
+// pairwiseBlock is the number of terms below which pairwise summation just adds them naively.
+const pairwiseBlock = 32
+
+// Float16Sum returns the sum of xs, accumulated according to alg.
+func Float16Sum[RND RoundingMode](xs []Float16WithRound[RND], alg SumAlgorithm) Float16WithRound[RND] {
+	var rnd RND
+
+	term := func(i int) uint16 { return xs[i].bits }
+
+	return Float16WithRound[RND]{sum[binary16](len(xs), term, alg, rnd)}
+}
+
+// Float16Dot returns the dot product of xs and ys, accumulated according to alg.
+// It panics if xs and ys have different lengths.
+func Float16Dot[RND RoundingMode](xs, ys []Float16WithRound[RND], alg SumAlgorithm) Float16WithRound[RND] {
+	var rnd RND
+
+	if len(xs) != len(ys) {
+		panic("Float16Dot: slices of different lengths")
+	}
+
+	x := func(i int) uint16 { return xs[i].bits }
+	y := func(i int) uint16 { return ys[i].bits }
+
+	return Float16WithRound[RND]{dot[binary16](len(xs), x, y, alg, rnd)}
+}
+
+// Float16Norm2 returns the Euclidean norm of xs, the square root of the sum of their squares,
+// taking care to avoid unnecessary overflow and underflow.
+// The sum of the squares is accumulated according to alg.
+func Float16Norm2[RND RoundingMode](xs []Float16WithRound[RND], alg SumAlgorithm) Float16WithRound[RND] {
+	var rnd RND
+
+	x := func(i int) uint16 { return xs[i].bits }
+
+	return Float16WithRound[RND]{norm2[binary16](len(xs), x, alg, rnd)}
+}
+
+// BFloat16Sum returns the sum of xs, accumulated according to alg.
+func BFloat16Sum[RND RoundingMode](xs []BFloat16WithRound[RND], alg SumAlgorithm) BFloat16WithRound[RND] {
+	var rnd RND
+
+	term := func(i int) uint16 { return xs[i].bits }
+
+	return BFloat16WithRound[RND]{sum[bfloat16](len(xs), term, alg, rnd)}
+}
+
+// BFloat16Dot returns the dot product of xs and ys, accumulated according to alg.
+// It panics if xs and ys have different lengths.
+func BFloat16Dot[RND RoundingMode](xs, ys []BFloat16WithRound[RND], alg SumAlgorithm) BFloat16WithRound[RND] {
+	var rnd RND
+
+	if len(xs) != len(ys) {
+		panic("BFloat16Dot: slices of different lengths")
+	}
+
+	x := func(i int) uint16 { return xs[i].bits }
+	y := func(i int) uint16 { return ys[i].bits }
+
+	return BFloat16WithRound[RND]{dot[bfloat16](len(xs), x, y, alg, rnd)}
+}
+
+// BFloat16Norm2 returns the Euclidean norm of xs, the square root of the sum of their squares,
+// taking care to avoid unnecessary overflow and underflow.
+// The sum of the squares is accumulated according to alg.
+func BFloat16Norm2[RND RoundingMode](xs []BFloat16WithRound[RND], alg SumAlgorithm) BFloat16WithRound[RND] {
+	var rnd RND
+
+	x := func(i int) uint16 { return xs[i].bits }
+
+	return BFloat16WithRound[RND]{norm2[bfloat16](len(xs), x, alg, rnd)}
+}
+
+// Float32Sum returns the sum of xs, accumulated according to alg.
+func Float32Sum[RND RoundingMode](xs []Float32WithRound[RND], alg SumAlgorithm) Float32WithRound[RND] {
+	var rnd RND
+
+	term := func(i int) uint32 { return xs[i].bits }
+
+	return Float32WithRound[RND]{sum[binary32](len(xs), term, alg, rnd)}
+}
+
+// Float32Dot returns the dot product of xs and ys, accumulated according to alg.
+// It panics if xs and ys have different lengths.
+func Float32Dot[RND RoundingMode](xs, ys []Float32WithRound[RND], alg SumAlgorithm) Float32WithRound[RND] {
+	var rnd RND
+
+	if len(xs) != len(ys) {
+		panic("Float32Dot: slices of different lengths")
+	}
+
+	x := func(i int) uint32 { return xs[i].bits }
+	y := func(i int) uint32 { return ys[i].bits }
+
+	return Float32WithRound[RND]{dot[binary32](len(xs), x, y, alg, rnd)}
+}
+
+// Float32Norm2 returns the Euclidean norm of xs, the square root of the sum of their squares,
+// taking care to avoid unnecessary overflow and underflow.
+// The sum of the squares is accumulated according to alg.
+func Float32Norm2[RND RoundingMode](xs []Float32WithRound[RND], alg SumAlgorithm) Float32WithRound[RND] {
+	var rnd RND
+
+	x := func(i int) uint32 { return xs[i].bits }
+
+	return Float32WithRound[RND]{norm2[binary32](len(xs), x, alg, rnd)}
+}
+
+// Float64Sum returns the sum of xs, accumulated according to alg.
+func Float64Sum[RND RoundingMode](xs []Float64WithRound[RND], alg SumAlgorithm) Float64WithRound[RND] {
+	var rnd RND
+
+	term := func(i int) uint64 { return xs[i].bits }
+
+	return Float64WithRound[RND]{sum[binary64](len(xs), term, alg, rnd)}
+}
+
+// Float64Dot returns the dot product of xs and ys, accumulated according to alg.
+// It panics if xs and ys have different lengths.
+func Float64Dot[RND RoundingMode](xs, ys []Float64WithRound[RND], alg SumAlgorithm) Float64WithRound[RND] {
+	var rnd RND
+
+	if len(xs) != len(ys) {
+		panic("Float64Dot: slices of different lengths")
+	}
+
+	x := func(i int) uint64 { return xs[i].bits }
+	y := func(i int) uint64 { return ys[i].bits }
+
+	return Float64WithRound[RND]{dot[binary64](len(xs), x, y, alg, rnd)}
+}
+
+// Float64Norm2 returns the Euclidean norm of xs, the square root of the sum of their squares,
+// taking care to avoid unnecessary overflow and underflow.
+// The sum of the squares is accumulated according to alg.
+func Float64Norm2[RND RoundingMode](xs []Float64WithRound[RND], alg SumAlgorithm) Float64WithRound[RND] {
+	var rnd RND
+
+	x := func(i int) uint64 { return xs[i].bits }
+
+	return Float64WithRound[RND]{norm2[binary64](len(xs), x, alg, rnd)}
+}
+
+// Float128Sum returns the sum of xs, accumulated according to alg.
+func Float128Sum[RND RoundingMode](xs []Float128WithRound[RND], alg SumAlgorithm) Float128WithRound[RND] {
+	var rnd RND
+
+	term := func(i int) bits.Uint128 { return xs[i].bits }
+
+	return Float128WithRound[RND]{sum[binary128](len(xs), term, alg, rnd)}
+}
+
+// Float128Dot returns the dot product of xs and ys, accumulated according to alg.
+// It panics if xs and ys have different lengths.
+func Float128Dot[RND RoundingMode](xs, ys []Float128WithRound[RND], alg SumAlgorithm) Float128WithRound[RND] {
+	var rnd RND
+
+	if len(xs) != len(ys) {
+		panic("Float128Dot: slices of different lengths")
+	}
+
+	x := func(i int) bits.Uint128 { return xs[i].bits }
+	y := func(i int) bits.Uint128 { return ys[i].bits }
+
+	return Float128WithRound[RND]{dot[binary128](len(xs), x, y, alg, rnd)}
+}
+
+// Float128Norm2 returns the Euclidean norm of xs, the square root of the sum of their squares,
+// taking care to avoid unnecessary overflow and underflow.
+// The sum of the squares is accumulated according to alg.
+func Float128Norm2[RND RoundingMode](xs []Float128WithRound[RND], alg SumAlgorithm) Float128WithRound[RND] {
+	var rnd RND
+
+	x := func(i int) bits.Uint128 { return xs[i].bits }
+
+	return Float128WithRound[RND]{norm2[binary128](len(xs), x, alg, rnd)}
+}
+
+// sum returns the sum of the n terms given by term, accumulated according to alg.
+func sum[SPEC spec[D], D datum](n int, term func(int) D, alg SumAlgorithm, rounding RoundingMode) D {
+	switch alg {
+	case SumPairwise:
+		return sumPairwise[SPEC](0, n, term, rounding)
+
+	case SumNeumaier:
+		if !toNearest(rounding) {
+			return sum[SPEC](n, term, SumExact, rounding)
+		}
+		return sumNeumaier[SPEC](n, term, rounding)
+
+	case SumExact:
+		acc := newExactSum[SPEC](2)
+		for i := 0; i < n; i++ {
+			acc.add(term(i))
+		}
+		return acc.result(rounding)
+
+	case SumNaive:
+		var s D
+		for i := 0; i < n; i++ {
+			s = add[SPEC](s, term(i), rounding)
+		}
+		return s
+	}
+
+	panic("unknown SumAlgorithm")
+}
+
+func sumPairwise[SPEC spec[D], D datum](lo, hi int, term func(int) D, rounding RoundingMode) D {
+	if hi-lo <= pairwiseBlock {
+		var s D
+		for i := lo; i < hi; i++ {
+			s = add[SPEC](s, term(i), rounding)
+		}
+		return s
+	}
+
+	mid := lo + (hi-lo)/2
+
+	return add[SPEC](sumPairwise[SPEC](lo, mid, term, rounding), sumPairwise[SPEC](mid, hi, term, rounding), rounding)
+}
+
+func sumNeumaier[SPEC spec[D], D datum](n int, term func(int) D, rounding RoundingMode) D {
+	var spec SPEC
+	var s, c D
+
+	for i := 0; i < n; i++ {
+		x := term(i)
+		t := add[SPEC](s, x, rounding)
+
+		_, sm := mag[SPEC](s)
+		_, xm := mag[SPEC](x)
+
+		// The rounding error of s+x is recovered exactly from the smaller of the two operands.
+		if spec.Gte(sm, xm) {
+			c = add[SPEC](c, add[SPEC](sub[SPEC](s, t, rounding), x, rounding), rounding)
+		} else {
+			c = add[SPEC](c, add[SPEC](sub[SPEC](x, t, rounding), s, rounding), rounding)
+		}
+
+		s = t
+	}
+
+	if isNaN[SPEC](s) || isInf[SPEC](s) {
+		// The compensation is meaningless, once the sum is not finite.
+		return s
+	}
+
+	return add[SPEC](s, c, rounding)
+}
+
+// dot returns the sum of the products of the n pairs of terms given by x and y, accumulated according to alg.
+func dot[SPEC spec[D], D datum](n int, x, y func(int) D, alg SumAlgorithm, rounding RoundingMode) D {
+	switch alg {
+	case SumNeumaier:
+		if !toNearest(rounding) {
+			return dot[SPEC](n, x, y, SumExact, rounding)
+		}
+		return dotNeumaier[SPEC](n, x, y, rounding)
+
+	case SumExact:
+		acc := newExactSum[SPEC](4)
+		for i := 0; i < n; i++ {
+			acc.addProd(x(i), y(i))
+		}
+		return acc.result(rounding)
+	}
+
+	prod := func(i int) D {
+		return mul[SPEC](x(i), y(i), rounding)
+	}
+
+	return sum[SPEC](n, prod, alg, rounding)
+}
+
+// dotNeumaier is the compensated dot product of Ogita, Rump, and Oishi,
+// which also recovers the error of each product exactly.
+func dotNeumaier[SPEC spec[D], D datum](n int, x, y func(int) D, rounding RoundingMode) D {
+	var spec SPEC
+	var s, c D
+
+	for i := 0; i < n; i++ {
+		xi, yi := x(i), y(i)
+
+		p := mul[SPEC](xi, yi, rounding)
+
+		var e D
+		if _, pm := mag[SPEC](p); !spec.IsZero(pm) && spec.Lt(pm, magInf[SPEC]()) {
+			e = prodErr[SPEC](xi, yi, p, rounding)
+		}
+
+		t := add[SPEC](s, p, rounding)
+
+		_, sm := mag[SPEC](s)
+		_, pm := mag[SPEC](p)
+
+		var q D
+		if spec.Gte(sm, pm) {
+			q = add[SPEC](sub[SPEC](s, t, rounding), p, rounding)
+		} else {
+			q = add[SPEC](sub[SPEC](p, t, rounding), s, rounding)
+		}
+
+		c = add[SPEC](c, add[SPEC](q, e, rounding), rounding)
+		s = t
+	}
+
+	if isNaN[SPEC](s) || isInf[SPEC](s) {
+		return s
+	}
+
+	return add[SPEC](s, c, rounding)
+}
+
+// norm2 returns the square root of the sum of the squares of the n terms given by x,
+// taking care to avoid unnecessary overflow and underflow.
+func norm2[SPEC spec[D], D datum](n int, x func(int) D, alg SumAlgorithm, rounding RoundingMode) D {
+	var spec SPEC
+	var max D
+
+	hasNaN := false
+	for i := 0; i < n; i++ {
+		_, m := mag[SPEC](x(i))
+
+		switch {
+		case spec.Eq(m, magInf[SPEC]()):
+			return magInf[SPEC]()
+		case spec.Gt(m, magInf[SPEC]()):
+			hasNaN = true
+		case spec.Gt(m, max):
+			max = m
+		}
+	}
+
+	switch {
+	case hasNaN:
+		return nan[SPEC]()
+	case spec.IsZero(max):
+		return max
+	}
+
+	if alg == SumExact {
+		acc := newExactSum[SPEC](4)
+		for i := 0; i < n; i++ {
+			acc.addProd(x(i), x(i))
+		}
+		return acc.sqrt(rounding)
+	}
+
+	// Scale every term by the same power of two, so that the largest is near 1/√n,
+	// and the sum of their squares is then no more than about 1.
+	k, _ := ilogb[SPEC](max)
+	k += (stdbits.Len(uint(n)) + 1) / 2
+	scaled := func(i int) D {
+		return ldexp[SPEC](x(i), -k, rounding)
+	}
+
+	s := dot[SPEC](n, scaled, scaled, alg, rounding)
+
+	return ldexp[SPEC](sqrt[SPEC](s, rounding), k, rounding)
+}
+
+// exactSum accumulates a sum of finite values exactly, while separately tracking any non-finite values.
+type exactSum[SPEC spec[D], D datum] struct {
+	sum *big.Float
+
+	// pos and neg report whether any finite term had its sign bit clear, or set,
+	// which decides the sign of a sum that is exactly zero.
+	pos, neg bool
+
+	nan            bool
+	posInf, negInf bool
+}
+
+// newExactSum returns an exactSum, with enough precision for sums of the product of up to factors values.
+func newExactSum[SPEC spec[D], D datum](factors int) *exactSum[SPEC, D] {
+	var spec SPEC
+
+	// Every finite value is an integer multiple of the smallest sub-normal,
+	// and fits within this many bits, with plenty of room left over for carries.
+	prec := factors * (2*expBias[SPEC]() + spec.mantWidth() + 1)
+
+	return &exactSum[SPEC, D]{
+		sum: new(big.Float).SetPrec(uint(prec + 64)),
+	}
+}
+
+func (acc *exactSum[SPEC, D]) add(x D) {
+	switch {
+	case isNaN[SPEC](x):
+		acc.nan = true
+	case isInf[SPEC](x):
+		acc.addInf(signBit[SPEC](x))
+	default:
+		acc.addSign(signBit[SPEC](x))
+		acc.sum.Add(acc.sum, toBigFloat[SPEC](x))
+	}
+}
+
+func (acc *exactSum[SPEC, D]) addProd(x, y D) {
+	var spec SPEC
+
+	_, xm := mag[SPEC](x)
+	_, ym := mag[SPEC](y)
+
+	switch {
+	case isNaN[SPEC](x), isNaN[SPEC](y):
+		acc.nan = true
+	case isInf[SPEC](x), isInf[SPEC](y):
+		if spec.IsZero(xm) || spec.IsZero(ym) {
+			// ±∞ × 0 = NaN
+			acc.nan = true
+			return
+		}
+		acc.addInf(signBit[SPEC](x) != signBit[SPEC](y))
+	default:
+		acc.addSign(signBit[SPEC](x) != signBit[SPEC](y))
+		prod := new(big.Float).SetPrec(uint(2 * spec.width()))
+		acc.sum.Add(acc.sum, prod.Mul(toBigFloat[SPEC](x), toBigFloat[SPEC](y)))
+	}
+}
+
+func (acc *exactSum[SPEC, D]) addSign(sign bool) {
+	if sign {
+		acc.neg = true
+	} else {
+		acc.pos = true
+	}
+}
+
+func (acc *exactSum[SPEC, D]) addInf(sign bool) {
+	if sign {
+		acc.negInf = true
+	} else {
+		acc.posInf = true
+	}
+}
+
+func (acc *exactSum[SPEC, D]) result(rounding RoundingMode) D {
+	switch {
+	case acc.nan, acc.posInf && acc.negInf:
+		return nan[SPEC]()
+	case acc.posInf:
+		return inf[SPEC](false)
+	case acc.negInf:
+		return inf[SPEC](true)
+	}
+
+	if acc.sum.Sign() == 0 && (acc.pos || acc.neg) {
+		// As a rounded sum would: -0 if every term is negative, and otherwise -0 only if rounding toward negative.
+		return zeroSum[SPEC](acc.neg, !acc.pos, rounding)
+	}
+
+	return fromBigFloat[SPEC](acc.sum, rounding)
+}
+
+func (acc *exactSum[SPEC, D]) sqrt(rounding RoundingMode) D {
+	var spec SPEC
+
+	// Sufficient extra precision makes a double rounding here vanishingly rare.
+	root := new(big.Float).SetPrec(uint(2*spec.width() + 64)).Sqrt(acc.sum)
+
+	return fromBigFloat[SPEC](root, rounding)
+}
+
+// toBigFloat returns the exact value of the finite x as a *big.Float.
+func toBigFloat[SPEC spec[D], D datum](x D) *big.Float {
+	var spec SPEC
+
+	f := decode[SPEC](x)
+
+	z := new(big.Float).SetPrec(uint(spec.width()))
+
+	switch m := any(f.m).(type) {
+	case bits.Uint128:
+		z.SetUint64(m.Hi)
+		z.SetMantExp(z, 64)
+		z.Add(z, new(big.Float).SetUint64(m.Lo))
+
+	default:
+		z.SetUint64(spec.Uint128(f.m).Lo)
+	}
+
+	// The top bit of the mantissa is the units bit.
+	z.SetMantExp(z, f.e-expBias[SPEC]()-(spec.width()-1))
+
+	if f.s {
+		z.Neg(z)
+	}
+
+	return z
+}
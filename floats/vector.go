@@ -0,0 +1,695 @@
+package floats
+
+import (
+	"math"
+	stdbits "math/bits"
+)
+
+// Accumulator selects the precision in which the reductions of vectors accumulate,
+// before the result is rounded once to the format of the vector.
+type Accumulator int
+
+const (
+	// AccumulateFloat32 accumulates in float32, as half-precision hardware usually does.
+	AccumulateFloat32 Accumulator = iota
+
+	// AccumulateFloat64 accumulates in float64.
+	AccumulateFloat64
+
+	// AccumulateExact accumulates exactly, in a fixed-point accumulator wide enough for any sum of products in the format,
+	// which gives a correctly-rounded result.
+	AccumulateExact
+)
+
+// Float16Vec is a vector of Float16 values, using the RoundTiesToEven rounding mode.
+type Float16Vec = Float16VecWithRound[RoundTiesToEven]
+
+// Float16VecWithRound is a vector of Float16 values, using the specified rounding mode.
+//
+// Its operations work through the vector in blocks converted to native floating-point numbers,
+// in tight loops that do not allocate.
+// Elementwise operations are correctly rounded in the rounding mode, overflow included,
+// as the scalar methods are, except that a NaN result is always the default quiet NaN.
+// They may be given the same vector as both destination and operand.
+type Float16VecWithRound[RND RoundingMode] []Float16WithRound[RND]
+
+// Add sets z to the elementwise sum x+y, and returns z.
+// It panics if the vectors have different lengths.
+func (z Float16VecWithRound[RND]) Add(x, y Float16VecWithRound[RND]) Float16VecWithRound[RND] {
+	var rnd RND
+
+	vecAdd(&float16Vec, z, x, y, rnd)
+	return z
+}
+
+// Mul sets z to the elementwise product x*y, and returns z.
+// It panics if the vectors have different lengths.
+func (z Float16VecWithRound[RND]) Mul(x, y Float16VecWithRound[RND]) Float16VecWithRound[RND] {
+	var rnd RND
+
+	vecMul(&float16Vec, z, x, y, rnd)
+	return z
+}
+
+// FMA sets z to the elementwise fused multiply-add x*y+a, computed with only one rounding, and returns z.
+// It panics if the vectors have different lengths.
+func (z Float16VecWithRound[RND]) FMA(x, y, a Float16VecWithRound[RND]) Float16VecWithRound[RND] {
+	var rnd RND
+
+	vecFMA(&float16Vec, z, x, y, a, rnd)
+	return z
+}
+
+// Scale sets z to the product of each element of x by a, and returns z.
+// It panics if the vectors have different lengths.
+func (z Float16VecWithRound[RND]) Scale(a Float16WithRound[RND], x Float16VecWithRound[RND]) Float16VecWithRound[RND] {
+	var rnd RND
+
+	vecScale(&float16Vec, z, a, x, rnd)
+	return z
+}
+
+// Dot returns the dot product of x and y, accumulated according to acc.
+// It panics if the vectors have different lengths.
+func (x Float16VecWithRound[RND]) Dot(y Float16VecWithRound[RND], acc Accumulator) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{vecDot(&float16Vec, x, y, acc, rnd)}
+}
+
+// Sum returns the sum of the elements of x, accumulated according to acc.
+func (x Float16VecWithRound[RND]) Sum(acc Accumulator) Float16WithRound[RND] {
+	var rnd RND
+
+	return Float16WithRound[RND]{vecSum(&float16Vec, x, acc, rnd)}
+}
+
+// Max returns the greatest element of x, ignoring NaNs, where +0 is greater than -0.
+// If x is empty, or all NaNs, it returns NaN.
+func (x Float16VecWithRound[RND]) Max() Float16WithRound[RND] {
+	return Float16WithRound[RND]{vecMax(&float16Vec, x)}
+}
+
+// SetFloat32s sets z to the values of src, rounded to Float16, and returns z.
+// It panics if z and src have different lengths.
+func (z Float16VecWithRound[RND]) SetFloat32s(src []float32) Float16VecWithRound[RND] {
+	var rnd RND
+
+	vecSetFloat32s(&float16Vec, z, src, rnd)
+	return z
+}
+
+// Float32s stores the values of x into dst.
+// There is no loss of precision.
+// It panics if x and dst have different lengths.
+func (x Float16VecWithRound[RND]) Float32s(dst []float32) {
+	vecFloat32s(&float16Vec, dst, x)
+}
+
+// BFloat16Vec is a vector of BFloat16 values, using the RoundTiesToEven rounding mode.
+type BFloat16Vec = BFloat16VecWithRound[RoundTiesToEven]
+
+// BFloat16VecWithRound is a vector of BFloat16 values, using the specified rounding mode.
+//
+// Its operations work through the vector in blocks converted to native floating-point numbers,
+// in tight loops that do not allocate.
+// Elementwise operations are correctly rounded in the rounding mode, overflow included,
+// as the scalar methods are, except that a NaN result is always the default quiet NaN.
+// They may be given the same vector as both destination and operand.
+type BFloat16VecWithRound[RND RoundingMode] []BFloat16WithRound[RND]
+
+// Add sets z to the elementwise sum x+y, and returns z.
+// It panics if the vectors have different lengths.
+func (z BFloat16VecWithRound[RND]) Add(x, y BFloat16VecWithRound[RND]) BFloat16VecWithRound[RND] {
+	var rnd RND
+
+	vecAdd(&bfloat16Vec, z, x, y, rnd)
+	return z
+}
+
+// Mul sets z to the elementwise product x*y, and returns z.
+// It panics if the vectors have different lengths.
+func (z BFloat16VecWithRound[RND]) Mul(x, y BFloat16VecWithRound[RND]) BFloat16VecWithRound[RND] {
+	var rnd RND
+
+	vecMul(&bfloat16Vec, z, x, y, rnd)
+	return z
+}
+
+// FMA sets z to the elementwise fused multiply-add x*y+a, computed with only one rounding, and returns z.
+// It panics if the vectors have different lengths.
+func (z BFloat16VecWithRound[RND]) FMA(x, y, a BFloat16VecWithRound[RND]) BFloat16VecWithRound[RND] {
+	var rnd RND
+
+	vecFMA(&bfloat16Vec, z, x, y, a, rnd)
+	return z
+}
+
+// Scale sets z to the product of each element of x by a, and returns z.
+// It panics if the vectors have different lengths.
+func (z BFloat16VecWithRound[RND]) Scale(a BFloat16WithRound[RND], x BFloat16VecWithRound[RND]) BFloat16VecWithRound[RND] {
+	var rnd RND
+
+	vecScale(&bfloat16Vec, z, a, x, rnd)
+	return z
+}
+
+// Dot returns the dot product of x and y, accumulated according to acc.
+// It panics if the vectors have different lengths.
+func (x BFloat16VecWithRound[RND]) Dot(y BFloat16VecWithRound[RND], acc Accumulator) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{vecDot(&bfloat16Vec, x, y, acc, rnd)}
+}
+
+// Sum returns the sum of the elements of x, accumulated according to acc.
+func (x BFloat16VecWithRound[RND]) Sum(acc Accumulator) BFloat16WithRound[RND] {
+	var rnd RND
+
+	return BFloat16WithRound[RND]{vecSum(&bfloat16Vec, x, acc, rnd)}
+}
+
+// Max returns the greatest element of x, ignoring NaNs, where +0 is greater than -0.
+// If x is empty, or all NaNs, it returns NaN.
+func (x BFloat16VecWithRound[RND]) Max() BFloat16WithRound[RND] {
+	return BFloat16WithRound[RND]{vecMax(&bfloat16Vec, x)}
+}
+
+// SetFloat32s sets z to the values of src, rounded to BFloat16, and returns z.
+// It panics if z and src have different lengths.
+func (z BFloat16VecWithRound[RND]) SetFloat32s(src []float32) BFloat16VecWithRound[RND] {
+	var rnd RND
+
+	vecSetFloat32s(&bfloat16Vec, z, src, rnd)
+	return z
+}
+
+// Float32s stores the values of x into dst.
+// There is no loss of precision.
+// It panics if x and dst have different lengths.
+func (x BFloat16VecWithRound[RND]) Float32s(dst []float32) {
+	vecFloat32s(&bfloat16Vec, dst, x)
+}
+
+// halfElem is the constraint of the 16-bit floating-point types, whatever their rounding mode.
+type halfElem interface {
+	~struct{ bits uint16 }
+}
+
+func bitsOf[E halfElem](x E) uint16 {
+	return struct{ bits uint16 }(x).bits
+}
+
+func fromBits[E halfElem](h uint16) E {
+	return E(struct{ bits uint16 }{h})
+}
+
+// halfVec is a 16-bit format, as the vector operations see it.
+type halfVec struct {
+	name    string
+	bfloat  bool
+	nanBits uint16
+
+	// lsb is the exponent of the product of the two least sub-normal numbers,
+	// which every sum of products in the format is a multiple of.
+	lsb int
+}
+
+var float16Vec = halfVec{
+	name:    "Float16Vec",
+	nanBits: nan[binary16](),
+	lsb:     -48,
+}
+
+var bfloat16Vec = halfVec{
+	name:    "BFloat16Vec",
+	bfloat:  true,
+	nanBits: nan[bfloat16](),
+	lsb:     -266,
+}
+
+// The bulk conversions are called directly, rather than through function values,
+// so that the buffers passed to them can stay on the stack.
+
+func (v *halfVec) decode32(dst []float32, src []uint16) {
+	if v.bfloat {
+		decodeBFloat16s32(dst, src)
+		return
+	}
+	decodeFloat16s32(dst, src)
+}
+
+func (v *halfVec) decode64(dst []float64, src []uint16) {
+	if v.bfloat {
+		decodeBFloat16s64(dst, src)
+		return
+	}
+	decodeFloat16s64(dst, src)
+}
+
+func (v *halfVec) encode32(dst []uint16, src []float32, rounding RoundingMode) {
+	if v.bfloat {
+		encodeBFloat16s32(dst, src, rounding)
+		return
+	}
+	encodeFloat16s32(dst, src, rounding)
+}
+
+func (v *halfVec) encode64(dst []uint16, src []float64, rounding RoundingMode) {
+	if v.bfloat {
+		encodeBFloat16s64(dst, src, rounding)
+		return
+	}
+	encodeFloat16s64(dst, src, rounding)
+}
+
+func (v *halfVec) checkLen(op string, n int, lens ...int) {
+	for _, l := range lens {
+		if l != n {
+			panic(v.name + "." + op + ": vectors of different lengths")
+		}
+	}
+}
+
+// vecBlock is the number of elements converted at a time, into buffers on the stack.
+const vecBlock = 64
+
+// load32 converts the elements of x, of which there are at most vecBlock, into buf, and returns them.
+func load32[E halfElem](v *halfVec, buf *[vecBlock]float32, x []E) []float32 {
+	var h [vecBlock]uint16
+	for i, e := range x {
+		h[i] = bitsOf(e)
+	}
+
+	v.decode32(buf[:len(x)], h[:len(x)])
+	return buf[:len(x)]
+}
+
+// load64 converts the elements of x, of which there are at most vecBlock, into buf, and returns them.
+func load64[E halfElem](v *halfVec, buf *[vecBlock]float64, x []E) []float64 {
+	var h [vecBlock]uint16
+	for i, e := range x {
+		h[i] = bitsOf(e)
+	}
+
+	v.decode64(buf[:len(x)], h[:len(x)])
+	return buf[:len(x)]
+}
+
+// store64 rounds the values of f into the elements of z.
+func store64[E halfElem](v *halfVec, z []E, f []float64, rounding RoundingMode) {
+	var h [vecBlock]uint16
+	v.encode64(h[:len(f)], f, rounding)
+
+	for i := range z {
+		z[i] = fromBits[E](h[i])
+	}
+}
+
+// addOdd returns x+y rounded to odd: when the sum is inexact, the neighbour of the two around it that has an odd mantissa.
+//
+// Rounding to odd at 53 bits keeps enough information that rounding the result again to a 16-bit format,
+// by any rounding mode, gives the same result as rounding the exact sum to it directly.
+//
+// A sum that is exactly zero is -0 if negZero is true, unless both x and y are +0.
+func addOdd(x, y float64, negZero bool) float64 {
+	s := x + y
+
+	if s == 0 && negZero {
+		// Sums of zero are +0 in float64, unless both terms are -0, so this flips which case is the exception.
+		return -(-x - y)
+	}
+
+	// TwoSum: the error of s, recovered exactly.
+	yy := s - x
+	e := (x - (s - yy)) + (y - yy)
+
+	u := math.Float64bits(s)
+	if e == 0 || u&1 == 1 || math.IsInf(s, 0) || math.IsNaN(s) {
+		return s
+	}
+
+	// s was rounded to even, so step to its odd neighbour, on the side of the exact sum.
+	if (e > 0) == (s > 0) {
+		return math.Float64frombits(u + 1)
+	}
+
+	return math.Float64frombits(u - 1)
+}
+
+// fmaOdd returns x*y+z rounded to odd, for x, y and z of a format with at most 24 bits of precision.
+//
+// The product of two such values is exact in float64, so only the sum is rounded,
+// and rounding the result again to the format gives x*y+z with only one rounding.
+func fmaOdd(x, y, z float64, rounding RoundingMode) float64 {
+	return addOdd(x*y, z, towardNegative(rounding))
+}
+
+func vecAdd[E halfElem](v *halfVec, z, x, y []E, rounding RoundingMode) {
+	v.checkLen("Add", len(z), len(x), len(y))
+
+	negZero := towardNegative(rounding)
+
+	var xb, yb [vecBlock]float64
+
+	for lo := 0; lo < len(z); lo += vecBlock {
+		hi := min(lo+vecBlock, len(z))
+
+		xf := load64(v, &xb, x[lo:hi])
+		yf := load64(v, &yb, y[lo:hi])
+
+		for i := range xf {
+			xf[i] = addOdd(xf[i], yf[i], negZero)
+		}
+
+		store64(v, z[lo:hi], xf, rounding)
+	}
+}
+
+func vecMul[E halfElem](v *halfVec, z, x, y []E, rounding RoundingMode) {
+	v.checkLen("Mul", len(z), len(x), len(y))
+
+	var xb, yb [vecBlock]float64
+
+	for lo := 0; lo < len(z); lo += vecBlock {
+		hi := min(lo+vecBlock, len(z))
+
+		xf := load64(v, &xb, x[lo:hi])
+		yf := load64(v, &yb, y[lo:hi])
+
+		// The product of two 16-bit numbers is exact in a float64.
+		for i := range xf {
+			xf[i] *= yf[i]
+		}
+
+		store64(v, z[lo:hi], xf, rounding)
+	}
+}
+
+func vecFMA[E halfElem](v *halfVec, z, x, y, a []E, rounding RoundingMode) {
+	v.checkLen("FMA", len(z), len(x), len(y), len(a))
+
+	negZero := towardNegative(rounding)
+
+	var xb, yb, ab [vecBlock]float64
+
+	for lo := 0; lo < len(z); lo += vecBlock {
+		hi := min(lo+vecBlock, len(z))
+
+		xf := load64(v, &xb, x[lo:hi])
+		yf := load64(v, &yb, y[lo:hi])
+		af := load64(v, &ab, a[lo:hi])
+
+		for i := range xf {
+			// The product is exact, so only the sum needs rounding, and to odd, for it is rounded again.
+			xf[i] = addOdd(float64(xf[i]*yf[i]), af[i], negZero)
+		}
+
+		store64(v, z[lo:hi], xf, rounding)
+	}
+}
+
+func vecScale[E halfElem](v *halfVec, z []E, a E, x []E, rounding RoundingMode) {
+	v.checkLen("Scale", len(z), len(x))
+
+	var s [1]float64
+	v.decode64(s[:], []uint16{bitsOf(a)})
+
+	var xb [vecBlock]float64
+
+	for lo := 0; lo < len(z); lo += vecBlock {
+		hi := min(lo+vecBlock, len(z))
+
+		xf := load64(v, &xb, x[lo:hi])
+
+		for i := range xf {
+			xf[i] *= s[0]
+		}
+
+		store64(v, z[lo:hi], xf, rounding)
+	}
+}
+
+func vecSetFloat32s[E halfElem](v *halfVec, z []E, src []float32, rounding RoundingMode) {
+	v.checkLen("SetFloat32s", len(z), len(src))
+
+	var h [vecBlock]uint16
+
+	for lo := 0; lo < len(z); lo += vecBlock {
+		hi := min(lo+vecBlock, len(z))
+
+		v.encode32(h[:hi-lo], src[lo:hi], rounding)
+
+		for i := range z[lo:hi] {
+			z[lo+i] = fromBits[E](h[i])
+		}
+	}
+}
+
+func vecFloat32s[E halfElem](v *halfVec, dst []float32, x []E) {
+	v.checkLen("Float32s", len(x), len(dst))
+
+	var b [vecBlock]float32
+
+	for lo := 0; lo < len(x); lo += vecBlock {
+		hi := min(lo+vecBlock, len(x))
+
+		copy(dst[lo:hi], load32(v, &b, x[lo:hi]))
+	}
+}
+
+func vecMax[E halfElem](v *halfVec, x []E) uint16 {
+	var b [vecBlock]float32
+
+	max, found := v.nanBits, false
+	var best float32
+
+	for lo := 0; lo < len(x); lo += vecBlock {
+		hi := min(lo+vecBlock, len(x))
+
+		for i, f := range load32(v, &b, x[lo:hi]) {
+			if f != f {
+				continue
+			}
+
+			if !found || f > best || (f == best && math.Signbit(float64(best))) {
+				max, best, found = bitsOf(x[lo+i]), f, true
+			}
+		}
+	}
+
+	return max
+}
+
+func vecSum[E halfElem](v *halfVec, x []E, acc Accumulator, rounding RoundingMode) uint16 {
+	switch acc {
+	case AccumulateFloat32:
+		var b [vecBlock]float32
+		var sum float32
+
+		for lo := 0; lo < len(x); lo += vecBlock {
+			for _, f := range load32(v, &b, x[lo:min(lo+vecBlock, len(x))]) {
+				sum += f
+			}
+		}
+
+		return v.round32(sum, rounding)
+
+	case AccumulateFloat64:
+		var b [vecBlock]float64
+		var sum float64
+
+		for lo := 0; lo < len(x); lo += vecBlock {
+			for _, f := range load64(v, &b, x[lo:min(lo+vecBlock, len(x))]) {
+				sum += f
+			}
+		}
+
+		return v.round64(sum, rounding)
+
+	case AccumulateExact:
+		var b [vecBlock]float64
+		sum := fixedAcc{lsb: v.lsb}
+
+		for lo := 0; lo < len(x); lo += vecBlock {
+			for _, f := range load64(v, &b, x[lo:min(lo+vecBlock, len(x))]) {
+				sum.add(f)
+			}
+		}
+
+		return v.round64(sum.float64(), rounding)
+	}
+
+	panic("unknown Accumulator")
+}
+
+func vecDot[E halfElem](v *halfVec, x, y []E, acc Accumulator, rounding RoundingMode) uint16 {
+	v.checkLen("Dot", len(x), len(y))
+
+	switch acc {
+	case AccumulateFloat32:
+		var xb, yb [vecBlock]float32
+		var sum float32
+
+		for lo := 0; lo < len(x); lo += vecBlock {
+			hi := min(lo+vecBlock, len(x))
+
+			yf := load32(v, &yb, y[lo:hi])
+			for i, f := range load32(v, &xb, x[lo:hi]) {
+				// The explicit conversion keeps the product from being fused into the sum.
+				sum += float32(f * yf[i])
+			}
+		}
+
+		return v.round32(sum, rounding)
+
+	case AccumulateFloat64:
+		var xb, yb [vecBlock]float64
+		var sum float64
+
+		for lo := 0; lo < len(x); lo += vecBlock {
+			hi := min(lo+vecBlock, len(x))
+
+			yf := load64(v, &yb, y[lo:hi])
+			for i, f := range load64(v, &xb, x[lo:hi]) {
+				sum += float64(f * yf[i])
+			}
+		}
+
+		return v.round64(sum, rounding)
+
+	case AccumulateExact:
+		var xb, yb [vecBlock]float64
+		sum := fixedAcc{lsb: v.lsb}
+
+		for lo := 0; lo < len(x); lo += vecBlock {
+			hi := min(lo+vecBlock, len(x))
+
+			yf := load64(v, &yb, y[lo:hi])
+			for i, f := range load64(v, &xb, x[lo:hi]) {
+				sum.add(f * yf[i])
+			}
+		}
+
+		return v.round64(sum.float64(), rounding)
+	}
+
+	panic("unknown Accumulator")
+}
+
+func (v *halfVec) round32(f float32, rounding RoundingMode) uint16 {
+	var h [1]uint16
+	v.encode32(h[:], []float32{f}, rounding)
+	return h[0]
+}
+
+func (v *halfVec) round64(f float64, rounding RoundingMode) uint16 {
+	var h [1]uint16
+	v.encode64(h[:], []float64{f}, rounding)
+	return h[0]
+}
+
+// fixedAcc is a fixed-point two’s complement accumulator, wide enough to hold exactly any sum of products
+// of 16-bit floating-point numbers, while separately tracking any non-finite values.
+type fixedAcc struct {
+	// w are the words of the accumulator, least-significant first.
+	w [10]uint64
+
+	// lsb is the exponent of the least-significant bit of the accumulator.
+	lsb int
+
+	// special is the sum of any non-finite values.
+	special float64
+}
+
+// add adds x, which must be a multiple of 2**lsb, to the accumulator.
+func (acc *fixedAcc) add(x float64) {
+	if math.IsInf(x, 0) || math.IsNaN(x) {
+		acc.special += x
+		return
+	}
+
+	if x == 0 {
+		return
+	}
+
+	frac, exp := math.Frexp(math.Abs(x))
+	m := uint64(frac * (1 << 53))
+
+	// x is a multiple of 2**lsb, so once the trailing zeros are dropped, the shift cannot be negative.
+	tz := stdbits.TrailingZeros64(m)
+	m >>= tz
+	shift := exp - 53 + tz - acc.lsb
+
+	i, s := shift/64, uint(shift%64)
+	lo, hi := m<<s, m>>(64-s)
+
+	var carry uint64
+	if x > 0 {
+		acc.w[i], carry = stdbits.Add64(acc.w[i], lo, 0)
+		for j := i + 1; j < len(acc.w) && (carry|hi) != 0; j++ {
+			acc.w[j], carry = stdbits.Add64(acc.w[j], hi, carry)
+			hi = 0
+		}
+		return
+	}
+
+	acc.w[i], carry = stdbits.Sub64(acc.w[i], lo, 0)
+	for j := i + 1; j < len(acc.w) && (carry|hi) != 0; j++ {
+		acc.w[j], carry = stdbits.Sub64(acc.w[j], hi, carry)
+		hi = 0
+	}
+}
+
+// float64 returns the value of the accumulator rounded to odd at 53 bits,
+// which a later rounding to a 16-bit format rounds as it would the exact value.
+func (acc *fixedAcc) float64() float64 {
+	if acc.special != 0 || acc.special != acc.special {
+		return acc.special
+	}
+
+	w := acc.w
+
+	neg := w[len(w)-1]>>63 != 0
+	if neg {
+		carry := uint64(1)
+		for i := range w {
+			w[i], carry = stdbits.Add64(^w[i], 0, carry)
+		}
+	}
+
+	k := len(w) - 1
+	for k >= 0 && w[k] == 0 {
+		k--
+	}
+
+	if k < 0 {
+		return 0
+	}
+
+	// The 64 bits from the most-significant bit down, and whether any bits below them are set.
+	n := stdbits.Len64(w[k])
+	top := w[k] << (64 - n)
+	var sticky bool
+
+	if k > 0 {
+		top |= w[k-1] >> n
+		sticky = w[k-1]<<(64-n) != 0
+	}
+
+	for _, word := range w[:max(k-1, 0)] {
+		sticky = sticky || word != 0
+	}
+
+	m := top >> 11
+	if sticky || top&(1<<11-1) != 0 {
+		m |= 1
+	}
+
+	f := math.Ldexp(float64(m), acc.lsb+64*k+n-53)
+	if neg {
+		return -f
+	}
+
+	return f
+}
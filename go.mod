@@ -1,8 +1,6 @@
 module github.com/puellanivis/math
 
-go 1.21
-
-toolchain go1.21.2
+go 1.22
 
 require github.com/puellanivis/breton v0.2.16
 
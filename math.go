@@ -1,5 +1,6 @@
 // Package math mostly provides genericized wrappers around the standard math library.
 // Some functions have different behaviors from the math library to be more compliant with the IEEE-754 standard.
+// Functions prefixed with Soft provide the same API for the software floating point types of the floats package, see Soft.
 //
 // This package does not guarantee bit-identical results across architectures.
 package math
@@ -68,7 +69,8 @@ func NextAfter[FLOAT Float](x, y FLOAT) FLOAT {
 //	Max(-0, -0)  = -0
 //
 // Note that this differs from the built-in function max and the standard library math.Max when called with NaN.
-// This change is to make Max compliant with the floating-point standard.
+// This change is to make Max compliant with the floating-point standard,
+// as Max is the IEEE 754-2019 maximumNumber operation.
 func Max[FLOAT Float](x, y FLOAT) FLOAT {
 	switch {
 	case IsNaN(x):
@@ -84,12 +86,13 @@ func Max[FLOAT Float](x, y FLOAT) FLOAT {
 //
 // Special cases are:
 //
+//	Min(x, NaN)  = Min(NaN, x)  = x
 //	Min(x, -Inf) = Min(-Inf, x) = -Inf
-//	Min(x, NaN)  = Min(NaN, x)  = NaN
 //	Min(-0, ±0)  = Min(±0, -0)  = -0
 //
 // Note that this differs from the built-in function min and the standard library math.Min when called with NaN.
-// This change is to make Min compliant with the floating-point standard.
+// This change is to make Min compliant with the floating-point standard,
+// as Min is the IEEE 754-2019 minimumNumber operation.
 func Min[FLOAT Float](x, y FLOAT) FLOAT {
 	switch {
 	case IsNaN(x):
@@ -101,6 +104,139 @@ func Min[FLOAT Float](x, y FLOAT) FLOAT {
 	return FLOAT(math.Min(cast2[float64](x, y)))
 }
 
+// Maximum returns the larger of x or y.
+//
+// Special cases are:
+//
+//	Maximum(x, NaN)  = Maximum(NaN, x)  = NaN
+//	Maximum(x, +Inf) = Maximum(+Inf, x) = +Inf, for any x other than NaN
+//	Maximum(+0, ±0)  = Maximum(±0, +0)  = +0
+//	Maximum(-0, -0)  = -0
+//
+// This is the IEEE 754-2019 maximum operation.
+// Note that this differs from the standard library math.Max, where math.Max(+Inf, NaN) = +Inf.
+func Maximum[FLOAT Float](x, y FLOAT) FLOAT {
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	}
+
+	return FLOAT(math.Max(cast2[float64](x, y)))
+}
+
+// Minimum returns the smaller of x or y.
+//
+// Special cases are:
+//
+//	Minimum(x, NaN)  = Minimum(NaN, x)  = NaN
+//	Minimum(x, -Inf) = Minimum(-Inf, x) = -Inf, for any x other than NaN
+//	Minimum(-0, ±0)  = Minimum(±0, -0)  = -0
+//
+// This is the IEEE 754-2019 minimum operation.
+// Note that this differs from the standard library math.Min, where math.Min(-Inf, NaN) = -Inf.
+func Minimum[FLOAT Float](x, y FLOAT) FLOAT {
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	}
+
+	return FLOAT(math.Min(cast2[float64](x, y)))
+}
+
+// MaxMag returns whichever of x or y has the larger magnitude.
+// If the magnitudes are equal, it returns [Max](x, y).
+//
+// Special cases are:
+//
+//	MaxMag(x, NaN)     = MaxMag(NaN, x) = x
+//	MaxMag(x, ±Inf)    = MaxMag(±Inf, x) = ±Inf
+//	MaxMag(±Inf, ∓Inf) = +Inf
+//	MaxMag(±0, ∓0)     = +0
+//
+// This is the IEEE 754-2019 maximumMagnitudeNumber operation.
+func MaxMag[FLOAT Float](x, y FLOAT) FLOAT {
+	switch {
+	case IsNaN(x):
+		return y
+	case IsNaN(y):
+		return x
+	}
+
+	return MaximumMag(x, y)
+}
+
+// MinMag returns whichever of x or y has the smaller magnitude.
+// If the magnitudes are equal, it returns [Min](x, y).
+//
+// Special cases are:
+//
+//	MinMag(x, NaN)     = MinMag(NaN, x) = x
+//	MinMag(±Inf, ∓Inf) = -Inf
+//	MinMag(±0, ∓0)     = -0
+//
+// This is the IEEE 754-2019 minimumMagnitudeNumber operation.
+func MinMag[FLOAT Float](x, y FLOAT) FLOAT {
+	switch {
+	case IsNaN(x):
+		return y
+	case IsNaN(y):
+		return x
+	}
+
+	return MinimumMag(x, y)
+}
+
+// MaximumMag returns whichever of x or y has the larger magnitude.
+// If the magnitudes are equal, it returns [Maximum](x, y).
+//
+// Special cases are:
+//
+//	MaximumMag(x, NaN)     = MaximumMag(NaN, x) = NaN
+//	MaximumMag(x, ±Inf)    = MaximumMag(±Inf, x) = ±Inf
+//	MaximumMag(±Inf, ∓Inf) = +Inf
+//	MaximumMag(±0, ∓0)     = +0
+//
+// This is the IEEE 754-2019 maximumMagnitude operation.
+func MaximumMag[FLOAT Float](x, y FLOAT) FLOAT {
+	ax, ay := Abs(x), Abs(y)
+
+	switch {
+	case ax > ay:
+		return x
+	case ax < ay:
+		return y
+	}
+
+	return Maximum(x, y)
+}
+
+// MinimumMag returns whichever of x or y has the smaller magnitude.
+// If the magnitudes are equal, it returns [Minimum](x, y).
+//
+// Special cases are:
+//
+//	MinimumMag(x, NaN)     = MinimumMag(NaN, x) = NaN
+//	MinimumMag(±Inf, ∓Inf) = -Inf
+//	MinimumMag(±0, ∓0)     = -0
+//
+// This is the IEEE 754-2019 minimumMagnitude operation.
+func MinimumMag[FLOAT Float](x, y FLOAT) FLOAT {
+	ax, ay := Abs(x), Abs(y)
+
+	switch {
+	case ax < ay:
+		return x
+	case ax > ay:
+		return y
+	}
+
+	return Minimum(x, y)
+}
+
 // Abs returns the absolute value of x.
 //
 // Special cases are:
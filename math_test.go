@@ -0,0 +1,76 @@
+package math
+
+import (
+	"math"
+	"testing"
+)
+
+// same reports whether x and y are both NaN, or are the same value, with the same sign if zero.
+func same[FLOAT Float](x, y FLOAT) bool {
+	if IsNaN(x) || IsNaN(y) {
+		return IsNaN(x) && IsNaN(y)
+	}
+
+	return x == y && SignBit(x) == SignBit(y)
+}
+
+func testMinMax[FLOAT Float](t *testing.T) {
+	t.Helper()
+
+	nan, inf, negZero := NaN[FLOAT](), Inf[FLOAT](1), CopySign(0, FLOAT(-1))
+
+	tests := []struct {
+		name string
+		fn   func(x, y FLOAT) FLOAT
+		x, y FLOAT
+
+		expect FLOAT
+	}{
+		{"Max", Max[FLOAT], 1, nan, 1},
+		{"Max", Max[FLOAT], nan, -1, -1},
+		{"Max", Max[FLOAT], -1, -2, -1},
+		{"Max", Max[FLOAT], negZero, 0, 0},
+		{"Min", Min[FLOAT], nan, 1, 1},
+		{"Min", Min[FLOAT], -1, -2, -2},
+		{"Min", Min[FLOAT], 0, negZero, negZero},
+
+		{"Maximum", Maximum[FLOAT], inf, nan, nan},
+		{"Maximum", Maximum[FLOAT], nan, inf, nan},
+		{"Maximum", Maximum[FLOAT], 1, nan, nan},
+		{"Maximum", Maximum[FLOAT], 1, inf, inf},
+		{"Maximum", Maximum[FLOAT], -1, -2, -1},
+		{"Maximum", Maximum[FLOAT], negZero, 0, 0},
+		{"Maximum", Maximum[FLOAT], negZero, negZero, negZero},
+		{"Minimum", Minimum[FLOAT], -inf, nan, nan},
+		{"Minimum", Minimum[FLOAT], nan, -inf, nan},
+		{"Minimum", Minimum[FLOAT], 1, -inf, -inf},
+		{"Minimum", Minimum[FLOAT], -1, -2, -2},
+		{"Minimum", Minimum[FLOAT], 0, negZero, negZero},
+
+		{"MaxMag", MaxMag[FLOAT], -2, 1, -2},
+		{"MaxMag", MaxMag[FLOAT], nan, -2, -2},
+		{"MaxMag", MaxMag[FLOAT], -inf, inf, inf},
+		{"MinMag", MinMag[FLOAT], -2, 1, 1},
+		{"MinMag", MinMag[FLOAT], 0, negZero, negZero},
+		{"MaximumMag", MaximumMag[FLOAT], -2, nan, nan},
+		{"MaximumMag", MaximumMag[FLOAT], -2, 1, -2},
+		{"MinimumMag", MinimumMag[FLOAT], inf, nan, nan},
+		{"MinimumMag", MinimumMag[FLOAT], -inf, inf, -inf},
+	}
+
+	for _, tt := range tests {
+		if got := tt.fn(tt.x, tt.y); !same(got, tt.expect) {
+			t.Errorf("%T: %s(%v, %v) = %v, expected %v", tt.x, tt.name, tt.x, tt.y, got, tt.expect)
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	testMinMax[float32](t)
+	testMinMax[float64](t)
+
+	// The IEEE 754-2019 maximum and minimum propagate NaN, where the standard library does not.
+	if got := Maximum(math.Inf(1), math.NaN()); !math.IsNaN(got) {
+		t.Errorf("Maximum(+Inf, NaN) = %v, expected NaN", got)
+	}
+}
@@ -0,0 +1,603 @@
+package math
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/puellanivis/math/floats"
+)
+
+// QuadDouble is an unevaluated sum of four float64 values, x0 + x1 + x2 + x3,
+// where each part is no more than half an ulp of the part before it.
+// It provides about 212 bits of precision, with the same exponent range as float64.
+//
+// The zero value is 0.
+type QuadDouble struct {
+	x [4]float64
+}
+
+// Constants for QuadDouble.
+var (
+	QuadDoublePi  = QuadDouble{[4]float64(piParts[:4])}
+	QuadDoubleLn2 = QuadDouble{[4]float64(ln2Parts[:4])}
+)
+
+// qdEps is the relative precision of a QuadDouble, beyond which series terms are negligible.
+const qdEps = 0x1p-212
+
+// QuadDoubleFromFloat64 returns x as a QuadDouble.
+func QuadDoubleFromFloat64(x float64) QuadDouble {
+	return QuadDouble{[4]float64{x}}
+}
+
+// QuadDoubleFromFloat128 returns x as a QuadDouble.
+// Every finite Float128 within the exponent range of float64 is represented exactly.
+func QuadDoubleFromFloat128(x floats.Float128) QuadDouble {
+	var z QuadDouble
+	splitFloat128(z.x[:], x)
+
+	return z
+}
+
+// ParseQuadDouble parses s as a decimal or hexadecimal floating-point number,
+// and returns the nearest QuadDouble.
+// It accepts the same syntax as big.ParseFloat, as well as "NaN".
+func ParseQuadDouble(s string) (QuadDouble, error) {
+	var z QuadDouble
+	if err := parseParts(z.x[:], s); err != nil {
+		return QuadDouble{}, err
+	}
+
+	return z, nil
+}
+
+// Parts returns the four parts of x, from most to least significant.
+func (x QuadDouble) Parts() [4]float64 {
+	return x.x
+}
+
+// Float64 returns x rounded to the nearest float64.
+func (x QuadDouble) Float64() float64 {
+	return x.x[0]
+}
+
+// Float128 returns x rounded to the nearest floats.Float128.
+func (x QuadDouble) Float128() floats.Float128 {
+	if !isFinite(x.x[0]) {
+		return floats.Float128FromFloat(x.x[0])
+	}
+
+	return floats.Float128FromFloat(x.BigFloat())
+}
+
+// DoubleDouble returns x rounded to a DoubleDouble.
+func (x QuadDouble) DoubleDouble() DoubleDouble {
+	return ddRenorm(x.x[0], x.x[1]+(x.x[2]+x.x[3]))
+}
+
+// BigFloat returns x as a *big.Float, with enough precision to hold x exactly.
+func (x QuadDouble) BigFloat() *big.Float {
+	return sumParts(x.x[:]...)
+}
+
+// String returns x formatted as the shortest decimal that rounds to x at 212 bits of precision.
+// Parts far below the precision of the leading part are rounded away, so the result need not parse back into x.
+func (x QuadDouble) String() string {
+	return fmt.Sprint(x)
+}
+
+// Format implements fmt.Formatter, accepting the same verbs as *big.Float.
+func (x QuadDouble) Format(f fmt.State, verb rune) {
+	formatParts(f, verb, 212, x.x[:]...)
+}
+
+// IsNaN reports whether x is a “not-a-number” value.
+func (x QuadDouble) IsNaN() bool {
+	return IsNaN(x.x[0])
+}
+
+// IsInf reports whether x is an infinity, according to sign.
+// If sign > 0, IsInf reports whether x is positive infinity.
+// If sign < 0, IsInf reports whether x is negative infinity.
+// If sign == 0, IsInf reports whether x is either infinity.
+func (x QuadDouble) IsInf(sign int) bool {
+	return IsInf(x.x[0], sign)
+}
+
+// Sign returns -1 if x < 0, 0 if x is ±0 or NaN, and +1 if x > 0.
+func (x QuadDouble) Sign() int {
+	switch {
+	case x.x[0] < 0:
+		return -1
+	case x.x[0] > 0:
+		return +1
+	}
+
+	return 0
+}
+
+// Cmp compares x and y and returns:
+//
+//	-1 if x < y
+//	 0 if x == y (incl. -0 == 0)
+//	+1 if x > y
+//
+// The result is 0, if either x or y is NaN.
+func (x QuadDouble) Cmp(y QuadDouble) int {
+	return cmpParts(x.x[0], y.x[0], x.x[1], y.x[1], x.x[2], y.x[2], x.x[3], y.x[3])
+}
+
+// Neg returns -x.
+func (x QuadDouble) Neg() QuadDouble {
+	return QuadDouble{[4]float64{-x.x[0], -x.x[1], -x.x[2], -x.x[3]}}
+}
+
+// Abs returns the absolute value of x.
+func (x QuadDouble) Abs() QuadDouble {
+	if SignBit(x.x[0]) {
+		return x.Neg()
+	}
+
+	return x
+}
+
+// Add returns the sum of x+y.
+//
+// Special cases are the same as for float64 addition.
+func (x QuadDouble) Add(y QuadDouble) QuadDouble {
+	if s := x.x[0] + y.x[0]; !isFinite(s) {
+		return QuadDoubleFromFloat64(s)
+	}
+
+	// Merge the parts of x and y in order of decreasing magnitude,
+	// accumulating them into non-overlapping parts of the sum.
+	var i, j int
+	next := func() float64 {
+		switch {
+		case i >= 4:
+			j++
+			return y.x[j-1]
+		case j >= 4:
+			i++
+			return x.x[i-1]
+		case Abs(x.x[i]) > Abs(y.x[j]):
+			i++
+			return x.x[i-1]
+		}
+
+		j++
+		return y.x[j-1]
+	}
+
+	var z [4]float64
+
+	u, v := quickTwoSum(next(), next())
+
+	for k := 0; k < 4; {
+		if i >= 4 && j >= 4 {
+			z[k] = u
+			if k < 3 {
+				z[k+1] = v
+			}
+			break
+		}
+
+		var s float64
+		s, u, v = quickThreeAccum(u, v, next())
+
+		if s != 0 {
+			z[k] = s
+			k++
+		}
+	}
+
+	for ; i < 4; i++ {
+		z[3] += x.x[i]
+	}
+	for ; j < 4; j++ {
+		z[3] += y.x[j]
+	}
+
+	return qdRenorm(z[0], z[1], z[2], z[3], 0)
+}
+
+// Sub returns the difference of x-y.
+//
+// Special cases are the same as for float64 subtraction.
+func (x QuadDouble) Sub(y QuadDouble) QuadDouble {
+	return x.Add(y.Neg())
+}
+
+// Mul returns the product of x*y.
+//
+// Special cases are the same as for float64 multiplication.
+func (x QuadDouble) Mul(y QuadDouble) QuadDouble {
+	a, b := &x.x, &y.x
+
+	p0, q0 := twoProd(a[0], b[0])
+	if !isFinite(p0) || p0 == 0 {
+		return QuadDoubleFromFloat64(p0)
+	}
+
+	p1, q1 := twoProd(a[0], b[1])
+	p2, q2 := twoProd(a[1], b[0])
+	p3, q3 := twoProd(a[0], b[2])
+	p4, q4 := twoProd(a[1], b[1])
+	p5, q5 := twoProd(a[2], b[0])
+
+	// O(ε) terms
+	p1, p2, q0 = threeSum(p1, p2, q0)
+
+	// O(ε²) terms
+	p2, q1, q2 = threeSum(p2, q1, q2)
+	p3, p4, p5 = threeSum(p3, p4, p5)
+
+	s0, t0 := twoSum(p2, p3)
+	s1, t1 := twoSum(q1, p4)
+	s2 := q2 + p5
+	s1, t0 = twoSum(s1, t0)
+	s2 += t0 + t1
+
+	// O(ε³) terms
+	s1 += a[0]*b[3] + a[1]*b[2] + a[2]*b[1] + a[3]*b[0] + q0 + q3 + q4 + q5
+
+	return qdRenorm(p0, p1, s0, s1, s2)
+}
+
+// mulFloat64 returns the product of x*y, where y is a float64.
+func (x QuadDouble) mulFloat64(y float64) QuadDouble {
+	a := &x.x
+
+	p0, q0 := twoProd(a[0], y)
+	if !isFinite(p0) || p0 == 0 {
+		return QuadDoubleFromFloat64(p0)
+	}
+
+	p1, q1 := twoProd(a[1], y)
+	p2, q2 := twoProd(a[2], y)
+	p3 := a[3] * y
+
+	s1, s2 := twoSum(q0, p1)
+	s2, q1, p2 = threeSum(s2, q1, p2)
+	q1, q2 = threeSum2(q1, q2, p3)
+
+	return qdRenorm(p0, s1, s2, q1, q2+p2)
+}
+
+// Div returns the quotient of x/y.
+//
+// Special cases are the same as for float64 division.
+func (x QuadDouble) Div(y QuadDouble) QuadDouble {
+	q0 := x.x[0] / y.x[0]
+	if !isFinite(q0) || q0 == 0 || IsInf(y.x[0], 0) {
+		return QuadDoubleFromFloat64(q0)
+	}
+
+	r := x.Sub(y.mulFloat64(q0))
+	q1 := r.x[0] / y.x[0]
+
+	r = r.Sub(y.mulFloat64(q1))
+	q2 := r.x[0] / y.x[0]
+
+	r = r.Sub(y.mulFloat64(q2))
+	q3 := r.x[0] / y.x[0]
+
+	r = r.Sub(y.mulFloat64(q3))
+	q4 := r.x[0] / y.x[0]
+
+	return qdRenorm(q0, q1, q2, q3, q4)
+}
+
+// Sqrt returns the square root of x.
+//
+// Special cases are:
+//
+//	+Inf.Sqrt() = +Inf
+//	±0.Sqrt() = ±0
+//	x.Sqrt() = NaN, if x < 0
+//	NaN.Sqrt() = NaN
+func (x QuadDouble) Sqrt() QuadDouble {
+	switch {
+	case x.x[0] == 0, IsInf(x.x[0], 1), IsNaN(x.x[0]):
+		return x
+	case x.x[0] < 0:
+		return QuadDoubleFromFloat64(NaN64())
+	}
+
+	// Scale x by an even power of two near 1, so that r² can neither overflow nor underflow.
+	k := math.Ilogb(x.x[0]) / 2
+	x = x.ldexp(-2 * k)
+
+	// Three Newton steps on the float64 reciprocal square root, r = r + (½ - ½x×r²)×r.
+	h := x.mulFloat64(0.5)
+	half := QuadDoubleFromFloat64(0.5)
+
+	r := QuadDoubleFromFloat64(1 / Sqrt(x.x[0]))
+	for i := 0; i < 3; i++ {
+		r = r.Add(half.Sub(h.Mul(r.Mul(r))).Mul(r))
+	}
+
+	return r.Mul(x).ldexp(k)
+}
+
+// Exp returns e**x, the base-e exponential of x.
+//
+// Special cases are:
+//
+//	+Inf.Exp() = +Inf
+//	-Inf.Exp() = 0
+//	NaN.Exp() = NaN
+//
+// Very large values overflow to +Inf, and very small values underflow to 0.
+func (x QuadDouble) Exp() QuadDouble {
+	switch {
+	case IsNaN(x.x[0]), IsInf(x.x[0], 1):
+		return x
+	case x.x[0] > expOverflow:
+		return QuadDoubleFromFloat64(Inf64(1))
+	case x.x[0] < expUnderflow:
+		return QuadDouble{}
+	case x.x[0] == 0:
+		return QuadDoubleFromFloat64(1)
+	}
+
+	// Reduce x = k×ln2 + r, with |r| ≤ ½ln2, then r by a further 2**expScale.
+	k := RoundToEven(x.x[0] / Ln2)
+
+	r := x
+	for _, p := range ln2Parts {
+		r = r.Sub(QuadDoubleFromFloat64(k).mulFloat64(p))
+	}
+	r = r.ldexp(-expScale)
+
+	// Taylor series of e**r - 1.
+	s, t := r, r
+	for i := 2; ; i++ {
+		t = t.Mul(r).Div(QuadDoubleFromFloat64(float64(i)))
+		s = s.Add(t)
+
+		if Abs(t.x[0]) <= Abs(s.x[0])*qdEps {
+			break
+		}
+	}
+
+	// e**2r - 1 = 2(e**r - 1) + (e**r - 1)²
+	for i := 0; i < expScale; i++ {
+		s = s.mulFloat64(2).Add(s.Mul(s))
+	}
+
+	return s.Add(QuadDoubleFromFloat64(1)).ldexp(int(k))
+}
+
+// Log returns the natural logarithm of x.
+//
+// Special cases are:
+//
+//	+Inf.Log() = +Inf
+//	0.Log() = -Inf
+//	x.Log() = NaN, if x < 0
+//	NaN.Log() = NaN
+func (x QuadDouble) Log() QuadDouble {
+	switch {
+	case IsNaN(x.x[0]), IsInf(x.x[0], 1):
+		return x
+	case x.x[0] == 0:
+		return QuadDoubleFromFloat64(Inf64(-1))
+	case x.x[0] < 0:
+		return QuadDoubleFromFloat64(NaN64())
+	case x == QuadDoubleFromFloat64(1):
+		return QuadDouble{}
+	}
+
+	// Reduce x = m×2**k, with √½ ≤ m < √2, so that log(m) is small.
+	k := math.Ilogb(x.x[0])
+	m := x.ldexp(-k)
+	if m.x[0] > Sqrt2 {
+		m = m.ldexp(-1)
+		k++
+	}
+
+	one := QuadDoubleFromFloat64(1)
+
+	var y QuadDouble
+	if Abs(m.x[0]-1) < logNearOne {
+		// Near 1, the Newton step would cancel catastrophically, so use log(m) = 2atanh(z),
+		// where z = (m-1)/(m+1), and atanh(z) = z + z³/3 + z⁵/5 + …
+		z := m.Sub(one).Div(m.Add(one))
+		zz := z.Mul(z)
+
+		s, t := z, z
+		for i := 3; ; i += 2 {
+			t = t.Mul(zz)
+			u := t.Div(QuadDoubleFromFloat64(float64(i)))
+			s = s.Add(u)
+
+			if Abs(u.x[0]) <= Abs(s.x[0])*qdEps {
+				break
+			}
+		}
+
+		y = s.mulFloat64(2)
+	} else {
+		// Each Newton step, y = y + m×e**-y - 1, doubles the precision, starting from the float64 logarithm.
+		y = QuadDoubleFromFloat64(Log(m.x[0]))
+		for i := 0; i < 2; i++ {
+			y = y.Add(m.Mul(y.Neg().Exp())).Sub(one)
+		}
+	}
+
+	// log(x) = k×ln2 + log(m)
+	var kln2 QuadDouble
+	for _, p := range ln2Parts[:5] {
+		kln2 = kln2.Add(QuadDoubleFromFloat64(float64(k)).mulFloat64(p))
+	}
+
+	return kln2.Add(y)
+}
+
+// Sin returns the sine of the radian argument x.
+//
+// Special cases are:
+//
+//	±0.Sin() = ±0
+//	±Inf.Sin() = NaN
+//	NaN.Sin() = NaN
+//
+// The argument is reduced against as many bits of π as it needs, so large arguments keep their full precision.
+func (x QuadDouble) Sin() QuadDouble {
+	sin, _ := x.SinCos()
+	return sin
+}
+
+// Cos returns the cosine of the radian argument x.
+//
+// Special cases are:
+//
+//	±Inf.Cos() = NaN
+//	NaN.Cos() = NaN
+//
+// The argument is reduced against as many bits of π as it needs, so large arguments keep their full precision.
+func (x QuadDouble) Cos() QuadDouble {
+	_, cos := x.SinCos()
+	return cos
+}
+
+// SinCos returns x.Sin(), x.Cos().
+func (x QuadDouble) SinCos() (sin, cos QuadDouble) {
+	switch {
+	case x.x[0] == 0:
+		return x, QuadDoubleFromFloat64(1)
+	case !isFinite(x.x[0]):
+		nan := QuadDoubleFromFloat64(NaN64())
+		return nan, nan
+	}
+
+	// Reduce x = j×π/2 + r, with |r| ≤ π/4.
+	r, j := x, 0
+	if Abs(x.x[0]) > Pi/4 {
+		var rem *big.Float
+		rem, j = reduceHalfPi(x.x[:]...)
+
+		r = QuadDouble{}
+		splitBig(r.x[:], rem)
+	}
+
+	// Taylor series of sin(r) and cos(r).
+	rr := r.Mul(r)
+
+	sin, t := r, r
+	for i := 3; i < trigTerms; i += 2 {
+		t = t.Mul(rr).Div(QuadDoubleFromFloat64(float64(-(i - 1) * i)))
+		sin = sin.Add(t)
+
+		if Abs(t.x[0]) <= Abs(sin.x[0])*qdEps {
+			break
+		}
+	}
+
+	one := QuadDoubleFromFloat64(1)
+
+	cos, t = one, one
+	for i := 2; i < trigTerms; i += 2 {
+		t = t.Mul(rr).Div(QuadDoubleFromFloat64(float64(-(i - 1) * i)))
+		cos = cos.Add(t)
+
+		if Abs(t.x[0]) <= qdEps {
+			break
+		}
+	}
+
+	switch j {
+	case 1:
+		return cos, sin.Neg()
+	case 2:
+		return sin.Neg(), cos.Neg()
+	case 3:
+		return cos.Neg(), sin
+	}
+
+	return sin, cos
+}
+
+// ldexp returns x×2**k, scaling each part.
+func (x QuadDouble) ldexp(k int) QuadDouble {
+	for i := range x.x {
+		x.x[i] = math.Ldexp(x.x[i], k)
+	}
+
+	return x
+}
+
+// threeSum returns the sum of x+y+z, as three non-overlapping parts.
+func threeSum(x, y, z float64) (float64, float64, float64) {
+	t1, t2 := twoSum(x, y)
+	x, t3 := twoSum(z, t1)
+	y, z = twoSum(t2, t3)
+
+	return x, y, z
+}
+
+// threeSum2 returns the sum of x+y+z, as two parts, dropping the least-significant.
+func threeSum2(x, y, z float64) (float64, float64) {
+	t1, t2 := twoSum(x, y)
+	x, t3 := twoSum(z, t1)
+
+	return x, t2 + t3
+}
+
+// quickThreeAccum adds z into the accumulator x + y.
+// If the accumulator then needs more than two parts, its most-significant part is returned as s;
+// otherwise, s is zero.
+func quickThreeAccum(x, y, z float64) (s, xʹ, yʹ float64) {
+	s, y = twoSum(y, z)
+	s, x = twoSum(x, s)
+
+	switch {
+	case x != 0 && y != 0:
+		return s, x, y
+	case y == 0:
+		return 0, s, x
+	}
+
+	return 0, s, y
+}
+
+// qdRenorm returns the sum of the five overlapping parts c0…c4 as a QuadDouble.
+func qdRenorm(c0, c1, c2, c3, c4 float64) QuadDouble {
+	if !isFinite(c0) {
+		return QuadDoubleFromFloat64(c0)
+	}
+
+	// Compress from the bottom up.
+	s0, c4 := quickTwoSum(c3, c4)
+	s0, c3 = quickTwoSum(c2, s0)
+	s0, c2 = quickTwoSum(c1, s0)
+	c0, c1 = quickTwoSum(c0, s0)
+
+	// Then distribute from the top down, skipping over any zero parts.
+	var s [4]float64
+	s[0] = c0
+
+	k := 0
+	for _, c := range []float64{c1, c2, c3, c4} {
+		if k == 3 {
+			s[3] += c
+			continue
+		}
+
+		var e float64
+		s[k], e = quickTwoSum(s[k], c)
+
+		if e != 0 {
+			k++
+			s[k] = e
+		}
+	}
+
+	if !isFinite(s[0]) {
+		return QuadDoubleFromFloat64(s[0])
+	}
+
+	return QuadDouble{s}
+}
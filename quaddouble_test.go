@@ -0,0 +1,104 @@
+package math
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestQuadDoubleArith(t *testing.T) {
+	x := QuadDouble{[4]float64{1, 0x1p-60, 0x1p-120, -0x1p-180}}
+	y := QuadDouble{[4]float64{3, -0x1p-55, 0x1p-110, 0x1p-170}}
+
+	tests := []struct {
+		name   string
+		got    QuadDouble
+		expect *big.Float
+	}{
+		{"Add", x.Add(y), new(big.Float).SetPrec(bigPrec).Add(x.BigFloat(), y.BigFloat())},
+		{"Sub", x.Sub(y), new(big.Float).SetPrec(bigPrec).Sub(x.BigFloat(), y.BigFloat())},
+		{"Mul", x.Mul(y), new(big.Float).SetPrec(bigPrec).Mul(x.BigFloat(), y.BigFloat())},
+		{"Div", x.Div(y), new(big.Float).SetPrec(bigPrec).Quo(x.BigFloat(), y.BigFloat())},
+		{"Sqrt", y.Sqrt(), new(big.Float).SetPrec(bigPrec).Sqrt(y.BigFloat())},
+	}
+
+	for _, tt := range tests {
+		if err := relErr(tt.got.BigFloat(), tt.expect); err > 0x1p-200 {
+			t.Errorf("%s = %v, relative error %g", tt.name, tt.got, err)
+		}
+	}
+}
+
+func TestQuadDoubleSinCos(t *testing.T) {
+	one := QuadDoubleFromFloat64(1)
+
+	for _, x := range []float64{1e-300, 0.5, 1, Pi / 4, 3, 100, 1e6, 1e15, 0x1p52 - 1, 0x1p52, 1e22, -1e300, MaxFloat64} {
+		sin, cos := QuadDoubleFromFloat64(x).SinCos()
+
+		// sin² + cos² = 1
+		sum := sin.Mul(sin).Add(cos.Mul(cos))
+		if err := math.Abs(sum.Sub(one).Float64()); err > 0x1p-200 {
+			t.Errorf("SinCos(%g): sin² + cos² = %v", x, sum)
+		}
+
+		if got, expect := sin.Float64(), math.Sin(x); math.Abs(got-expect) > 0x1p-50 {
+			t.Errorf("Sin(%g) = %v, expected about %v", x, got, expect)
+		}
+
+		if got, expect := cos.Float64(), math.Cos(x); math.Abs(got-expect) > 0x1p-50 {
+			t.Errorf("Cos(%g) = %v, expected about %v", x, got, expect)
+		}
+	}
+
+	// sin(π) is the error of the QuadDouble approximation to π.
+	if got := math.Abs(QuadDoublePi.Sin().Float64()); got > 0x1p-210 {
+		t.Errorf("Sin(π) = %v, expected about 0", got)
+	}
+
+	// sin(10²²), from a reduction against a long π.
+	expect, _, _ := big.ParseFloat("-0.85220084976718880177270589375302936826176215041004365625650932602591", 10, 256, big.ToNearestEven)
+	if got := QuadDoubleFromFloat64(1e22).Sin(); relErr(got.BigFloat(), expect) > 0x1p-200 {
+		t.Errorf("Sin(1e22) = %v, expected %v", got, expect)
+	}
+
+	// sin(2x) = 2 sin(x) cos(x) reduces 2x and x by different multiples of π/2.
+	for _, x := range []float64{5e5, 1e22, 0x1p1000} {
+		sin, cos := QuadDoubleFromFloat64(x).SinCos()
+		sin2 := QuadDoubleFromFloat64(2 * x).Sin()
+
+		if err := math.Abs(sin2.Sub(sin.Mul(cos).mulFloat64(2)).Float64()); err > 0x1p-200 {
+			t.Errorf("Sin(2×%g) = %v, but 2 sin cos differs by %g", x, sin2, err)
+		}
+	}
+
+	for _, x := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		sin, cos := QuadDoubleFromFloat64(x).SinCos()
+		if !sin.IsNaN() || !cos.IsNaN() {
+			t.Errorf("SinCos(%g) = %v, %v, expected NaN, NaN", x, sin, cos)
+		}
+	}
+}
+
+func TestQuadDoubleString(t *testing.T) {
+	tests := []QuadDouble{
+		{[4]float64{1}},
+		{[4]float64{0.1}},
+		{[4]float64{1, 0x1p-60, 0x1p-120, 0x1p-180}},
+		{[4]float64{1, 0x1p-60, 0x1p-300, 0x1p-400}},
+		{[4]float64{-3, 0x1p-1000}},
+	}
+
+	// The result parses back to a value that rounds to the same number of bits as x.
+	for _, x := range tests {
+		s := x.String()
+
+		got, err := ParseQuadDouble(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g, e := got.BigFloat().SetPrec(212), x.BigFloat().SetPrec(212); g.Cmp(e) != 0 {
+			t.Errorf("ParseQuadDouble(%q) = %v, expected %v", s, g, e)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+package math
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/puellanivis/math/floats"
+)
+
+// Soft is a constraint that permits any of the software floating point types of the floats package:
+// Float16, BFloat16, Float32, Float64, and Float128, with any rounding mode.
+//
+// Each function of this package that is prefixed with Soft is the counterpart of the function without the prefix,
+// with the same special cases, for these types: SoftSqrt is Sqrt, SoftFMA is FMA, and so on.
+// Results are rounded per the rounding mode of the type.
+//
+// An algorithm written once against Soft covers the builtin types too, through floats.Float32 and floats.Float64,
+// which use native arithmetic under the default rounding mode.
+//
+// Some combinations are not supported, and fail to compile, rather than silently losing precision:
+//
+//   - Arguments of different types, or of the same format with different rounding modes.
+//     Convert them first, with methods such as Float64 and Float128.
+//   - Bits and FromBits, as the width of the bits depends on the type. Use the Bits methods of the types instead.
+//   - Log, Ln, Log2, Lb, Log10, ErfInv, ErfcInv, Jn, Yn, and the trigonometric functions other than Hypot,
+//     which the floats types do not yet implement.
+type Soft[T any] interface {
+	floats.Floating[T]
+}
+
+// SoftSignBit is [SignBit] for the floats types.
+func SoftSignBit[T Soft[T]](x T) bool {
+	return x.SignBit()
+}
+
+// SoftCopySign is [CopySign] for the floats types.
+func SoftCopySign[T Soft[T]](f, sign T) T {
+	return f.CopySign(sign)
+}
+
+// SoftInf is [Inf] for the floats types.
+func SoftInf[T Soft[T]](sign int) T {
+	return floats.FromFloat[T](math.Inf(sign))
+}
+
+// SoftIsInf is [IsInf] for the floats types.
+func SoftIsInf[T Soft[T]](f T, sign int) bool {
+	return f.IsInf(sign)
+}
+
+// SoftNaN is [NaN] for the floats types.
+func SoftNaN[T Soft[T]]() T {
+	return floats.FromFloat[T](math.NaN())
+}
+
+// SoftIsNaN is [IsNaN] for the floats types.
+func SoftIsNaN[T Soft[T]](f T) bool {
+	return f.IsNaN()
+}
+
+// SoftClass is [Class] for the floats types.
+func SoftClass[T Soft[T]](f T) floats.Class {
+	return f.Class()
+}
+
+// SoftIsSignaling is [IsSignaling] for the floats types.
+func SoftIsSignaling[T Soft[T]](f T) bool {
+	return f.IsSignaling()
+}
+
+// SoftIsZero is [IsZero] for the floats types.
+func SoftIsZero[T Soft[T]](f T) bool {
+	return f.IsZero()
+}
+
+// SoftIsFinite is [IsFinite] for the floats types.
+func SoftIsFinite[T Soft[T]](f T) bool {
+	return f.IsFinite()
+}
+
+// SoftIsNormal is [IsNormal] for the floats types.
+func SoftIsNormal[T Soft[T]](f T) bool {
+	return f.IsNormal()
+}
+
+// SoftIsSubnormal is [IsSubnormal] for the floats types.
+func SoftIsSubnormal[T Soft[T]](f T) bool {
+	return f.IsSubnormal()
+}
+
+// SoftFrExp is [FrExp] for the floats types.
+func SoftFrExp[T Soft[T]](f T) (frac T, exp int) {
+	return f.FrExp()
+}
+
+// SoftLdExp is [LdExp] for the floats types.
+func SoftLdExp[T Soft[T]](frac T, exp int) T {
+	return frac.LdExp(exp)
+}
+
+// SoftNextUp is [NextUp] for the floats types.
+func SoftNextUp[T Soft[T]](x T) T {
+	return x.NextUp()
+}
+
+// SoftNextDown is [NextDown] for the floats types.
+func SoftNextDown[T Soft[T]](x T) T {
+	return x.NextDown()
+}
+
+// SoftNextAfter is [NextAfter] for the floats types.
+func SoftNextAfter[T Soft[T]](x, y T) T {
+	// The method gives ±0.NextAfter(∓0) = ∓0, where NextAfter(x, x) = x covers both zeros.
+	if x.Equal(y) {
+		return x
+	}
+
+	return x.NextAfter(y)
+}
+
+// SoftMax is [Max] for the floats types.
+func SoftMax[T Soft[T]](x, y T) T {
+	return x.Max(y)
+}
+
+// SoftMin is [Min] for the floats types.
+func SoftMin[T Soft[T]](x, y T) T {
+	return x.Min(y)
+}
+
+// SoftMaximum is [Maximum] for the floats types.
+func SoftMaximum[T Soft[T]](x, y T) T {
+	return x.Maximum(y)
+}
+
+// SoftMinimum is [Minimum] for the floats types.
+func SoftMinimum[T Soft[T]](x, y T) T {
+	return x.Minimum(y)
+}
+
+// SoftMaxMag is [MaxMag] for the floats types.
+func SoftMaxMag[T Soft[T]](x, y T) T {
+	return x.MaxMag(y)
+}
+
+// SoftMinMag is [MinMag] for the floats types.
+func SoftMinMag[T Soft[T]](x, y T) T {
+	return x.MinMag(y)
+}
+
+// SoftMaximumMag is [MaximumMag] for the floats types.
+func SoftMaximumMag[T Soft[T]](x, y T) T {
+	return x.MaximumMag(y)
+}
+
+// SoftMinimumMag is [MinimumMag] for the floats types.
+func SoftMinimumMag[T Soft[T]](x, y T) T {
+	return x.MinimumMag(y)
+}
+
+// SoftAbs is [Abs] for the floats types.
+func SoftAbs[T Soft[T]](x T) T {
+	return x.Abs()
+}
+
+// SoftSgn is [Sgn] for the floats types.
+func SoftSgn[T Soft[T]](x T) T {
+	switch {
+	case x.IsNaN():
+		return x
+	case x.IsZero():
+		var zero T
+		return zero
+	case x.SignBit():
+		return floats.FromFloat[T](-1)
+	default:
+		return floats.FromFloat[T](1)
+	}
+}
+
+// SoftDim is [Dim] for the floats types.
+func SoftDim[T Soft[T]](x, y T) T {
+	return x.Dim(y)
+}
+
+// SoftFMA is [FMA] for the floats types.
+func SoftFMA[T Soft[T]](x, y, z T) T {
+	return x.FMA(y, z)
+}
+
+// SoftMod is [Mod] for the floats types.
+func SoftMod[T Soft[T]](x, y T) T {
+	return x.Mod(y)
+}
+
+// SoftRemainder is [Remainder] for the floats types.
+func SoftRemainder[T Soft[T]](x, y T) T {
+	return x.Remainder(y)
+}
+
+// SoftSqrt is [Sqrt] for the floats types.
+func SoftSqrt[T Soft[T]](x T) T {
+	return x.Sqrt()
+}
+
+// SoftCbrt is [Cbrt] for the floats types.
+func SoftCbrt[T Soft[T]](x T) T {
+	return x.Cbrt()
+}
+
+// SoftExp is [Exp] for the floats types.
+func SoftExp[T Soft[T]](x T) T {
+	return x.Exp()
+}
+
+// SoftExpM1 is [ExpM1] for the floats types.
+func SoftExpM1[T Soft[T]](x T) T {
+	return x.ExpM1()
+}
+
+// SoftExp2 is [Exp2] for the floats types.
+func SoftExp2[T Soft[T]](x T) T {
+	return x.Exp2()
+}
+
+// SoftPow is [Pow] for the floats types.
+func SoftPow[T Soft[T]](x, y T) T {
+	return x.Pow(y)
+}
+
+// SoftPow10 is [Pow10] for the floats types.
+func SoftPow10[T Soft[T]](n int) T {
+	return floats.FromFloat[T](10).Pown(n)
+}
+
+// SoftILogB is [ILogB] for the floats types.
+func SoftILogB[T Soft[T]](x T) int {
+	exp, _ := x.ILogB()
+	return exp
+}
+
+// SoftLogB is [LogB] for the floats types.
+func SoftLogB[T Soft[T]](x T) T {
+	return x.LogB()
+}
+
+// SoftLog1p is [Log1p] for the floats types.
+func SoftLog1p[T Soft[T]](x T) T {
+	return x.Log1p()
+}
+
+// SoftLn1p is an alias for SoftLog1p.
+func SoftLn1p[T Soft[T]](x T) T {
+	return x.Log1p()
+}
+
+// SoftFloor is [Floor] for the floats types.
+func SoftFloor[T Soft[T]](x T) T {
+	return x.Floor()
+}
+
+// SoftCeil is [Ceil] for the floats types.
+func SoftCeil[T Soft[T]](x T) T {
+	return x.Ceil()
+}
+
+// SoftTrunc is [Trunc] for the floats types.
+func SoftTrunc[T Soft[T]](x T) T {
+	return x.Trunc()
+}
+
+// SoftModf is [Modf] for the floats types.
+func SoftModf[T Soft[T]](x T) (int, frac T) {
+	return x.ModF()
+}
+
+// SoftRound is [Round] for the floats types.
+func SoftRound[T Soft[T]](x T) T {
+	return x.Round()
+}
+
+// SoftRoundToEven is [RoundToEven] for the floats types.
+func SoftRoundToEven[T Soft[T]](x T) T {
+	return x.RoundToEven()
+}
+
+// SoftErf is [Erf] for the floats types.
+func SoftErf[T Soft[T]](x T) T {
+	return x.Erf()
+}
+
+// SoftErfc is [Erfc] for the floats types.
+func SoftErfc[T Soft[T]](x T) T {
+	return x.Erfc()
+}
+
+// SoftGamma is [Gamma] for the floats types.
+func SoftGamma[T Soft[T]](x T) T {
+	return x.Gamma()
+}
+
+// SoftLnGamma is [LnGamma] for the floats types.
+func SoftLnGamma[T Soft[T]](x T) (lngamma T, sign int) {
+	return x.LnGamma()
+}
+
+// SoftLnΓ is an alias for SoftLnGamma.
+func SoftLnΓ[T Soft[T]](x T) (lnΓ T, sign int) {
+	return x.LnGamma()
+}
+
+// SoftJ0 is [J0] for the floats types.
+func SoftJ0[T Soft[T]](x T) T {
+	return x.J0()
+}
+
+// SoftJ1 is [J1] for the floats types.
+func SoftJ1[T Soft[T]](x T) T {
+	return x.J1()
+}
+
+// SoftY0 is [Y0] for the floats types.
+func SoftY0[T Soft[T]](x T) T {
+	return x.Y0()
+}
+
+// SoftY1 is [Y1] for the floats types.
+func SoftY1[T Soft[T]](x T) T {
+	return x.Y1()
+}
+
+// SoftHypot is [Hypot] for the floats types.
+func SoftHypot[T Soft[T]](p, q T) T {
+	return p.Hypot(q)
+}
+
+// SoftAugmentedAdd is [AugmentedAdd] for the floats types.
+func SoftAugmentedAdd[T Soft[T]](x, y T) (sum, err T) {
+	return x.AugmentedAdd(y)
+}
+
+// SoftAugmentedSub is [AugmentedSub] for the floats types.
+func SoftAugmentedSub[T Soft[T]](x, y T) (diff, err T) {
+	return x.AugmentedSub(y)
+}
+
+// SoftAugmentedMul is [AugmentedMul] for the floats types.
+func SoftAugmentedMul[T Soft[T]](x, y T) (prod, err T) {
+	return x.AugmentedMul(y)
+}
+
+// SoftSum is [Sum] for the floats types.
+func SoftSum[T Soft[T]](xs []T, alg SumAlgorithm) T {
+	return floats.Sum(xs, alg)
+}
+
+// SoftDot is [Dot] for the floats types.
+func SoftDot[T Soft[T]](xs, ys []T, alg SumAlgorithm) T {
+	return floats.Dot(xs, ys, alg)
+}
+
+// SoftNorm2 is [Norm2] for the floats types.
+//
+// Under SumExact, the sum of the squares is exact, but it is rounded once before its square root is taken.
+func SoftNorm2[T Soft[T]](xs []T, alg SumAlgorithm) T {
+	var max T
+
+	hasNaN := false
+	for _, x := range xs {
+		switch {
+		case x.IsInf(0):
+			return SoftInf[T](1)
+		case x.IsNaN():
+			hasNaN = true
+		case max.Less(x.Abs()):
+			max = x.Abs()
+		}
+	}
+
+	switch {
+	case hasNaN:
+		return SoftNaN[T]()
+	case max.IsZero():
+		return max
+	}
+
+	// Scale every term by the same power of two, as Norm2 does.
+	_, k := max.FrExp()
+	k += (bits.Len(uint(len(xs))) + 1) / 2
+
+	scaled := make([]T, len(xs))
+	for i, x := range xs {
+		scaled[i] = x.LdExp(-k)
+	}
+
+	return floats.Dot(scaled, scaled, alg).Sqrt().LdExp(k)
+}
@@ -0,0 +1,137 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/puellanivis/math/floats"
+)
+
+// testSoft checks that each SoftX agrees with X on special values, for the floats type S that matches FLOAT.
+func testSoft[FLOAT Float, S Soft[S]](t *testing.T, native func(S) FLOAT) {
+	t.Helper()
+
+	nan, inf := NaN[FLOAT](), Inf[FLOAT](1)
+	negZero := CopySign(0, FLOAT(-1))
+
+	// The smallest subnormal, and the largest finite value.
+	tiny, huge := NextUp(FLOAT(0)), NextDown(inf)
+
+	// The transcendental functions are compared only where their results are exact:
+	// on the special values, and for binary functions, wherever either argument is special.
+	specials := []FLOAT{0, negZero, inf, -inf, nan}
+	values := append(specials, 1, -1, 0.5, -0.5, 3, -2.5, 1<<40+0.5, tiny, -tiny, huge, -huge)
+
+	soft := func(x FLOAT) S {
+		return floats.FromFloat[S](float64(x))
+	}
+
+	unary := []struct {
+		name   string
+		expect func(FLOAT) FLOAT
+		got    func(S) S
+		exact  bool
+	}{
+		{"Abs", Abs[FLOAT], SoftAbs[S], true},
+		{"Sgn", Sgn[FLOAT], SoftSgn[S], true},
+		{"Trunc", Trunc[FLOAT], SoftTrunc[S], true},
+		{"Floor", Floor[FLOAT], SoftFloor[S], true},
+		{"Ceil", Ceil[FLOAT], SoftCeil[S], true},
+		{"Round", Round[FLOAT], SoftRound[S], true},
+		{"RoundToEven", RoundToEven[FLOAT], SoftRoundToEven[S], true},
+		{"Sqrt", Sqrt[FLOAT], SoftSqrt[S], true},
+		{"LogB", LogB[FLOAT], SoftLogB[S], true},
+		{"NextUp", NextUp[FLOAT], SoftNextUp[S], true},
+		{"NextDown", NextDown[FLOAT], SoftNextDown[S], true},
+		{"Cbrt", Cbrt[FLOAT], SoftCbrt[S], false},
+		{"Exp", Exp[FLOAT], SoftExp[S], false},
+		{"ExpM1", ExpM1[FLOAT], SoftExpM1[S], false},
+		{"Exp2", Exp2[FLOAT], SoftExp2[S], false},
+		{"Log1p", Log1p[FLOAT], SoftLog1p[S], false},
+		{"Erf", Erf[FLOAT], SoftErf[S], false},
+		{"Erfc", Erfc[FLOAT], SoftErfc[S], false},
+	}
+
+	for _, tt := range unary {
+		xs := specials
+		if tt.exact {
+			xs = values
+		}
+
+		for _, x := range xs {
+			if got, expect := native(tt.got(soft(x))), tt.expect(x); !same(got, expect) {
+				t.Errorf("%T: Soft%s(%v) = %v, expected %v", x, tt.name, x, got, expect)
+			}
+		}
+	}
+
+	binary := []struct {
+		name   string
+		expect func(x, y FLOAT) FLOAT
+		got    func(x, y S) S
+		exact  bool
+	}{
+		{"NextAfter", NextAfter[FLOAT], SoftNextAfter[S], true},
+		{"Max", Max[FLOAT], SoftMax[S], true},
+		{"Min", Min[FLOAT], SoftMin[S], true},
+		{"Maximum", Maximum[FLOAT], SoftMaximum[S], true},
+		{"Minimum", Minimum[FLOAT], SoftMinimum[S], true},
+		{"MaxMag", MaxMag[FLOAT], SoftMaxMag[S], true},
+		{"MinMag", MinMag[FLOAT], SoftMinMag[S], true},
+		{"Dim", Dim[FLOAT], SoftDim[S], true},
+		{"Mod", Mod[FLOAT], SoftMod[S], true},
+		{"Remainder", Remainder[FLOAT], SoftRemainder[S], true},
+		{"Pow", Pow[FLOAT], SoftPow[S], false},
+		{"Hypot", Hypot[FLOAT], SoftHypot[S], false},
+	}
+
+	isSpecial := func(x FLOAT) bool {
+		return x == 0 || IsInf(x, 0) || IsNaN(x)
+	}
+
+	for _, tt := range binary {
+		for _, x := range values {
+			for _, y := range values {
+				if !tt.exact && !isSpecial(x) && !isSpecial(y) {
+					continue
+				}
+
+				if got, expect := native(tt.got(soft(x), soft(y))), tt.expect(x, y); !same(got, expect) {
+					t.Errorf("%T: Soft%s(%v, %v) = %v, expected %v", x, tt.name, x, y, got, expect)
+				}
+			}
+		}
+	}
+
+	for _, x := range values {
+		for _, y := range values {
+			for _, z := range values {
+				if got, expect := native(SoftFMA(soft(x), soft(y), soft(z))), FMA(x, y, z); !same(got, expect) {
+					t.Errorf("%T: SoftFMA(%v, %v, %v) = %v, expected %v", x, x, y, z, got, expect)
+				}
+			}
+		}
+	}
+
+	for _, x := range values {
+		if got, expect := SoftILogB(soft(x)), ILogB(x); got != expect {
+			t.Errorf("%T: SoftILogB(%v) = %d, expected %d", x, x, got, expect)
+		}
+
+		gi, gf := SoftModf(soft(x))
+		ei, ef := Modf(x)
+		if !same(native(gi), ei) || !same(native(gf), ef) {
+			t.Errorf("%T: SoftModf(%v) = %v, %v, expected %v, %v", x, x, native(gi), native(gf), ei, ef)
+		}
+
+		gfrac, gexp := SoftFrExp(soft(x))
+		efrac, eexp := FrExp(x)
+		if !same(native(gfrac), efrac) || gexp != eexp {
+			t.Errorf("%T: SoftFrExp(%v) = %v, %d, expected %v, %d", x, x, native(gfrac), gexp, efrac, eexp)
+		}
+	}
+}
+
+func TestSoft(t *testing.T) {
+	testSoft[float32](t, floats.Float32.Native)
+	testSoft[float64](t, floats.Float64.Native)
+}
@@ -0,0 +1,386 @@
+package math
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+
+	"github.com/puellanivis/math/floats"
+)
+
+// SumAlgorithm selects how the terms of a sum are accumulated.
+// The functions that take one panic on any value other than those below.
+type SumAlgorithm = floats.SumAlgorithm
+
+// Summation algorithms, see floats.SumAlgorithm for details.
+const (
+	SumNaive    = floats.SumNaive
+	SumPairwise = floats.SumPairwise
+	SumNeumaier = floats.SumNeumaier
+	SumExact    = floats.SumExact
+)
+
+// pairwiseBlock is the number of terms below which pairwise summation just adds them naively.
+const pairwiseBlock = 128
+
+// Sum returns the sum of xs, accumulated according to alg.
+// SumNeumaier accumulates the sum and its compensation as float64,
+// as the compensation of a float32 sum of millions of terms would itself drift.
+//
+// Special cases are:
+//
+//	Sum([]) = 0
+//	Sum(xs) = NaN, if any x is NaN, or xs contains both +Inf and -Inf
+//	Sum(xs) = ±Inf, if xs contains ±Inf
+func Sum[FLOAT Float](xs []FLOAT, alg SumAlgorithm) FLOAT {
+	switch alg {
+	case SumPairwise:
+		return sumPairwise(xs)
+
+	case SumNeumaier:
+		var s, c float64
+		for _, x := range xs {
+			s, c = neumaier(s, c, float64(x))
+		}
+		return FLOAT(compensated(s, c))
+
+	case SumExact:
+		var acc superAccumulator
+		for _, x := range xs {
+			acc.add(float64(x))
+		}
+		return superResult[FLOAT](&acc)
+
+	case SumNaive:
+		var s FLOAT
+		for _, x := range xs {
+			s += x
+		}
+		return s
+	}
+
+	panic("unknown SumAlgorithm")
+}
+
+func sumPairwise[FLOAT Float](xs []FLOAT) FLOAT {
+	if len(xs) <= pairwiseBlock {
+		var s FLOAT
+		for _, x := range xs {
+			s += x
+		}
+		return s
+	}
+
+	mid := len(xs) / 2
+
+	return sumPairwise(xs[:mid]) + sumPairwise(xs[mid:])
+}
+
+// neumaier adds x into the sum s, and its rounding error into the compensation c.
+func neumaier(s, c, x float64) (float64, float64) {
+	t := s + x
+
+	// The rounding error of s+x is recovered exactly from the smaller of the two operands.
+	if Abs(s) >= Abs(x) {
+		c += (s - t) + x
+	} else {
+		c += (x - t) + s
+	}
+
+	return t, c
+}
+
+// compensated returns the sum s corrected by its compensation c.
+func compensated(s, c float64) float64 {
+	if IsNaN(s) || IsInf(s, 0) {
+		// The compensation is meaningless, once the sum is not finite.
+		return s
+	}
+
+	return s + c
+}
+
+// Dot returns the dot product of xs and ys, accumulated according to alg.
+// It panics if xs and ys have different lengths.
+//
+// With SumNeumaier, the products are accumulated as float64,
+// and the rounding error of each product is also compensated for.
+// With SumExact, the result is correctly rounded,
+// unless the rounding error of a float64 product underflows.
+func Dot[FLOAT Float](xs, ys []FLOAT, alg SumAlgorithm) FLOAT {
+	if len(xs) != len(ys) {
+		panic("Dot: slices of different lengths")
+	}
+
+	switch alg {
+	case SumPairwise:
+		return dotPairwise(xs, ys)
+
+	case SumNeumaier:
+		var s, c float64
+		for i, x := range xs {
+			p, e := twoProd(float64(x), float64(ys[i]))
+
+			s, c = neumaier(s, c, p)
+			c += e
+		}
+		return FLOAT(compensated(s, c))
+
+	case SumExact:
+		var acc superAccumulator
+		for i, x := range xs {
+			acc.addProd(float64(x), float64(ys[i]))
+		}
+		return superResult[FLOAT](&acc)
+
+	case SumNaive:
+		var s FLOAT
+		for i, x := range xs {
+			s += x * ys[i]
+		}
+		return s
+	}
+
+	panic("unknown SumAlgorithm")
+}
+
+func dotPairwise[FLOAT Float](xs, ys []FLOAT) FLOAT {
+	if len(xs) <= pairwiseBlock {
+		var s FLOAT
+		for i, x := range xs {
+			s += x * ys[i]
+		}
+		return s
+	}
+
+	mid := len(xs) / 2
+
+	return dotPairwise(xs[:mid], ys[:mid]) + dotPairwise(xs[mid:], ys[mid:])
+}
+
+// Norm2 returns the Euclidean norm of xs, the square root of the sum of their squares,
+// taking care to avoid unnecessary overflow and underflow.
+// The sum of the squares is accumulated according to alg.
+//
+// Special cases are:
+//
+//	Norm2([]) = 0
+//	Norm2(xs) = +Inf, if any x is ±Inf
+//	Norm2(xs) = NaN, if any x is NaN, and no x is ±Inf
+func Norm2[FLOAT Float](xs []FLOAT, alg SumAlgorithm) FLOAT {
+	var max FLOAT
+
+	hasNaN := false
+	for _, x := range xs {
+		switch {
+		case IsInf(x, 0):
+			return Inf[FLOAT](1)
+		case IsNaN(x):
+			hasNaN = true
+		case Abs(x) > max:
+			max = Abs(x)
+		}
+	}
+
+	switch {
+	case hasNaN:
+		return NaN[FLOAT]()
+	case max == 0:
+		return 0
+	}
+
+	if alg == SumExact {
+		// The squares can overflow or underflow a float64, so they are summed exactly as big.Floats instead.
+		acc := new(big.Float).SetPrec(normExactPrec)
+		for _, x := range xs {
+			sq := new(big.Float).SetPrec(106).SetFloat64(float64(x))
+			acc.Add(acc, sq.Mul(sq, sq))
+		}
+
+		// Sufficient extra precision makes a double rounding here vanishingly rare.
+		return bigToFloat[FLOAT](new(big.Float).SetPrec(128).Sqrt(acc))
+	}
+
+	// Scale every term by the same power of two, so that the largest is near 1/√n,
+	// and the sum of their squares is then no more than about 1.
+	_, k := math.Frexp(float64(max))
+	k += (bits.Len(uint(len(xs))) + 1) / 2
+
+	scaled := make([]FLOAT, len(xs))
+	for i, x := range xs {
+		scaled[i] = FLOAT(math.Ldexp(float64(x), -k))
+	}
+
+	s := Dot(scaled, scaled, alg)
+
+	return FLOAT(math.Ldexp(float64(Sqrt(s)), k))
+}
+
+// normExactPrec is enough precision to hold the exact sum of the squares of up to 2**64 float64 values.
+const normExactPrec = 2*(superBias+1024) + 64
+
+// superAccumulator holds the exact sum of any number of float64 values,
+// as a fixed-point integer spanning the whole float64 range, split into 32-bit digits.
+// Each digit is held in an int64, so that many values can be added before carries must be propagated.
+type superAccumulator struct {
+	digits [superDigits]int64
+
+	// pending counts the additions made since the carries were last propagated.
+	pending int
+
+	// pos and neg report whether any finite value had its sign bit clear, or set,
+	// which decides the sign of a sum that is exactly zero.
+	pos, neg bool
+
+	nan            bool
+	posInf, negInf bool
+}
+
+const (
+	// The smallest sub-normal float64 is 2**-1074, and the largest float64 is less than 2**1024.
+	// Products of float64s could need twice the range,
+	// but their rounding errors are only accumulated when they do not underflow.
+	superBias   = 1074
+	superDigits = (superBias+1024)/32 + 4
+
+	// Every addition adds less than 2**32 into any digit, so carries must be propagated before 2**31 of them.
+	superPendingMax = 1 << 30
+)
+
+func (acc *superAccumulator) add(x float64) {
+	switch {
+	case IsNaN(x):
+		acc.nan = true
+		return
+	case IsInf(x, 1):
+		acc.posInf = true
+		return
+	case IsInf(x, -1):
+		acc.negInf = true
+		return
+	}
+
+	if SignBit(x) {
+		acc.neg = true
+	} else {
+		acc.pos = true
+	}
+
+	if x == 0 {
+		return
+	}
+
+	// x = ±m × 2**e, where m is a 53-bit integer, and e ≥ -1074.
+	frac, exp := math.Frexp(x)
+	m := int64(math.Ldexp(frac, 53))
+	e := exp - 53
+
+	if e < -superBias {
+		// Sub-normals have fewer than 53 significant bits, so this shift is exact.
+		m >>= -superBias - e
+		e = -superBias
+	}
+
+	pos := e + superBias
+	i, shift := pos/32, uint(pos%32)
+
+	// Spread the shifted mantissa across the (up to) three digits it overlaps.
+	neg := m < 0
+	u := uint64(m)
+	if neg {
+		u = uint64(-m)
+	}
+
+	lo := u << shift
+	hi := u >> (64 - shift)
+	if shift == 0 {
+		hi = 0
+	}
+
+	parts := [3]int64{int64(lo & 0xffffffff), int64(lo >> 32), int64(hi)}
+	for j, p := range parts {
+		if neg {
+			p = -p
+		}
+		acc.digits[i+j] += p
+	}
+
+	acc.pending++
+	if acc.pending >= superPendingMax {
+		acc.normalize()
+	}
+}
+
+// addProd adds the product x*y, as the sum of its rounded value and the exact rounding error.
+func (acc *superAccumulator) addProd(x, y float64) {
+	p, e := twoProd(x, y)
+
+	acc.add(p)
+
+	// A zero error adds nothing, and must not make the sign of a zero product positive.
+	if isFinite(p) && e != 0 {
+		acc.add(e)
+	}
+}
+
+// normalize propagates the carries, so that every digit but the last is in the range [0, 2**32).
+func (acc *superAccumulator) normalize() {
+	var carry int64
+	for i := range acc.digits {
+		d := acc.digits[i] + carry
+		acc.digits[i] = d & 0xffffffff
+		carry = d >> 32
+	}
+	acc.digits[len(acc.digits)-1] += carry << 32
+
+	acc.pending = 0
+}
+
+// bigFloat returns the exact value of the finite sum.
+func (acc *superAccumulator) bigFloat() *big.Float {
+	acc.normalize()
+
+	n := new(big.Int)
+	for i := len(acc.digits) - 1; i >= 0; i-- {
+		n.Lsh(n, 32)
+		n.Add(n, big.NewInt(acc.digits[i]))
+	}
+
+	f := new(big.Float).SetInt(n)
+	return f.SetMantExp(f, -superBias)
+}
+
+// superResult returns the sum in acc, correctly rounded to FLOAT.
+func superResult[FLOAT Float](acc *superAccumulator) FLOAT {
+	switch {
+	case acc.nan, acc.posInf && acc.negInf:
+		return NaN[FLOAT]()
+	case acc.posInf:
+		return Inf[FLOAT](1)
+	case acc.negInf:
+		return Inf[FLOAT](-1)
+	}
+
+	f := acc.bigFloat()
+	if f.Sign() == 0 && acc.neg && !acc.pos {
+		// As a rounded sum would be, the sum of only negative zeros is -0.
+		return CopySign(0, FLOAT(-1))
+	}
+
+	return bigToFloat[FLOAT](f)
+}
+
+// bigToFloat returns x correctly rounded to FLOAT.
+func bigToFloat[FLOAT Float](x *big.Float) FLOAT {
+	var f FLOAT
+	switch any(f).(type) {
+	case float32:
+		f32, _ := x.Float32()
+		return FLOAT(f32)
+	case float64:
+		f64, _ := x.Float64()
+		return FLOAT(f64)
+	default:
+		panic("impossible type")
+	}
+}
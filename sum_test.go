@@ -0,0 +1,125 @@
+package math
+
+import (
+	"math"
+	"testing"
+)
+
+var sumAlgorithms = []SumAlgorithm{SumNaive, SumPairwise, SumNeumaier, SumExact}
+
+func testSum[FLOAT Float](t *testing.T) {
+	t.Helper()
+
+	nan, inf, negZero := NaN[FLOAT](), Inf[FLOAT](1), CopySign(0, FLOAT(-1))
+
+	// 1 + n×ε/2 - 1: every rounded addition of ε/2 to 1 is lost, but the exact sum is n×ε/2.
+	eps := NextUp(FLOAT(1)) - 1
+
+	ties := []FLOAT{1}
+	for i := 0; i < 1000; i++ {
+		ties = append(ties, eps/2)
+	}
+	ties = append(ties, -1)
+
+	tests := []struct {
+		name string
+		alg  SumAlgorithm
+		xs   []FLOAT
+
+		expect FLOAT
+	}{
+		{"empty", SumNaive, nil, 0},
+		{"empty", SumExact, nil, 0},
+		{"small", SumPairwise, []FLOAT{1, 2, 3}, 6},
+		{"ties", SumNaive, ties, 0},
+		{"ties", SumNeumaier, ties, 500 * eps},
+		{"ties", SumExact, ties, 500 * eps},
+		{"cancellation", SumExact, []FLOAT{NextDown(inf), 1, -NextDown(inf)}, 1},
+		{"-0", SumExact, []FLOAT{negZero, negZero}, negZero},
+		{"±0", SumExact, []FLOAT{negZero, 0}, 0},
+		{"zero", SumExact, []FLOAT{-1, 1}, 0},
+		{"inf", SumNeumaier, []FLOAT{inf, 1}, inf},
+		{"inf", SumExact, []FLOAT{-inf, 1}, -inf},
+		{"inf and -inf", SumExact, []FLOAT{inf, -inf}, nan},
+		{"nan", SumPairwise, []FLOAT{1, nan}, nan},
+	}
+
+	for _, tt := range tests {
+		if got := Sum(tt.xs, tt.alg); !same(got, tt.expect) {
+			t.Errorf("%T: Sum(%s, %d) = %v, expected %v", got, tt.name, tt.alg, got, tt.expect)
+		}
+	}
+}
+
+func TestSum(t *testing.T) {
+	testSum[float32](t)
+	testSum[float64](t)
+}
+
+func TestDot(t *testing.T) {
+	xs := []float64{1, 2, 3}
+	ys := []float64{4, 5, 6}
+
+	for _, alg := range sumAlgorithms {
+		if got := Dot(xs, ys, alg); got != 32 {
+			t.Errorf("Dot((1, 2, 3), (4, 5, 6), %d) = %v, expected 32", alg, got)
+		}
+	}
+
+	// (1+ε)(1-ε) - 1 = -ε², which is lost entirely by the rounding of the product.
+	eps := 0x1p-52
+	xs = []float64{1 + eps, -1}
+	ys = []float64{1 - eps, 1}
+
+	if got := Dot(xs, ys, SumNaive); got != 0 {
+		t.Errorf("Dot(SumNaive) = %v, expected 0", got)
+	}
+
+	for _, alg := range []SumAlgorithm{SumNeumaier, SumExact} {
+		if got := Dot(xs, ys, alg); got != -eps*eps {
+			t.Errorf("Dot(%d) = %v, expected %v", alg, got, -eps*eps)
+		}
+	}
+
+	// The products are both -0, and so is their exact sum.
+	negZero := math.Copysign(0, -1)
+	if got := Dot([]float64{negZero, 1}, []float64{1, negZero}, SumExact); !same(got, negZero) {
+		t.Errorf("Dot((-0, 1), (1, -0), SumExact) = %v, expected -0", got)
+	}
+}
+
+func TestNorm2(t *testing.T) {
+	big, small := 0x1p1000, 0x1p-1060
+
+	tests := []struct {
+		name string
+		xs   []float64
+
+		expect float64
+	}{
+		{"empty", nil, 0},
+		{"three four", []float64{3, -4}, 5},
+		{"no overflow", []float64{3 * big, 4 * big}, 5 * big},
+		{"no underflow", []float64{3 * small, 4 * small}, 5 * small},
+		{"nan and inf", []float64{math.NaN(), math.Inf(-1)}, math.Inf(1)},
+		{"nan", []float64{math.NaN(), 1}, math.NaN()},
+	}
+
+	for _, tt := range tests {
+		for _, alg := range sumAlgorithms {
+			if got := Norm2(tt.xs, alg); !same(got, tt.expect) {
+				t.Errorf("Norm2(%s, %d) = %v, expected %v", tt.name, alg, got, tt.expect)
+			}
+		}
+	}
+}
+
+func TestSumUnknown(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Sum with an unknown SumAlgorithm did not panic")
+		}
+	}()
+
+	Sum([]float64{1}, SumAlgorithm(-1))
+}